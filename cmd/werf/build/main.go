@@ -2,24 +2,14 @@ package build
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/spf13/cobra"
 
 	"github.com/werf/logboek"
 
 	"github.com/werf/werf/cmd/werf/common"
-	"github.com/werf/werf/pkg/build"
-	"github.com/werf/werf/pkg/container_runtime"
-	"github.com/werf/werf/pkg/docker"
-	"github.com/werf/werf/pkg/git_repo"
-	"github.com/werf/werf/pkg/image"
-	"github.com/werf/werf/pkg/logging"
-	"github.com/werf/werf/pkg/ssh_agent"
-	"github.com/werf/werf/pkg/storage/manager"
+	"github.com/werf/werf/pkg/api"
 	"github.com/werf/werf/pkg/tmp_manager"
-	"github.com/werf/werf/pkg/true_git"
-	"github.com/werf/werf/pkg/werf"
 	"github.com/werf/werf/pkg/werf/global_warnings"
 )
 
@@ -39,7 +29,20 @@ func NewCmd() *cobra.Command {
   $ werf build --introspect-error
 
   # Build images and store/use stages from repo
-  $ werf build --repo harbor.company.io/werf`,
+  $ werf build --repo harbor.company.io/werf
+
+  # Build all images which do not depend on the failed ones and report results of each image
+  $ werf build --keep-going
+
+  # Build only images affected by changes since the main branch, stages of other images are taken from repo
+  $ werf build --repo harbor.company.io/werf --affected-since origin/main
+
+  # Build the second of three shards of images on the CI agent, then build all images using stored stages
+  $ werf build --repo harbor.company.io/werf --shard 2/3
+  $ werf build --repo harbor.company.io/werf
+
+  # Build reproducible stages and check that already stored stages of image 'backend' are reproducible
+  $ SOURCE_DATE_EPOCH=1600000000 werf build --reproducible --verify-reproducibility backend`,
 		Long: common.GetLongCommandDescription(`Build images that are described in werf.yaml.
 
 The result of build command is built images pushed into the specified repo (or locally if repo is not specified).
@@ -68,6 +71,7 @@ If one or more IMAGE_NAME parameters specified, werf will build only these image
 
 	common.SetupDir(&commonCmdData, cmd)
 	common.SetupConfigTemplatesDir(&commonCmdData, cmd)
+	common.SetupConfigValues(&commonCmdData, cmd)
 	common.SetupConfigPath(&commonCmdData, cmd)
 	common.SetupEnvironment(&commonCmdData, cmd)
 
@@ -76,6 +80,7 @@ If one or more IMAGE_NAME parameters specified, werf will build only these image
 	common.SetupTmpDir(&commonCmdData, cmd)
 	common.SetupHomeDir(&commonCmdData, cmd)
 	common.SetupSSHKey(&commonCmdData, cmd)
+	common.SetupGitHTTPSCredentials(&commonCmdData, cmd)
 
 	common.SetupSecondaryStagesStorageOptions(&commonCmdData, cmd)
 	common.SetupStagesStorageOptions(&commonCmdData, cmd)
@@ -88,6 +93,10 @@ If one or more IMAGE_NAME parameters specified, werf will build only these image
 	common.SetupIntrospectBeforeError(&commonCmdData, cmd)
 	common.SetupIntrospectStage(&commonCmdData, cmd)
 
+	common.SetupReproducible(&commonCmdData, cmd)
+	common.SetupImportServer(&commonCmdData, cmd)
+	common.SetupVerifyReproducibility(&commonCmdData, cmd)
+
 	common.SetupLogOptions(&commonCmdData, cmd)
 	common.SetupLogProjectDir(&commonCmdData, cmd)
 
@@ -104,6 +113,9 @@ If one or more IMAGE_NAME parameters specified, werf will build only these image
 	common.SetupVirtualMergeIntoCommit(&commonCmdData, cmd)
 
 	common.SetupParallelOptions(&commonCmdData, cmd, common.DefaultBuildParallelTasksLimit)
+	common.SetupKeepGoing(&commonCmdData, cmd)
+	common.SetupAffectedSince(&commonCmdData, cmd)
+	common.SetupShard(&commonCmdData, cmd)
 	common.SetupFollow(&commonCmdData, cmd)
 
 	return cmd
@@ -112,139 +124,62 @@ If one or more IMAGE_NAME parameters specified, werf will build only these image
 func runMain(ctx context.Context, args []string) error {
 	tmp_manager.AutoGCEnabled = true
 
-	if err := werf.Init(*commonCmdData.TmpDir, *commonCmdData.HomeDir); err != nil {
-		return fmt.Errorf("initialization error: %s", err)
-	}
-
-	if err := common.InitGiterminismInspector(&commonCmdData); err != nil {
-		return err
-	}
-
-	if err := git_repo.Init(); err != nil {
-		return err
-	}
-
-	if err := image.Init(); err != nil {
-		return err
-	}
-
-	if err := true_git.Init(true_git.Options{LiveGitOutput: *commonCmdData.LogVerbose || *commonCmdData.LogDebug}); err != nil {
-		return err
-	}
-
-	if err := common.DockerRegistryInit(&commonCmdData); err != nil {
-		return err
-	}
-
-	if err := docker.Init(ctx, *commonCmdData.DockerConfig, *commonCmdData.LogVerbose, *commonCmdData.LogDebug); err != nil {
-		return err
-	}
-
-	ctxWithDockerCli, err := docker.NewContext(ctx)
+	initOptions, err := common.GetInitOptions(&commonCmdData)
 	if err != nil {
 		return err
 	}
-	ctx = ctxWithDockerCli
 
-	projectDir, err := common.GetProjectDir(&commonCmdData)
+	ctx, err = api.Init(ctx, initOptions)
 	if err != nil {
-		return fmt.Errorf("getting project dir failed: %s", err)
-	}
-
-	common.ProcessLogProjectDir(&commonCmdData, projectDir)
-
-	if err := ssh_agent.Init(ctx, *commonCmdData.SSHKeys); err != nil {
-		return fmt.Errorf("cannot initialize ssh agent: %s", err)
+		return err
 	}
 	defer func() {
-		err := ssh_agent.Terminate()
-		if err != nil {
+		if err := api.Terminate(); err != nil {
 			logboek.Warn().LogF("WARNING: ssh agent termination failed: %s\n", err)
 		}
 	}()
 
+	common.ProcessLogProjectDir(&commonCmdData, initOptions.ProjectDir)
+
 	if *commonCmdData.Follow {
 		logboek.LogOptionalLn()
 		return common.FollowGitHead(ctx, &commonCmdData, func(ctx context.Context) error {
-			return run(ctx, projectDir, args)
+			return run(ctx, initOptions.ProjectDir, args)
 		})
 	} else {
-		return run(ctx, projectDir, args)
+		return run(ctx, initOptions.ProjectDir, args)
 	}
 }
 
 func run(ctx context.Context, projectDir string, imagesToProcess []string) error {
-	localGitRepo, err := common.OpenLocalGitRepo(projectDir)
-	if err != nil {
-		return fmt.Errorf("unable to open local repo %s: %s", projectDir, err)
-	}
-
-	werfConfig, err := common.GetRequiredWerfConfig(ctx, projectDir, &commonCmdData, localGitRepo, common.GetWerfConfigOptions(&commonCmdData, true))
-	if err != nil {
-		return fmt.Errorf("unable to load werf config: %s", err)
-	}
-
-	projectName := werfConfig.Meta.Project
-
-	for _, imageToProcess := range imagesToProcess {
-		if !werfConfig.HasImageOrArtifact(imageToProcess) {
-			return fmt.Errorf("specified image %s is not defined in werf.yaml", logging.ImageLogName(imageToProcess, false))
-		}
-	}
-
-	projectTmpDir, err := tmp_manager.CreateProjectDir(ctx)
-	if err != nil {
-		return fmt.Errorf("getting project tmp dir failed: %s", err)
-	}
-	defer tmp_manager.ReleaseProjectDir(projectTmpDir)
-
-	containerRuntime := &container_runtime.LocalDockerServerRuntime{} // TODO
-
-	stagesStorageAddress := common.GetOptionalStagesStorageAddress(&commonCmdData)
-	stagesStorage, err := common.GetStagesStorage(stagesStorageAddress, containerRuntime, &commonCmdData)
+	project, err := api.OpenProject(ctx, common.GetProjectOptions(&commonCmdData, projectDir, true))
 	if err != nil {
 		return err
 	}
 
-	synchronization, err := common.GetSynchronization(ctx, &commonCmdData, projectName, stagesStorage)
-	if err != nil {
-		return err
-	}
-	stagesStorageCache, err := common.GetStagesStorageCache(synchronization)
-	if err != nil {
-		return err
-	}
-	storageLockManager, err := common.GetStorageLockManager(ctx, synchronization)
-	if err != nil {
-		return err
-	}
-	secondaryStagesStorageList, err := common.GetSecondaryStagesStorageList(stagesStorage, containerRuntime, &commonCmdData)
+	buildOptions, err := common.GetBuildOptions(&commonCmdData, project.WerfConfig)
 	if err != nil {
 		return err
 	}
 
-	storageManager := manager.NewStorageManager(projectName, stagesStorage, secondaryStagesStorageList, storageLockManager, stagesStorageCache)
-
-	buildOptions, err := common.GetBuildOptions(&commonCmdData, werfConfig)
+	conveyorOptions, err := common.GetConveyorOptionsWithParallel(&commonCmdData, buildOptions)
 	if err != nil {
 		return err
 	}
 
-	conveyorOptions, err := common.GetConveyorOptionsWithParallel(&commonCmdData, buildOptions)
+	shardOptions, err := common.GetShardOptions(&commonCmdData)
 	if err != nil {
 		return err
 	}
 
-	logboek.LogOptionalLn()
-
-	conveyorWithRetry := build.NewConveyorWithRetryWrapper(werfConfig, localGitRepo, imagesToProcess, projectDir, projectTmpDir, ssh_agent.SSHAuthSock, containerRuntime, storageManager, storageLockManager, conveyorOptions)
-	defer conveyorWithRetry.Terminate()
-
-	if err := conveyorWithRetry.WithRetryBlock(ctx, func(c *build.Conveyor) error {
-		return c.Build(ctx, buildOptions)
-	}); err != nil {
-		return err
-	}
+	_, err = api.Build(ctx, project, api.BuildOptions{
+		Storage:         common.GetStorageOptions(&commonCmdData, common.GetOptionalStagesStorageAddress(&commonCmdData)),
+		ImagesToProcess: imagesToProcess,
+		Build:           buildOptions,
+		Conveyor:        conveyorOptions,
+		AffectedSince:   *commonCmdData.AffectedSince,
+		Shard:           shardOptions,
+	})
 
-	return nil
+	return err
 }
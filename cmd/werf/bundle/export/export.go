@@ -7,7 +7,6 @@ import (
 
 	"helm.sh/helm/v3/pkg/getter"
 
-	"github.com/werf/werf/pkg/config"
 	"github.com/werf/werf/pkg/git_repo"
 	"github.com/werf/werf/pkg/giterminism_inspector"
 	"github.com/werf/werf/pkg/werf/global_warnings"
@@ -78,17 +77,22 @@ func NewCmd() *cobra.Command {
 	common.SetupDir(&commonCmdData, cmd)
 	common.SetupGiterminismInspectorOptions(&commonCmdData, cmd)
 	common.SetupConfigTemplatesDir(&commonCmdData, cmd)
+	common.SetupConfigValues(&commonCmdData, cmd)
 	common.SetupConfigPath(&commonCmdData, cmd)
 	common.SetupEnvironment(&commonCmdData, cmd)
 
 	common.SetupTmpDir(&commonCmdData, cmd)
 	common.SetupHomeDir(&commonCmdData, cmd)
 	common.SetupSSHKey(&commonCmdData, cmd)
+	common.SetupGitHTTPSCredentials(&commonCmdData, cmd)
 
 	common.SetupIntrospectAfterError(&commonCmdData, cmd)
 	common.SetupIntrospectBeforeError(&commonCmdData, cmd)
 	common.SetupIntrospectStage(&commonCmdData, cmd)
 
+	common.SetupReproducible(&commonCmdData, cmd)
+	common.SetupImportServer(&commonCmdData, cmd)
+
 	common.SetupSecondaryStagesStorageOptions(&commonCmdData, cmd)
 	common.SetupStagesStorageOptions(&commonCmdData, cmd)
 
@@ -176,7 +180,7 @@ func runExport() error {
 		return fmt.Errorf("unable to open local repo %s: %s", projectDir, err)
 	}
 
-	werfConfig, err := common.GetRequiredWerfConfig(ctx, projectDir, &commonCmdData, localGitRepo, config.WerfConfigOptions{LogRenderedFilePath: true, Env: *commonCmdData.Environment})
+	werfConfig, err := common.GetRequiredWerfConfig(ctx, projectDir, &commonCmdData, localGitRepo, common.GetWerfConfigOptions(&commonCmdData, true))
 	if err != nil {
 		return fmt.Errorf("unable to load werf config: %s", err)
 	}
@@ -194,6 +198,10 @@ func runExport() error {
 	}
 	defer tmp_manager.ReleaseProjectDir(projectTmpDir)
 
+	if err := common.InitGitHTTPSCredentials(&commonCmdData); err != nil {
+		return fmt.Errorf("cannot initialize git https credentials: %s", err)
+	}
+
 	if err := ssh_agent.Init(ctx, *commonCmdData.SSHKeys); err != nil {
 		return fmt.Errorf("cannot initialize ssh agent: %s", err)
 	}
@@ -338,6 +346,10 @@ func runExport() error {
 		FileValues:   *commonCmdData.SetFile,
 	}
 
+	if err := deploy.ReportHelmSetValues(ctx, *valueOpts, localGitRepo); err != nil {
+		return err
+	}
+
 	helmTemplateCmd, _ := cmd_helm.NewTemplateCmd(actionConfig, ioutil.Discard, cmd_helm.TemplateCmdOptions{
 		PostRenderer: wc.ExtraAnnotationsAndLabelsPostRenderer,
 		ValueOpts:    valueOpts,
@@ -1,8 +1,8 @@
 package publish
 
 import (
+	"bytes"
 	"fmt"
-	"io/ioutil"
 	"os"
 	"path/filepath"
 
@@ -10,7 +10,6 @@ import (
 
 	uuid "github.com/satori/go.uuid"
 
-	"github.com/werf/werf/pkg/config"
 	"github.com/werf/werf/pkg/git_repo"
 	"github.com/werf/werf/pkg/giterminism_inspector"
 	"github.com/werf/werf/pkg/werf/global_warnings"
@@ -19,6 +18,7 @@ import (
 
 	"github.com/werf/werf/pkg/deploy"
 	"github.com/werf/werf/pkg/deploy/secret"
+	"github.com/werf/werf/pkg/deploy/validation"
 
 	"github.com/werf/werf/pkg/deploy/werf_chart"
 	cmd_helm "helm.sh/helm/v3/cmd/helm"
@@ -84,17 +84,22 @@ Published into container registry bundle can be rolled out by the "werf bundle "
 	common.SetupDir(&commonCmdData, cmd)
 	common.SetupGiterminismInspectorOptions(&commonCmdData, cmd)
 	common.SetupConfigTemplatesDir(&commonCmdData, cmd)
+	common.SetupConfigValues(&commonCmdData, cmd)
 	common.SetupConfigPath(&commonCmdData, cmd)
 	common.SetupEnvironment(&commonCmdData, cmd)
 
 	common.SetupTmpDir(&commonCmdData, cmd)
 	common.SetupHomeDir(&commonCmdData, cmd)
 	common.SetupSSHKey(&commonCmdData, cmd)
+	common.SetupGitHTTPSCredentials(&commonCmdData, cmd)
 
 	common.SetupIntrospectAfterError(&commonCmdData, cmd)
 	common.SetupIntrospectBeforeError(&commonCmdData, cmd)
 	common.SetupIntrospectStage(&commonCmdData, cmd)
 
+	common.SetupReproducible(&commonCmdData, cmd)
+	common.SetupImportServer(&commonCmdData, cmd)
+
 	common.SetupSecondaryStagesStorageOptions(&commonCmdData, cmd)
 	common.SetupStagesStorageOptions(&commonCmdData, cmd)
 
@@ -117,6 +122,8 @@ Published into container registry bundle can be rolled out by the "werf bundle "
 	common.SetupSecretValues(&commonCmdData, cmd)
 	common.SetupIgnoreSecretKey(&commonCmdData, cmd)
 
+	common.SetupValidate(&commonCmdData, cmd)
+
 	common.SetupReportPath(&commonCmdData, cmd)
 	common.SetupReportFormat(&commonCmdData, cmd)
 
@@ -186,7 +193,7 @@ func runPublish() error {
 		return fmt.Errorf("unable to open local repo %s: %s", projectDir, err)
 	}
 
-	werfConfig, err := common.GetRequiredWerfConfig(ctx, projectDir, &commonCmdData, localGitRepo, config.WerfConfigOptions{LogRenderedFilePath: true, Env: *commonCmdData.Environment})
+	werfConfig, err := common.GetRequiredWerfConfig(ctx, projectDir, &commonCmdData, localGitRepo, common.GetWerfConfigOptions(&commonCmdData, true))
 	if err != nil {
 		return fmt.Errorf("unable to load werf config: %s", err)
 	}
@@ -204,6 +211,10 @@ func runPublish() error {
 	}
 	defer tmp_manager.ReleaseProjectDir(projectTmpDir)
 
+	if err := common.InitGitHTTPSCredentials(&commonCmdData); err != nil {
+		return fmt.Errorf("cannot initialize git https credentials: %s", err)
+	}
+
 	if err := ssh_agent.Init(ctx, *commonCmdData.SSHKeys); err != nil {
 		return fmt.Errorf("cannot initialize ssh agent: %s", err)
 	}
@@ -348,16 +359,40 @@ func runPublish() error {
 		FileValues:   *commonCmdData.SetFile,
 	}
 
-	helmTemplateCmd, _ := cmd_helm.NewTemplateCmd(actionConfig, ioutil.Discard, cmd_helm.TemplateCmdOptions{
+	if err := deploy.ReportHelmSetValues(ctx, *valueOpts, localGitRepo); err != nil {
+		return err
+	}
+
+	validateOptions := common.GetValidateOptions(&commonCmdData)
+
+	var manifests bytes.Buffer
+	helmTemplateCmd, _ := cmd_helm.NewTemplateCmd(actionConfig, &manifests, cmd_helm.TemplateCmdOptions{
 		PostRenderer: wc.ExtraAnnotationsAndLabelsPostRenderer,
 		ValueOpts:    valueOpts,
 	})
+	if validateOptions != nil {
+		if err := helmTemplateCmd.Flags().Set("include-crds", "true"); err != nil {
+			return err
+		}
+	}
 	if err := wc.WrapTemplate(ctx, func() error {
 		return helmTemplateCmd.RunE(helmTemplateCmd, []string{"RELEASE", chartDir})
 	}); err != nil {
 		return err
 	}
 
+	if err := wc.CheckPolicies(ctx, manifests.Bytes()); err != nil {
+		return err
+	}
+
+	if validateOptions != nil {
+		if err := logboek.Context(ctx).LogProcess("Validating manifests").DoError(func() error {
+			return validation.ValidateManifests(ctx, manifests.Bytes(), *validateOptions)
+		}); err != nil {
+			return err
+		}
+	}
+
 	bundleTmpDir := filepath.Join(werf.GetServiceDir(), "tmp", "bundles", uuid.NewV4().String())
 	defer os.RemoveAll(bundleTmpDir)
 
@@ -58,6 +58,7 @@ Currently supported only GitLab (gitlab) and GitHub (github) CI systems`,
 
 	common.SetupDir(&commonCmdData, cmd)
 	common.SetupConfigTemplatesDir(&commonCmdData, cmd)
+	common.SetupConfigValues(&commonCmdData, cmd)
 	common.SetupConfigPath(&commonCmdData, cmd)
 	common.SetupEnvironment(&commonCmdData, cmd)
 
@@ -13,6 +13,7 @@ import (
 	"github.com/werf/werf/pkg/docker"
 	"github.com/werf/werf/pkg/git_repo"
 	"github.com/werf/werf/pkg/image"
+	"github.com/werf/werf/pkg/ssh_agent"
 	"github.com/werf/werf/pkg/storage"
 	"github.com/werf/werf/pkg/storage/manager"
 	"github.com/werf/werf/pkg/tmp_manager"
@@ -51,11 +52,15 @@ It is safe to run this command periodically (daily is enough) by automated clean
 
 	common.SetupDir(&commonCmdData, cmd)
 	common.SetupConfigTemplatesDir(&commonCmdData, cmd)
+	common.SetupConfigValues(&commonCmdData, cmd)
 	common.SetupConfigPath(&commonCmdData, cmd)
 	common.SetupEnvironment(&commonCmdData, cmd)
 
 	common.SetupGiterminismInspectorOptions(&commonCmdData, cmd)
 
+	common.SetupSSHKey(&commonCmdData, cmd)
+	common.SetupGitHTTPSCredentials(&commonCmdData, cmd)
+
 	common.SetupTmpDir(&commonCmdData, cmd)
 	common.SetupHomeDir(&commonCmdData, cmd)
 
@@ -103,6 +108,20 @@ func runCleanup() error {
 		return err
 	}
 
+	if err := common.InitGitHTTPSCredentials(&commonCmdData); err != nil {
+		return fmt.Errorf("cannot initialize git https credentials: %s", err)
+	}
+
+	if err := ssh_agent.Init(ctx, *commonCmdData.SSHKeys); err != nil {
+		return fmt.Errorf("cannot initialize ssh agent: %s", err)
+	}
+	defer func() {
+		err := ssh_agent.Terminate()
+		if err != nil {
+			logboek.Warn().LogF("WARNING: ssh agent termination failed: %s\n", err)
+		}
+	}()
+
 	if err := image.Init(); err != nil {
 		return err
 	}
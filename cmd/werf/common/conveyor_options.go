@@ -10,13 +10,19 @@ import (
 )
 
 func GetConveyorOptions(commonCmdData *CmdData) build.ConveyorOptions {
-	return build.ConveyorOptions{
+	conveyorOptions := build.ConveyorOptions{
 		LocalGitRepoVirtualMergeOptions: stage.VirtualMergeOptions{
 			VirtualMerge:           *commonCmdData.VirtualMerge,
 			VirtualMergeFromCommit: *commonCmdData.VirtualMergeFromCommit,
 			VirtualMergeIntoCommit: *commonCmdData.VirtualMergeIntoCommit,
 		},
 	}
+
+	if commonCmdData.ImportServer != nil {
+		conveyorOptions.ImportServerType = *commonCmdData.ImportServer
+	}
+
+	return conveyorOptions
 }
 
 func GetConveyorOptionsWithParallel(commonCmdData *CmdData, buildStagesOptions build.BuildOptions) (build.ConveyorOptions, error) {
@@ -30,6 +36,10 @@ func GetConveyorOptionsWithParallel(commonCmdData *CmdData, buildStagesOptions b
 
 	conveyorOptions.ParallelTasksLimit = parallelTasksLimit
 
+	if commonCmdData.KeepGoing != nil {
+		conveyorOptions.KeepGoing = *commonCmdData.KeepGoing
+	}
+
 	return conveyorOptions, nil
 }
 
@@ -54,5 +64,22 @@ func GetBuildOptions(commonCmdData *CmdData, werfConfig *config.WerfConfig) (bui
 		ReportFormat:      reportFormat,
 	}
 
+	if commonCmdData.VerifyReproducibility != nil {
+		buildOptions.VerifyReproducibility = *commonCmdData.VerifyReproducibility
+	}
+
+	if commonCmdData.Reproducible != nil {
+		buildOptions.ImageBuildOptions.Reproducible = *commonCmdData.Reproducible || buildOptions.VerifyReproducibility
+	}
+
+	if buildOptions.ImageBuildOptions.Reproducible {
+		sourceDateEpoch, err := GetSourceDateEpoch()
+		if err != nil {
+			return buildOptions, err
+		}
+
+		buildOptions.ImageBuildOptions.SourceDateEpoch = sourceDateEpoch
+	}
+
 	return buildOptions, nil
 }
@@ -1,115 +1,28 @@
 package common
 
 import (
-	"bytes"
 	"context"
 	"fmt"
 	"strings"
-	"text/template"
 
 	"helm.sh/helm/v3/pkg/chart/loader"
 	"helm.sh/helm/v3/pkg/cli"
+	"helm.sh/helm/v3/pkg/cli/values"
 
-	"github.com/Masterminds/sprig/v3"
+	"github.com/werf/werf/pkg/api"
 	"github.com/werf/werf/pkg/config"
-	"github.com/werf/werf/pkg/deploy/werf_chart"
+	"github.com/werf/werf/pkg/deploy"
+	"github.com/werf/werf/pkg/deploy/validation"
 	"github.com/werf/werf/pkg/git_repo"
-	"github.com/werf/werf/pkg/giterminism_inspector"
 	"github.com/werf/werf/pkg/image"
-	"github.com/werf/werf/pkg/slug"
-	"github.com/werf/werf/pkg/util"
 )
 
 func GetHelmRelease(releaseOption string, environmentOption string, werfConfig *config.WerfConfig) (string, error) {
-	if releaseOption != "" {
-		err := slug.ValidateHelmRelease(releaseOption)
-		if err != nil {
-			return "", fmt.Errorf("bad Helm release specified '%s': %s", releaseOption, err)
-		}
-		return releaseOption, nil
-	}
-
-	var releaseTemplate string
-	if werfConfig.Meta.Deploy.HelmRelease != nil {
-		releaseTemplate = *werfConfig.Meta.Deploy.HelmRelease
-	} else if environmentOption == "" {
-		releaseTemplate = "[[ project ]]"
-	} else {
-		releaseTemplate = "[[ project ]]-[[ env ]]"
-	}
-
-	renderedRelease, err := renderDeployParamTemplate("release", releaseTemplate, environmentOption, werfConfig)
-	if err != nil {
-		return "", fmt.Errorf("cannot render Helm release name by template '%s': %s", releaseTemplate, err)
-	}
-
-	if renderedRelease == "" {
-		return "", fmt.Errorf("Helm release rendered by template '%s' is empty: release name cannot be empty", releaseTemplate)
-	}
-
-	var helmReleaseSlug bool
-	if werfConfig.Meta.Deploy.HelmReleaseSlug != nil {
-		helmReleaseSlug = *werfConfig.Meta.Deploy.HelmReleaseSlug
-	} else {
-		helmReleaseSlug = true
-	}
-
-	if helmReleaseSlug {
-		return slug.HelmRelease(renderedRelease), nil
-	}
-
-	err = slug.ValidateHelmRelease(renderedRelease)
-	if err != nil {
-		return "", fmt.Errorf("bad Helm release '%s' rendered by template '%s': %s", renderedRelease, releaseTemplate, err)
-	}
-
-	return renderedRelease, nil
+	return deploy.GetHelmRelease(releaseOption, environmentOption, werfConfig)
 }
 
 func GetKubernetesNamespace(namespaceOption string, environmentOption string, werfConfig *config.WerfConfig) (string, error) {
-	if namespaceOption != "" {
-		err := slug.ValidateKubernetesNamespace(namespaceOption)
-		if err != nil {
-			return "", fmt.Errorf("bad Kubernetes namespace specified '%s': %s", namespaceOption, err)
-		}
-		return namespaceOption, nil
-	}
-
-	var namespaceTemplate string
-	if werfConfig.Meta.Deploy.Namespace != nil {
-		namespaceTemplate = *werfConfig.Meta.Deploy.Namespace
-	} else if environmentOption == "" {
-		namespaceTemplate = "[[ project ]]"
-	} else {
-		namespaceTemplate = "[[ project ]]-[[ env ]]"
-	}
-
-	renderedNamespace, err := renderDeployParamTemplate("namespace", namespaceTemplate, environmentOption, werfConfig)
-	if err != nil {
-		return "", fmt.Errorf("cannot render Kubernetes namespace by template '%s': %s", namespaceTemplate, err)
-	}
-
-	if renderedNamespace == "" {
-		return "", fmt.Errorf("Kubernetes namespace rendered by template '%s' is empty: namespace cannot be empty", namespaceTemplate)
-	}
-
-	var namespaceSlug bool
-	if werfConfig.Meta.Deploy.NamespaceSlug != nil {
-		namespaceSlug = *werfConfig.Meta.Deploy.NamespaceSlug
-	} else {
-		namespaceSlug = true
-	}
-
-	if namespaceSlug {
-		return slug.KubernetesNamespace(renderedNamespace), nil
-	}
-
-	err = slug.ValidateKubernetesNamespace(renderedNamespace)
-	if err != nil {
-		return "", fmt.Errorf("bad Kubernetes namespace '%s' rendered by template '%s': %s", renderedNamespace, namespaceTemplate, err)
-	}
-
-	return renderedNamespace, nil
+	return deploy.GetKubernetesNamespace(namespaceOption, environmentOption, werfConfig)
 }
 
 func GetUserExtraAnnotations(cmdData *CmdData) (map[string]string, error) {
@@ -152,36 +65,6 @@ func GetUserExtraLabels(cmdData *CmdData) (map[string]string, error) {
 	return extraLabelMap, nil
 }
 
-func renderDeployParamTemplate(templateName, templateText string, environmentOption string, werfConfig *config.WerfConfig) (string, error) {
-	tmpl := template.New(templateName).Delims("[[", "]]")
-
-	funcMap := sprig.TxtFuncMap()
-	delete(funcMap, "env")
-	delete(funcMap, "expandenv")
-
-	funcMap["project"] = func() string {
-		return werfConfig.Meta.Project
-	}
-
-	funcMap["env"] = func() (string, error) {
-		return environmentOption, nil
-	}
-
-	tmpl = tmpl.Funcs(funcMap)
-
-	tmpl, err := tmpl.Parse(templateText)
-	if err != nil {
-		return "", fmt.Errorf("bad template: %s", err)
-	}
-
-	buf := bytes.NewBuffer(nil)
-	if err := tmpl.ExecuteTemplate(buf, templateName, nil); err != nil {
-		return "", err
-	}
-
-	return buf.String(), nil
-}
-
 func StubImageInfoGetters(werfConfig *config.WerfConfig) (list []*image.InfoGetter) {
 	var imagesNames []string
 	for _, imageConfig := range werfConfig.StapelImages {
@@ -199,47 +82,118 @@ func StubImageInfoGetters(werfConfig *config.WerfConfig) (list []*image.InfoGett
 }
 
 func MakeChartDirLoadFunc(ctx context.Context, localGitRepo *git_repo.Local, projectDir string) func(dir string) ([]*loader.BufferedFile, error) {
-	if giterminism_inspector.LooseGiterminism || localGitRepo == nil {
-		return nil
-	}
-	return func(dir string) ([]*loader.BufferedFile, error) {
-		return werf_chart.GiterministicFilesLoader(ctx, localGitRepo, projectDir, dir)
-	}
+	return deploy.MakeChartDirLoadFunc(ctx, localGitRepo, projectDir)
 }
 
 func MakeLocateChartFunc(ctx context.Context, localGitRepo *git_repo.Local, projectDir string) func(name string, settings *cli.EnvSettings) (string, error) {
-	if giterminism_inspector.LooseGiterminism || localGitRepo == nil {
-		return nil
+	return deploy.MakeLocateChartFunc(ctx, localGitRepo, projectDir)
+}
+
+func MakeHelmReadFileFunc(ctx context.Context, localGitRepo *git_repo.Local, projectDir string) func(filePath string) ([]byte, error) {
+	return deploy.MakeHelmReadFileFunc(ctx, localGitRepo, projectDir)
+}
+
+// GetDeployOptions returns options to render or converge the project with api.Render and api.Converge.
+func GetDeployOptions(cmdData *CmdData, werfConfig *config.WerfConfig, stagesStorageAddress string) (api.DeployOptions, error) {
+	buildOptions, err := GetBuildOptions(cmdData, werfConfig)
+	if err != nil {
+		return api.DeployOptions{}, err
 	}
 
-	return func(name string, settings *cli.EnvSettings) (string, error) {
-		commit, err := localGitRepo.HeadCommit(ctx)
-		if err != nil {
-			return "", fmt.Errorf("unable to get local repo head commit: %s", err)
-		}
+	conveyorOptions, err := GetConveyorOptionsWithParallel(cmdData, buildOptions)
+	if err != nil {
+		return api.DeployOptions{}, err
+	}
 
-		if exists, err := localGitRepo.IsCommitDirectoryExists(ctx, name, commit); err != nil {
-			return "", fmt.Errorf("error checking existence of %q in the local git repo commit %s: %s", name, commit, err)
-		} else if exists {
-			return name, nil
-		} else {
-			return "", fmt.Errorf("chart path %q not found in the local git repo commit %s", name, commit)
-		}
+	userExtraAnnotations, err := GetUserExtraAnnotations(cmdData)
+	if err != nil {
+		return api.DeployOptions{}, err
 	}
+
+	userExtraLabels, err := GetUserExtraLabels(cmdData)
+	if err != nil {
+		return api.DeployOptions{}, err
+	}
+
+	return api.DeployOptions{
+		Storage:   GetStorageOptions(cmdData, stagesStorageAddress),
+		Build:     buildOptions,
+		Conveyor:  conveyorOptions,
+		SkipBuild: *cmdData.SkipBuild,
+		Release:   *cmdData.Release,
+		Namespace: *cmdData.Namespace,
+		Values: values.Options{
+			ValueFiles:   *cmdData.Values,
+			StringValues: *cmdData.SetString,
+			Values:       *cmdData.Set,
+			FileValues:   *cmdData.SetFile,
+		},
+		SecretValueFiles: *cmdData.SecretValues,
+		IgnoreSecretKey:  *cmdData.IgnoreSecretKey,
+		ExtraAnnotations: userExtraAnnotations,
+		ExtraLabels:      userExtraLabels,
+		Validate:         GetValidateOptions(cmdData),
+	}, nil
 }
 
-func MakeHelmReadFileFunc(ctx context.Context, localGitRepo *git_repo.Local, projectDir string) func(filePath string) ([]byte, error) {
-	if giterminism_inspector.LooseGiterminism || localGitRepo == nil {
+// GetValidateOptions returns options of manifests validation or nil if the validation is not enabled.
+func GetValidateOptions(cmdData *CmdData) *validation.Options {
+	if cmdData.Validate == nil || !*cmdData.Validate {
 		return nil
 	}
 
-	return func(filePath string) ([]byte, error) {
-		commit, err := localGitRepo.HeadCommit(ctx)
-		if err != nil {
-			return nil, fmt.Errorf("unable to get local repo head commit: %s", err)
+	return &validation.Options{
+		KubeVersion: *cmdData.ValidateKubeVersion,
+		CRDFiles:    *cmdData.ValidateCRDs,
+	}
+}
+
+// GetMultiClusterWaves returns kube contexts of clusters to deploy into wave by wave and the failure policy.
+// Waves are taken from --kube-contexts options or deploy.multiCluster of werf.yaml, werf.yaml is ignored if --kube-context is specified.
+// Nil waves are returned if the release is deployed into the single cluster.
+func GetMultiClusterWaves(cmdData *CmdData, werfConfig *config.WerfConfig) ([][]string, string, error) {
+	var waves [][]string
+	var failurePolicy string
+
+	if len(*cmdData.KubeContexts) != 0 {
+		kubeContexts := map[string]bool{}
+		for _, value := range *cmdData.KubeContexts {
+			var wave []string
+			for _, kubeContext := range strings.Split(value, ",") {
+				kubeContext = strings.TrimSpace(kubeContext)
+				if kubeContext == "" {
+					return nil, "", fmt.Errorf("bad --kube-contexts value %q: empty kube context", value)
+				}
+
+				if kubeContexts[kubeContext] {
+					return nil, "", fmt.Errorf("bad --kube-contexts value %q: kube context %q is specified more than once", value, kubeContext)
+				}
+				kubeContexts[kubeContext] = true
+
+				wave = append(wave, kubeContext)
+			}
+
+			waves = append(waves, wave)
 		}
+	} else if *cmdData.KubeContext == "" && werfConfig.Meta.Deploy.MultiCluster != nil {
+		waves = werfConfig.Meta.Deploy.MultiCluster.Waves
+		failurePolicy = werfConfig.Meta.Deploy.MultiCluster.FailurePolicy
+	}
 
-		relativeFilePath := util.GetRelativeToBaseFilepath(projectDir, filePath)
-		return git_repo.ReadCommitFileAndCompareWithProjectFile(ctx, localGitRepo, commit, projectDir, relativeFilePath)
+	if waves == nil {
+		return nil, "", nil
 	}
+
+	switch *cmdData.MultiClusterFailurePolicy {
+	case "":
+		if failurePolicy == "" {
+			failurePolicy = config.MultiClusterFailurePolicyStop
+		}
+	case config.MultiClusterFailurePolicyStop, config.MultiClusterFailurePolicyContinue:
+		failurePolicy = *cmdData.MultiClusterFailurePolicy
+	default:
+		return nil, "", fmt.Errorf("bad --multi-cluster-failure-policy value %q: %q or %q expected", *cmdData.MultiClusterFailurePolicy, config.MultiClusterFailurePolicyStop, config.MultiClusterFailurePolicyContinue)
+	}
+
+	return waves, failurePolicy, nil
 }
@@ -6,25 +6,11 @@ import (
 	"os"
 	"strings"
 
-	"github.com/werf/werf/pkg/werf/global_warnings"
-
-	"github.com/werf/werf/pkg/werf/locker_with_retry"
-
-	"github.com/werf/lockgate/pkg/distributed_locker"
-	"github.com/werf/logboek"
-
 	"github.com/spf13/cobra"
 
-	"k8s.io/client-go/dynamic"
-
-	"k8s.io/client-go/kubernetes"
-
-	"github.com/werf/kubedog/pkg/kube"
-
-	"github.com/werf/werf/pkg/storage/synchronization_server"
-
+	"github.com/werf/werf/pkg/api"
 	"github.com/werf/werf/pkg/storage"
-	"github.com/werf/werf/pkg/werf"
+	"github.com/werf/werf/pkg/werf/global_warnings"
 )
 
 func SetupSynchronization(cmdData *CmdData, cmd *cobra.Command) {
@@ -42,19 +28,15 @@ Default:
 The same address should be specified for all werf processes that work with a single repo. :local address allows execution of werf processes from a single host only`, storage.DefaultKubernetesStorageAddress))
 }
 
-type SynchronizationType string
+type SynchronizationType = api.SynchronizationType
 
 const (
-	LocalSynchronization      SynchronizationType = "LocalSynchronization"
-	KubernetesSynchronization SynchronizationType = "KubernetesSynchronization"
-	HttpSynchronization       SynchronizationType = "HttpSynchronization"
+	LocalSynchronization      = api.LocalSynchronization
+	KubernetesSynchronization = api.KubernetesSynchronization
+	HttpSynchronization       = api.HttpSynchronization
 )
 
-type SynchronizationParams struct {
-	Address             string
-	SynchronizationType SynchronizationType
-	KubeParams          *storage.KubernetesSynchronizationParams
-}
+type SynchronizationParams = api.SynchronizationParams
 
 func checkSynchronizationKubernetesParamsForWarnings(cmdData *CmdData) {
 	if *cmdData.Synchronization != "" {
@@ -108,105 +90,17 @@ func checkSynchronizationKubernetesParamsForWarnings(cmdData *CmdData) {
 }
 
 func GetSynchronization(ctx context.Context, cmdData *CmdData, projectName string, stagesStorage storage.StagesStorage) (*SynchronizationParams, error) {
-	getKubeParamsFunc := func(address string) (*SynchronizationParams, error) {
-		res := &SynchronizationParams{}
-		res.SynchronizationType = KubernetesSynchronization
-		res.Address = address
-
-		if params, err := storage.ParseKubernetesSynchronization(res.Address); err != nil {
-			return nil, fmt.Errorf("unable to parse synchronization address %s: %s", res.Address, err)
-		} else {
-			res.KubeParams = params
-			return res, nil
-		}
-	}
-
-	getHttpParamsFunc := func(synchronization string, stagesStorage storage.StagesStorage) (*SynchronizationParams, error) {
-		var address string
-		if err := logboek.Default().LogProcess(fmt.Sprintf("Getting client id for the http synchronization server")).
-			DoError(func() error {
-				if clientID, err := synchronization_server.GetOrCreateClientID(ctx, projectName, synchronization_server.NewSynchronizationClient(synchronization), stagesStorage); err != nil {
-					return fmt.Errorf("unable to get synchronization client id: %s", err)
-				} else {
-					address = fmt.Sprintf("%s/%s", synchronization, clientID)
-					logboek.Default().LogF("Using clientID %q for http synchronization server at address %s\n", clientID, address)
-					return nil
-				}
-			}); err != nil {
-			return nil, err
-		}
-
-		return &SynchronizationParams{Address: address, SynchronizationType: HttpSynchronization}, nil
-	}
-
-	if *cmdData.Synchronization == "" {
-		if stagesStorage.Address() == storage.LocalStorageAddress {
-			return &SynchronizationParams{SynchronizationType: LocalSynchronization, Address: storage.LocalStorageAddress}, nil
-		} else {
-			return getHttpParamsFunc("https://synchronization.werf.io", stagesStorage)
-		}
-	} else if *cmdData.Synchronization == storage.LocalStorageAddress {
-		return &SynchronizationParams{Address: *cmdData.Synchronization, SynchronizationType: LocalSynchronization}, nil
-	} else if strings.HasPrefix(*cmdData.Synchronization, "kubernetes://") {
+	if strings.HasPrefix(*cmdData.Synchronization, "kubernetes://") {
 		checkSynchronizationKubernetesParamsForWarnings(cmdData)
-		return getKubeParamsFunc(*cmdData.Synchronization)
-	} else if strings.HasPrefix(*cmdData.Synchronization, "http://") || strings.HasPrefix(*cmdData.Synchronization, "https://") {
-		return getHttpParamsFunc(*cmdData.Synchronization, stagesStorage)
-	} else {
-		return nil, fmt.Errorf("only --synchronization=%s or --synchronization=kubernetes://NAMESPACE or --synchronization=http[s]://HOST:PORT/CLIENT_ID is supported, got %q", storage.LocalStorageAddress, *cmdData.Synchronization)
 	}
+
+	return api.GetSynchronization(ctx, projectName, *cmdData.Synchronization, stagesStorage)
 }
 
 func GetStagesStorageCache(synchronization *SynchronizationParams) (storage.StagesStorageCache, error) {
-	switch synchronization.SynchronizationType {
-	case LocalSynchronization:
-		return storage.NewFileStagesStorageCache(werf.GetStagesStorageCacheDir()), nil
-	case KubernetesSynchronization:
-		if config, err := kube.GetKubeConfig(kube.KubeConfigOptions{
-			ConfigPath:       synchronization.KubeParams.ConfigPath,
-			ConfigDataBase64: synchronization.KubeParams.ConfigDataBase64,
-			Context:          synchronization.KubeParams.ConfigContext,
-		}); err != nil {
-			return nil, fmt.Errorf("unable to load synchronization kube config %q (context %q)", synchronization.KubeParams.ConfigPath, synchronization.KubeParams.ConfigContext)
-		} else if client, err := kubernetes.NewForConfig(config.Config); err != nil {
-			return nil, fmt.Errorf("unable to create synchronization kubernetes client: %s", err)
-		} else {
-			return storage.NewKubernetesStagesStorageCache(synchronization.KubeParams.Namespace, client, func(projectName string) string {
-				return fmt.Sprintf("werf-%s", projectName)
-			}), nil
-		}
-	case HttpSynchronization:
-		return synchronization_server.NewStagesStorageCacheHttpClient(fmt.Sprintf("%s/stages-storage-cache", synchronization.Address)), nil
-	default:
-		panic(fmt.Sprintf("unsupported synchronization address %q", synchronization.Address))
-	}
+	return api.GetStagesStorageCache(synchronization)
 }
 
 func GetStorageLockManager(ctx context.Context, synchronization *SynchronizationParams) (storage.LockManager, error) {
-	switch synchronization.SynchronizationType {
-	case LocalSynchronization:
-		return storage.NewGenericLockManager(werf.GetHostLocker()), nil
-	case KubernetesSynchronization:
-		if config, err := kube.GetKubeConfig(kube.KubeConfigOptions{
-			ConfigPath:       synchronization.KubeParams.ConfigPath,
-			ConfigDataBase64: synchronization.KubeParams.ConfigDataBase64,
-			Context:          synchronization.KubeParams.ConfigContext,
-		}); err != nil {
-			return nil, fmt.Errorf("unable to load synchronization kube config %q (context %q)", synchronization.KubeParams.ConfigPath, synchronization.KubeParams.ConfigContext)
-		} else if dynamicClient, err := dynamic.NewForConfig(config.Config); err != nil {
-			return nil, fmt.Errorf("unable to create synchronization kubernetes dynamic client: %s", err)
-		} else if client, err := kubernetes.NewForConfig(config.Config); err != nil {
-			return nil, fmt.Errorf("unable to create synchronization kubernetes client: %s", err)
-		} else {
-			return storage.NewKubernetesLockManager(synchronization.KubeParams.Namespace, client, dynamicClient, func(projectName string) string {
-				return fmt.Sprintf("werf-%s", projectName)
-			}), nil
-		}
-	case HttpSynchronization:
-		locker := distributed_locker.NewHttpLocker(fmt.Sprintf("%s/locker", synchronization.Address))
-		lockerWithRetry := locker_with_retry.NewLockerWithRetry(ctx, locker, locker_with_retry.LockerWithRetryOptions{MaxAcquireAttempts: 10, MaxReleaseAttempts: 10})
-		return storage.NewGenericLockManager(lockerWithRetry), nil
-	default:
-		panic(fmt.Sprintf("unsupported synchronization address %q", synchronization.Address))
-	}
+	return api.GetStorageLockManager(ctx, synchronization)
 }
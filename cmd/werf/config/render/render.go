@@ -5,9 +5,12 @@ import (
 
 	"github.com/spf13/cobra"
 
+	"github.com/werf/logboek"
+
 	"github.com/werf/werf/cmd/werf/common"
 	"github.com/werf/werf/pkg/config"
 	"github.com/werf/werf/pkg/git_repo"
+	"github.com/werf/werf/pkg/ssh_agent"
 	"github.com/werf/werf/pkg/true_git"
 	"github.com/werf/werf/pkg/werf"
 )
@@ -20,6 +23,8 @@ func NewCmd() *cobra.Command {
 		DisableFlagsInUseLine: true,
 		Short:                 "Render werf.yaml",
 		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := common.BackgroundContext()
+
 			if err := common.ProcessLogOptions(&commonCmdData); err != nil {
 				common.PrintHelp(cmd)
 				return err
@@ -41,6 +46,20 @@ func NewCmd() *cobra.Command {
 				return err
 			}
 
+			if err := common.InitGitHTTPSCredentials(&commonCmdData); err != nil {
+				return fmt.Errorf("cannot initialize git https credentials: %s", err)
+			}
+
+			if err := ssh_agent.Init(ctx, *commonCmdData.SSHKeys); err != nil {
+				return fmt.Errorf("cannot initialize ssh agent: %s", err)
+			}
+			defer func() {
+				err := ssh_agent.Terminate()
+				if err != nil {
+					logboek.Warn().LogF("WARNING: ssh agent termination failed: %s\n", err)
+				}
+			}()
+
 			projectDir, err := common.GetProjectDir(&commonCmdData)
 			if err != nil {
 				return fmt.Errorf("getting project dir failed: %s", err)
@@ -61,17 +80,21 @@ func NewCmd() *cobra.Command {
 
 			werfConfigTemplatesDir := common.GetWerfConfigTemplatesDir(projectDir, &commonCmdData)
 
-			return config.RenderWerfConfig(common.BackgroundContext(), projectDir, werfConfigPath, werfConfigTemplatesDir, args, localGitRepo, configOpts)
+			return config.RenderWerfConfig(ctx, projectDir, werfConfigPath, werfConfigTemplatesDir, args, localGitRepo, configOpts)
 		},
 	}
 
 	common.SetupDir(&commonCmdData, cmd)
 	common.SetupConfigTemplatesDir(&commonCmdData, cmd)
+	common.SetupConfigValues(&commonCmdData, cmd)
 	common.SetupConfigPath(&commonCmdData, cmd)
 	common.SetupEnvironment(&commonCmdData, cmd)
 
 	common.SetupGiterminismInspectorOptions(&commonCmdData, cmd)
 
+	common.SetupSSHKey(&commonCmdData, cmd)
+	common.SetupGitHTTPSCredentials(&commonCmdData, cmd)
+
 	common.SetupTmpDir(&commonCmdData, cmd)
 	common.SetupHomeDir(&commonCmdData, cmd)
 
@@ -0,0 +1,35 @@
+package schema
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/werf/werf/cmd/werf/common"
+	"github.com/werf/werf/pkg/config"
+)
+
+func NewCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                   "schema",
+		DisableFlagsInUseLine: true,
+		Short:                 "Print JSON Schema of werf.yaml sections",
+		Long: common.GetLongCommandDescription(`Print JSON Schema of werf.yaml sections.
+
+The schema describes every section (YAML document) of the rendered werf.yaml: the meta section, stapel image, artifact and Dockerfile image sections. The schema can be used by editors with YAML language server to complete and check werf.yaml.`),
+		Example: `  # Save the schema to use it in the editor
+  $ werf config schema > werf-schema.json`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			data, err := config.GetWerfConfigJSONSchema().MarshalIndent()
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(data))
+
+			return nil
+		},
+	}
+
+	return cmd
+}
@@ -0,0 +1,154 @@
+package validate
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/werf/logboek"
+
+	"github.com/werf/werf/cmd/werf/common"
+	"github.com/werf/werf/pkg/config"
+	"github.com/werf/werf/pkg/git_repo"
+	"github.com/werf/werf/pkg/ssh_agent"
+	"github.com/werf/werf/pkg/true_git"
+	"github.com/werf/werf/pkg/werf"
+)
+
+var commonCmdData common.CmdData
+
+func NewCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                   "validate",
+		DisableFlagsInUseLine: true,
+		Short:                 "Validate werf.yaml and report all errors at once",
+		Long: common.GetLongCommandDescription(`Validate werf.yaml and report all errors at once.
+
+Every config section of the rendered werf.yaml is checked against the JSON Schema (see werf config schema), then semantic checks of the section are performed. References between sections (e.g. fromImage and import) are checked if all sections are valid.
+
+Errors are reported with the line in the rendered config and the line in werf.yaml if the rendered line is found in werf.yaml as is (lines produced by template actions and included templates are reported only by the rendered line).
+
+The command exits with the error if werf.yaml is not valid.`),
+		Example: `  # Validate werf.yaml in the current dir
+  $ werf config validate
+
+  # Validate werf.yaml rendered for the production environment and get errors in JSON
+  $ werf config validate --env production --output-format json`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if err := common.ProcessLogOptions(&commonCmdData); err != nil {
+				common.PrintHelp(cmd)
+				return err
+			}
+
+			return run()
+		},
+	}
+
+	common.SetupDir(&commonCmdData, cmd)
+	common.SetupConfigTemplatesDir(&commonCmdData, cmd)
+	common.SetupConfigValues(&commonCmdData, cmd)
+	common.SetupConfigPath(&commonCmdData, cmd)
+	common.SetupEnvironment(&commonCmdData, cmd)
+
+	common.SetupGiterminismInspectorOptions(&commonCmdData, cmd)
+
+	common.SetupSSHKey(&commonCmdData, cmd)
+	common.SetupGitHTTPSCredentials(&commonCmdData, cmd)
+
+	common.SetupTmpDir(&commonCmdData, cmd)
+	common.SetupHomeDir(&commonCmdData, cmd)
+
+	common.SetupLogOptions(&commonCmdData, cmd)
+	common.SetupOutputFormat(&commonCmdData, cmd)
+
+	return cmd
+}
+
+func run() error {
+	ctx := common.BackgroundContext()
+
+	outputFormat, err := common.GetOutputFormat(&commonCmdData)
+	if err != nil {
+		return err
+	}
+
+	if err := werf.Init(*commonCmdData.TmpDir, *commonCmdData.HomeDir); err != nil {
+		return fmt.Errorf("initialization error: %s", err)
+	}
+
+	if err := common.InitGiterminismInspector(&commonCmdData); err != nil {
+		return err
+	}
+
+	if err := git_repo.Init(); err != nil {
+		return err
+	}
+
+	if err := true_git.Init(true_git.Options{LiveGitOutput: *commonCmdData.LogVerbose || *commonCmdData.LogDebug}); err != nil {
+		return err
+	}
+
+	if err := common.InitGitHTTPSCredentials(&commonCmdData); err != nil {
+		return fmt.Errorf("cannot initialize git https credentials: %s", err)
+	}
+
+	if err := ssh_agent.Init(ctx, *commonCmdData.SSHKeys); err != nil {
+		return fmt.Errorf("cannot initialize ssh agent: %s", err)
+	}
+	defer func() {
+		err := ssh_agent.Terminate()
+		if err != nil {
+			logboek.Warn().LogF("WARNING: ssh agent termination failed: %s\n", err)
+		}
+	}()
+
+	projectDir, err := common.GetProjectDir(&commonCmdData)
+	if err != nil {
+		return fmt.Errorf("getting project dir failed: %s", err)
+	}
+
+	localGitRepo, err := common.OpenLocalGitRepo(projectDir)
+	if err != nil {
+		return fmt.Errorf("unable to open local repo %s: %s", projectDir, err)
+	}
+
+	werfConfigPath, err := common.GetWerfConfigPath(projectDir, *commonCmdData.ConfigPath, true, localGitRepo)
+	if err != nil {
+		return err
+	}
+
+	werfConfigTemplatesDir := common.GetWerfConfigTemplatesDir(projectDir, &commonCmdData)
+
+	validationErrors, err := config.ValidateWerfConfig(ctx, projectDir, werfConfigPath, werfConfigTemplatesDir, localGitRepo, common.GetWerfConfigOptions(&commonCmdData, false))
+	if err != nil {
+		return err
+	}
+
+	switch outputFormat {
+	case common.OutputFormatJSON:
+		if validationErrors == nil {
+			validationErrors = []*config.ValidationError{}
+		}
+
+		data, err := json.MarshalIndent(validationErrors, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(data))
+	default:
+		for _, validationErr := range validationErrors {
+			fmt.Println(validationErr.String())
+		}
+	}
+
+	if len(validationErrors) != 0 {
+		return fmt.Errorf("werf config is not valid, errors found: %d", len(validationErrors))
+	}
+
+	if outputFormat != common.OutputFormatJSON {
+		fmt.Println("werf config is valid")
+	}
+
+	return nil
+}
@@ -2,36 +2,16 @@ package converge
 
 import (
 	"context"
-	"fmt"
 	"time"
 
-	cmd_helm "helm.sh/helm/v3/cmd/helm"
-	"helm.sh/helm/v3/pkg/action"
-	"helm.sh/helm/v3/pkg/chart"
-	"helm.sh/helm/v3/pkg/chart/loader"
-	"helm.sh/helm/v3/pkg/cli/values"
-
 	"github.com/spf13/cobra"
 
 	"github.com/werf/kubedog/pkg/kube"
 	"github.com/werf/logboek"
 
 	"github.com/werf/werf/cmd/werf/common"
-	"github.com/werf/werf/pkg/build"
-	"github.com/werf/werf/pkg/container_runtime"
-	"github.com/werf/werf/pkg/deploy"
-	"github.com/werf/werf/pkg/deploy/helm"
-	"github.com/werf/werf/pkg/deploy/lock_manager"
-	"github.com/werf/werf/pkg/deploy/secret"
-	"github.com/werf/werf/pkg/deploy/werf_chart"
-	"github.com/werf/werf/pkg/docker"
-	"github.com/werf/werf/pkg/git_repo"
-	"github.com/werf/werf/pkg/image"
-	"github.com/werf/werf/pkg/ssh_agent"
-	"github.com/werf/werf/pkg/storage/manager"
+	"github.com/werf/werf/pkg/api"
 	"github.com/werf/werf/pkg/tmp_manager"
-	"github.com/werf/werf/pkg/true_git"
-	"github.com/werf/werf/pkg/werf"
 	"github.com/werf/werf/pkg/werf/global_warnings"
 )
 
@@ -52,9 +32,14 @@ The result of converge command is an application deployed into Kubernetes for cu
 
 Environment is a required param for the deploy by default, because it is needed to construct Helm Release name and Kubernetes Namespace. Either --env or $WERF_ENV should be specified for command.
 
+The release can be deployed into multiple clusters with --kube-contexts options or deploy.multiCluster section of werf.yaml: images are built once, then clusters are deployed wave by wave, clusters of the wave are deployed one by one.
+
 Read more info about Helm chart structure, Helm Release name, Kubernetes Namespace and how to change it: https://werf.io/documentation/advanced/helm/basics.html`),
 		Example: `# Build and deploy current application state into production environment
-werf converge --repo registry.mydomain.com/web --env production`,
+werf converge --repo registry.mydomain.com/web --env production
+
+# Build once and deploy into eu-west and eu-central clusters, then into us-east cluster
+werf converge --repo registry.mydomain.com/web --env production --kube-contexts=eu-west,eu-central --kube-contexts=us-east`,
 		DisableFlagsInUseLine: true,
 		Annotations: map[string]string{
 			common.CmdEnvAnno: common.EnvsDescription(common.WerfDebugAnsibleArgs, common.WerfSecretKey),
@@ -78,6 +63,7 @@ werf converge --repo registry.mydomain.com/web --env production`,
 
 	common.SetupDir(&commonCmdData, cmd)
 	common.SetupConfigTemplatesDir(&commonCmdData, cmd)
+	common.SetupConfigValues(&commonCmdData, cmd)
 	common.SetupConfigPath(&commonCmdData, cmd)
 	common.SetupEnvironment(&commonCmdData, cmd)
 
@@ -86,11 +72,15 @@ werf converge --repo registry.mydomain.com/web --env production`,
 	common.SetupTmpDir(&commonCmdData, cmd)
 	common.SetupHomeDir(&commonCmdData, cmd)
 	common.SetupSSHKey(&commonCmdData, cmd)
+	common.SetupGitHTTPSCredentials(&commonCmdData, cmd)
 
 	common.SetupIntrospectAfterError(&commonCmdData, cmd)
 	common.SetupIntrospectBeforeError(&commonCmdData, cmd)
 	common.SetupIntrospectStage(&commonCmdData, cmd)
 
+	common.SetupReproducible(&commonCmdData, cmd)
+	common.SetupImportServer(&commonCmdData, cmd)
+
 	common.SetupSecondaryStagesStorageOptions(&commonCmdData, cmd)
 	common.SetupStagesStorageOptions(&commonCmdData, cmd)
 
@@ -106,6 +96,7 @@ werf converge --repo registry.mydomain.com/web --env production`,
 	common.SetupKubeConfig(&commonCmdData, cmd)
 	common.SetupKubeConfigBase64(&commonCmdData, cmd)
 	common.SetupKubeContext(&commonCmdData, cmd)
+	common.SetupMultiCluster(&commonCmdData, cmd)
 
 	common.SetupStatusProgressPeriod(&commonCmdData, cmd)
 	common.SetupHooksStatusProgressPeriod(&commonCmdData, cmd)
@@ -123,6 +114,8 @@ werf converge --repo registry.mydomain.com/web --env production`,
 	common.SetupSecretValues(&commonCmdData, cmd)
 	common.SetupIgnoreSecretKey(&commonCmdData, cmd)
 
+	common.SetupValidate(&commonCmdData, cmd)
+
 	common.SetupReportPath(&commonCmdData, cmd)
 	common.SetupReportFormat(&commonCmdData, cmd)
 
@@ -146,260 +139,75 @@ werf converge --repo registry.mydomain.com/web --env production`,
 func runMain(ctx context.Context) error {
 	tmp_manager.AutoGCEnabled = true
 
-	if err := werf.Init(*commonCmdData.TmpDir, *commonCmdData.HomeDir); err != nil {
-		return fmt.Errorf("initialization error: %s", err)
-	}
-
-	if err := common.InitGiterminismInspector(&commonCmdData); err != nil {
-		return err
-	}
-
-	if err := git_repo.Init(); err != nil {
-		return err
-	}
-
-	if err := image.Init(); err != nil {
-		return err
-	}
-
-	if err := true_git.Init(true_git.Options{LiveGitOutput: *commonCmdData.LogVerbose || *commonCmdData.LogDebug}); err != nil {
-		return err
-	}
-
-	if err := common.DockerRegistryInit(&commonCmdData); err != nil {
-		return err
-	}
-
-	if err := docker.Init(ctx, *commonCmdData.DockerConfig, *commonCmdData.LogVerbose, *commonCmdData.LogDebug); err != nil {
-		return err
-	}
-
-	ctxWithDockerCli, err := docker.NewContext(ctx)
+	initOptions, err := common.GetInitOptions(&commonCmdData)
 	if err != nil {
 		return err
 	}
-	ctx = ctxWithDockerCli
 
-	projectDir, err := common.GetProjectDir(&commonCmdData)
+	ctx, err = api.Init(ctx, initOptions)
 	if err != nil {
-		return fmt.Errorf("getting project dir failed: %s", err)
-	}
-
-	common.ProcessLogProjectDir(&commonCmdData, projectDir)
-
-	if err := ssh_agent.Init(ctx, *commonCmdData.SSHKeys); err != nil {
-		return fmt.Errorf("cannot initialize ssh agent: %s", err)
+		return err
 	}
 	defer func() {
-		err := ssh_agent.Terminate()
-		if err != nil {
+		if err := api.Terminate(); err != nil {
 			logboek.Warn().LogF("WARNING: ssh agent termination failed: %s\n", err)
 		}
 	}()
 
+	common.ProcessLogProjectDir(&commonCmdData, initOptions.ProjectDir)
+
 	common.SetupOndemandKubeInitializer(*commonCmdData.KubeContext, *commonCmdData.KubeConfig, *commonCmdData.KubeConfigBase64)
-	if err := common.GetOndemandKubeInitializer().Init(ctx); err != nil {
-		return err
-	}
 
 	if *commonCmdData.Follow {
 		logboek.LogOptionalLn()
 		return common.FollowGitHead(ctx, &commonCmdData, func(ctx context.Context) error {
-			return run(ctx, projectDir)
+			return run(ctx, initOptions.ProjectDir)
 		})
 	} else {
-		return run(ctx, projectDir)
+		return run(ctx, initOptions.ProjectDir)
 	}
 }
 
 func run(ctx context.Context, projectDir string) error {
-	localGitRepo, err := common.OpenLocalGitRepo(projectDir)
-	if err != nil {
-		return fmt.Errorf("unable to open local repo %s: %s", projectDir, err)
-	}
-
-	werfConfig, err := common.GetRequiredWerfConfig(ctx, projectDir, &commonCmdData, localGitRepo, common.GetWerfConfigOptions(&commonCmdData, true))
-	if err != nil {
-		return fmt.Errorf("unable to load werf config: %s", err)
-	}
-
-	chartDir, err := common.GetHelmChartDir(projectDir, &commonCmdData, werfConfig)
-	if err != nil {
-		return fmt.Errorf("getting helm chart dir failed: %s", err)
-	}
-
-	projectName := werfConfig.Meta.Project
-
-	projectTmpDir, err := tmp_manager.CreateProjectDir(ctx)
-	if err != nil {
-		return fmt.Errorf("getting project tmp dir failed: %s", err)
-	}
-	defer tmp_manager.ReleaseProjectDir(projectTmpDir)
-
-	buildOptions, err := common.GetBuildOptions(&commonCmdData, werfConfig)
-	if err != nil {
-		return err
-	}
-
-	var imagesInfoGetters []*image.InfoGetter
-	var imagesRepository string
-	if len(werfConfig.StapelImages) != 0 || len(werfConfig.ImagesFromDockerfile) != 0 {
-		stagesStorageAddress, err := common.GetStagesStorageAddress(&commonCmdData)
-		if err != nil {
-			return err
-		}
-		containerRuntime := &container_runtime.LocalDockerServerRuntime{} // TODO
-		stagesStorage, err := common.GetStagesStorage(stagesStorageAddress, containerRuntime, &commonCmdData)
-		if err != nil {
-			return err
-		}
-		logboek.LogOptionalLn()
-		synchronization, err := common.GetSynchronization(ctx, &commonCmdData, projectName, stagesStorage)
-		if err != nil {
-			return err
-		}
-		stagesStorageCache, err := common.GetStagesStorageCache(synchronization)
-		if err != nil {
-			return err
-		}
-		storageLockManager, err := common.GetStorageLockManager(ctx, synchronization)
-		if err != nil {
-			return err
-		}
-		secondaryStagesStorageList, err := common.GetSecondaryStagesStorageList(stagesStorage, containerRuntime, &commonCmdData)
-		if err != nil {
-			return err
-		}
-
-		storageManager := manager.NewStorageManager(projectName, stagesStorage, secondaryStagesStorageList, storageLockManager, stagesStorageCache)
-
-		imagesRepository = storageManager.StagesStorage.String()
-
-		conveyorOptions, err := common.GetConveyorOptionsWithParallel(&commonCmdData, buildOptions)
-		if err != nil {
-			return err
-		}
-
-		conveyorWithRetry := build.NewConveyorWithRetryWrapper(werfConfig, localGitRepo, nil, projectDir, projectTmpDir, ssh_agent.SSHAuthSock, containerRuntime, storageManager, storageLockManager, conveyorOptions)
-		defer conveyorWithRetry.Terminate()
-
-		if err := conveyorWithRetry.WithRetryBlock(ctx, func(c *build.Conveyor) error {
-			if *commonCmdData.SkipBuild {
-				if err := c.ShouldBeBuilt(ctx); err != nil {
-					return err
-				}
-			} else {
-				if err := c.Build(ctx, buildOptions); err != nil {
-					return err
-				}
-			}
-
-			imagesInfoGetters = c.GetImageInfoGetters()
-
-			return nil
-		}); err != nil {
-			return err
-		}
-
-		logboek.LogOptionalLn()
-	}
-
-	var secretsManager secret.Manager
-	if m, err := deploy.GetSafeSecretManager(ctx, projectDir, chartDir, *commonCmdData.SecretValues, localGitRepo, *commonCmdData.IgnoreSecretKey); err != nil {
-		return err
-	} else {
-		secretsManager = m
-	}
-
-	releaseName, err := common.GetHelmRelease(*commonCmdData.Release, *commonCmdData.Environment, werfConfig)
+	project, err := api.OpenProject(ctx, common.GetProjectOptions(&commonCmdData, projectDir, true))
 	if err != nil {
 		return err
 	}
 
-	namespace, err := common.GetKubernetesNamespace(*commonCmdData.Namespace, *commonCmdData.Environment, werfConfig)
+	deployOptions, err := common.GetDeployOptions(&commonCmdData, project.WerfConfig, common.GetOptionalStagesStorageAddress(&commonCmdData))
 	if err != nil {
 		return err
 	}
 
-	userExtraAnnotations, err := common.GetUserExtraAnnotations(&commonCmdData)
+	waves, failurePolicy, err := common.GetMultiClusterWaves(&commonCmdData, project.WerfConfig)
 	if err != nil {
 		return err
 	}
 
-	userExtraLabels, err := common.GetUserExtraLabels(&commonCmdData)
-	if err != nil {
-		return err
-	}
-
-	var lockManager *lock_manager.LockManager
-	if m, err := lock_manager.NewLockManager(namespace); err != nil {
-		return fmt.Errorf("unable to create lock manager: %s", err)
-	} else {
-		lockManager = m
-	}
-
-	wc := werf_chart.NewWerfChart(ctx, localGitRepo, projectDir, werf_chart.WerfChartOptions{
-		ReleaseName: releaseName,
-		ChartDir:    chartDir,
-
-		SecretValueFiles: *commonCmdData.SecretValues,
-		ExtraAnnotations: userExtraAnnotations,
-		ExtraLabels:      userExtraLabels,
-
-		LockManager:    lockManager,
-		SecretsManager: secretsManager,
-	})
-	if err := wc.SetEnv(*commonCmdData.Environment); err != nil {
-		return err
-	}
-	if err := wc.SetWerfConfig(werfConfig); err != nil {
-		return err
-	}
-	if vals, err := werf_chart.GetServiceValues(ctx, werfConfig.Meta.Project, imagesRepository, imagesInfoGetters, werf_chart.ServiceValuesOptions{Namespace: namespace, Env: *commonCmdData.Environment}); err != nil {
-		return fmt.Errorf("error creating service values: %s", err)
-	} else if err := wc.SetServiceValues(vals); err != nil {
-		return err
-	}
-
-	actionConfig := new(action.Configuration)
-	if err := helm.InitActionConfig(ctx, common.GetOndemandKubeInitializer(), namespace, cmd_helm.Settings, actionConfig, helm.InitActionConfigOptions{
-		StatusProgressPeriod:      time.Duration(*commonCmdData.StatusProgressPeriodSeconds) * time.Second,
-		HooksStatusProgressPeriod: time.Duration(*commonCmdData.HooksStatusProgressPeriodSeconds) * time.Second,
+	convergeOptions := api.ConvergeOptions{
+		DeployOptions: deployOptions,
 		KubeConfigOptions: kube.KubeConfigOptions{
 			Context:          *commonCmdData.KubeContext,
 			ConfigPath:       *commonCmdData.KubeConfig,
 			ConfigDataBase64: *commonCmdData.KubeConfigBase64,
 		},
-		ReleasesHistoryMax: *commonCmdData.ReleasesHistoryMax,
-	}); err != nil {
-		return err
+		KubeInitializer:           common.GetOndemandKubeInitializer(),
+		StatusProgressPeriod:      time.Duration(*commonCmdData.StatusProgressPeriodSeconds) * time.Second,
+		HooksStatusProgressPeriod: time.Duration(*commonCmdData.HooksStatusProgressPeriodSeconds) * time.Second,
+		ReleasesHistoryMax:        *commonCmdData.ReleasesHistoryMax,
+		Timeout:                   time.Duration(cmdData.Timeout),
+		AutoRollback:              cmdData.AutoRollback,
 	}
 
-	loader.GlobalLoadOptions = &loader.LoadOptions{
-		ChartExtender: wc,
-		SubchartExtenderFactoryFunc: func() chart.ChartExtender {
-			return werf_chart.NewWerfChart(ctx, nil, projectDir, werf_chart.WerfChartOptions{})
-		},
-		LoadDirFunc:     common.MakeChartDirLoadFunc(ctx, localGitRepo, projectDir),
-		LocateChartFunc: common.MakeLocateChartFunc(ctx, localGitRepo, projectDir),
-		ReadFileFunc:    common.MakeHelmReadFileFunc(ctx, localGitRepo, projectDir),
+	if waves != nil {
+		return runMultiCluster(ctx, project, convergeOptions, waves, failurePolicy)
 	}
 
-	helmUpgradeCmd, _ := cmd_helm.NewUpgradeCmd(actionConfig, logboek.ProxyOutStream(), cmd_helm.UpgradeCmdOptions{
-		PostRenderer: wc.ExtraAnnotationsAndLabelsPostRenderer,
-		ValueOpts: &values.Options{
-			ValueFiles:   *commonCmdData.Values,
-			StringValues: *commonCmdData.SetString,
-			Values:       *commonCmdData.Set,
-			FileValues:   *commonCmdData.SetFile,
-		},
-		CreateNamespace: common.NewBool(true),
-		Install:         common.NewBool(true),
-		Wait:            common.NewBool(true),
-		Atomic:          common.NewBool(cmdData.AutoRollback),
-		Timeout:         common.NewDuration(time.Duration(cmdData.Timeout)),
-	})
-	return wc.WrapUpgrade(ctx, func() error {
-		return helmUpgradeCmd.RunE(helmUpgradeCmd, []string{releaseName, chartDir})
-	})
+	if err := common.GetOndemandKubeInitializer().Init(ctx); err != nil {
+		return err
+	}
+
+	_, err = api.Converge(ctx, project, convergeOptions)
+
+	return err
 }
@@ -0,0 +1,31 @@
+package converge
+
+import (
+	"context"
+
+	"github.com/werf/logboek"
+
+	"github.com/werf/werf/pkg/api"
+)
+
+func runMultiCluster(ctx context.Context, project *api.Project, convergeOptions api.ConvergeOptions, waves [][]string, failurePolicy string) error {
+	res, err := api.ConvergeClusters(ctx, project, api.ConvergeClustersOptions{
+		ConvergeOptions: convergeOptions,
+		Waves:           waves,
+		FailurePolicy:   failurePolicy,
+	})
+
+	if res != nil && res.Clusters != nil {
+		logboek.Context(ctx).LogOptionalLn()
+		logboek.Context(ctx).Default().LogBlock("Clusters of release %s (namespace %s)", res.ReleaseName, res.Namespace).Do(func() {
+			for _, clusterRes := range res.Clusters {
+				logboek.Context(ctx).Default().LogF("wave %d  %-10s %s\n", clusterRes.Wave, clusterRes.Status, clusterRes.KubeContext)
+				if clusterRes.Error != "" {
+					logboek.Context(ctx).Default().LogF("  %s\n", clusterRes.Error)
+				}
+			}
+		})
+	}
+
+	return err
+}
@@ -70,6 +70,7 @@ Read more info about Helm Release name, Kubernetes Namespace and how to change i
 
 	common.SetupTmpDir(&commonCmdData, cmd)
 	common.SetupConfigTemplatesDir(&commonCmdData, cmd)
+	common.SetupConfigValues(&commonCmdData, cmd)
 	common.SetupConfigPath(&commonCmdData, cmd)
 	common.SetupEnvironment(&commonCmdData, cmd)
 
@@ -149,7 +149,7 @@ permalink: documentation/reference/cli/%s.html
 	}
 
 	for _, command := range cmd.Commands() {
-		if cmd.Hidden {
+		if command.Hidden {
 			continue
 		}
 
@@ -229,7 +229,7 @@ func genCliSidebar(cmd *cobra.Command, indent int, buf *bytes.Buffer) error {
 
 		indent += 1
 		for _, command := range cmd.Commands() {
-			if cmd.Hidden {
+			if command.Hidden {
 				continue
 			}
 
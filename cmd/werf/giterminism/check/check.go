@@ -0,0 +1,285 @@
+package check
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"helm.sh/helm/v3/pkg/cli/values"
+
+	"github.com/werf/logboek"
+
+	"github.com/werf/werf/cmd/werf/common"
+	"github.com/werf/werf/pkg/api"
+	"github.com/werf/werf/pkg/build"
+	"github.com/werf/werf/pkg/config"
+	"github.com/werf/werf/pkg/deploy"
+	"github.com/werf/werf/pkg/deploy/werf_chart"
+	"github.com/werf/werf/pkg/git_repo"
+	"github.com/werf/werf/pkg/giterminism_inspector"
+	"github.com/werf/werf/pkg/ssh_agent"
+	"github.com/werf/werf/pkg/true_git"
+	"github.com/werf/werf/pkg/werf"
+)
+
+var commonCmdData common.CmdData
+
+func NewCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                   "check",
+		DisableFlagsInUseLine: true,
+		Short:                 "Check the project for giterminism violations and report all of them at once",
+		Long: common.GetLongCommandDescription(`Check the project for giterminism violations and report all of them at once.
+
+The command walks werf.yaml rendering (templates, env variables, config values, mounts), dockerfile images (dockerfile, .dockerignore, contextAddFile directives and uncommitted files of the context), files of the helm chart (including secret values and secret files), values files specified with --values, --secret-values and --set-file options and keys of values specified with --set, --set-string and --set-file options.
+
+Every violation is reported with its source and the werf-giterminism.yaml snippet to allow the violation if the violation can be allowed. The werf-giterminism.yaml directives which allow all reported violations are printed at the end. Pinned commits of remote repositories werf.yaml templates are imported from are listed before violations.
+
+The command exits with the error if any violation is found.`),
+		Example: `  # Check the project in the current dir
+  $ werf giterminism check
+
+  # Check the project for the production environment and get violations in JSON
+  $ werf giterminism check --env production --values .helm/values_production.yaml --output-format json`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if err := common.ProcessLogOptions(&commonCmdData); err != nil {
+				common.PrintHelp(cmd)
+				return err
+			}
+
+			return run()
+		},
+	}
+
+	common.SetupDir(&commonCmdData, cmd)
+	common.SetupConfigTemplatesDir(&commonCmdData, cmd)
+	common.SetupConfigValues(&commonCmdData, cmd)
+	common.SetupConfigPath(&commonCmdData, cmd)
+	common.SetupEnvironment(&commonCmdData, cmd)
+
+	common.SetupGiterminismInspectorOptions(&commonCmdData, cmd)
+
+	common.SetupSSHKey(&commonCmdData, cmd)
+	common.SetupGitHTTPSCredentials(&commonCmdData, cmd)
+
+	common.SetupSet(&commonCmdData, cmd)
+	common.SetupSetString(&commonCmdData, cmd)
+	common.SetupValues(&commonCmdData, cmd)
+	common.SetupSetFile(&commonCmdData, cmd)
+	common.SetupSecretValues(&commonCmdData, cmd)
+
+	common.SetupTmpDir(&commonCmdData, cmd)
+	common.SetupHomeDir(&commonCmdData, cmd)
+
+	common.SetupLogOptions(&commonCmdData, cmd)
+	common.SetupOutputFormat(&commonCmdData, cmd)
+
+	return cmd
+}
+
+type checkResult struct {
+	Violations []*giterminism_inspector.Violation `json:"violations"`
+	// AllowConfig is werf-giterminism.yaml which allows all violations which can be allowed
+	AllowConfig string `json:"allowConfig,omitempty"`
+	// RemoteTemplates are pinned commits of remote repositories werf.yaml templates are imported from
+	RemoteTemplates []*config.RemoteTemplates `json:"remoteTemplates,omitempty"`
+}
+
+func run() error {
+	ctx := common.BackgroundContext()
+
+	outputFormat, err := common.GetOutputFormat(&commonCmdData)
+	if err != nil {
+		return err
+	}
+
+	if err := werf.Init(*commonCmdData.TmpDir, *commonCmdData.HomeDir); err != nil {
+		return fmt.Errorf("initialization error: %s", err)
+	}
+
+	if err := common.InitGiterminismInspector(&commonCmdData); err != nil {
+		return err
+	}
+
+	if giterminism_inspector.LooseGiterminism {
+		return fmt.Errorf("giterminism cannot be checked with the loose giterminism mode")
+	}
+	giterminism_inspector.CheckMode = true
+
+	if err := git_repo.Init(); err != nil {
+		return err
+	}
+
+	if err := true_git.Init(true_git.Options{LiveGitOutput: *commonCmdData.LogVerbose || *commonCmdData.LogDebug}); err != nil {
+		return err
+	}
+
+	if err := common.InitGitHTTPSCredentials(&commonCmdData); err != nil {
+		return fmt.Errorf("cannot initialize git https credentials: %s", err)
+	}
+
+	if err := ssh_agent.Init(ctx, *commonCmdData.SSHKeys); err != nil {
+		return fmt.Errorf("cannot initialize ssh agent: %s", err)
+	}
+	defer func() {
+		err := ssh_agent.Terminate()
+		if err != nil {
+			logboek.Warn().LogF("WARNING: ssh agent termination failed: %s\n", err)
+		}
+	}()
+
+	projectDir, err := common.GetProjectDir(&commonCmdData)
+	if err != nil {
+		return fmt.Errorf("getting project dir failed: %s", err)
+	}
+
+	localGitRepo, err := common.OpenLocalGitRepo(projectDir)
+	if err != nil {
+		return fmt.Errorf("unable to open local repo %s: %s", projectDir, err)
+	} else if localGitRepo == nil {
+		return fmt.Errorf("local git repository was not found")
+	}
+
+	giterminism_inspector.CheckSource = "werf.yaml"
+
+	werfConfigPath, err := common.GetWerfConfigPath(projectDir, *commonCmdData.ConfigPath, true, localGitRepo)
+	if err != nil {
+		return err
+	}
+
+	werfConfigTemplatesDir := common.GetWerfConfigTemplatesDir(projectDir, &commonCmdData)
+
+	werfConfig, err := config.GetWerfConfig(ctx, projectDir, werfConfigPath, werfConfigTemplatesDir, localGitRepo, common.GetWerfConfigOptions(&commonCmdData, false))
+	if err != nil {
+		return err
+	}
+
+	if v := giterminism_inspector.GetViolation(giterminism_inspector.ViolationUncommittedFile, werfConfigPath); v != nil {
+		v.SetAllowConfig([]string{"config", "allowUncommitted"}, true)
+	}
+
+	if err := git_repo.ReportUncommittedDirFiles(ctx, localGitRepo, projectDir, werfConfigTemplatesDir, nil); err != nil {
+		return err
+	}
+
+	for _, imageFromDockerfileConfig := range werfConfig.ImagesFromDockerfile {
+		giterminism_inspector.CheckSource = fmt.Sprintf("image %s", imageFromDockerfileConfig.Name)
+
+		if err := build.CheckImageFromDockerfileGiterminism(ctx, imageFromDockerfileConfig, projectDir, localGitRepo); err != nil {
+			return fmt.Errorf("image %s: %s", imageFromDockerfileConfig.Name, err)
+		}
+	}
+
+	giterminism_inspector.CheckSource = "helm chart"
+
+	helmChartDir := api.GetHelmChartDir(werfConfig)
+	if err := git_repo.ReportUncommittedDirFiles(ctx, localGitRepo, projectDir, helmChartDir, giterminism_inspector.IsUncommittedHelmFileAccepted); err != nil {
+		return err
+	}
+
+	for _, v := range giterminism_inspector.Violations {
+		if v.Source == giterminism_inspector.CheckSource {
+			v.SetAllowConfig([]string{"helm", "allowUncommittedFiles"}, []string{v.Path})
+		}
+	}
+
+	giterminism_inspector.CheckSource = "helm values"
+
+	valueOpts := values.Options{
+		ValueFiles:   *commonCmdData.Values,
+		StringValues: *commonCmdData.SetString,
+		Values:       *commonCmdData.Set,
+		FileValues:   *commonCmdData.SetFile,
+	}
+
+	if err := deploy.ReportHelmSetValues(ctx, valueOpts, localGitRepo); err != nil {
+		return err
+	}
+
+	// values files are read the same way as during the deploy
+	if _, err := valueOpts.MergeValues(nil, deploy.MakeHelmReadFileFunc(ctx, localGitRepo, projectDir)); err != nil {
+		return err
+	}
+
+	commit, err := localGitRepo.HeadCommit(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to get local repo head commit: %s", err)
+	}
+
+	for _, path := range *commonCmdData.SecretValues {
+		// secret values are not decrypted, only files are checked
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(projectDir, path)
+		}
+
+		if _, err := werf_chart.ReadValuesFile(ctx, localGitRepo, commit, projectDir, path); err != nil {
+			return err
+		}
+	}
+
+	allowConfig, err := giterminism_inspector.GetViolationsAllowConfig()
+	if err != nil {
+		return err
+	}
+
+	violations := giterminism_inspector.Violations
+
+	switch outputFormat {
+	case common.OutputFormatJSON:
+		if violations == nil {
+			violations = []*giterminism_inspector.Violation{}
+		}
+
+		data, err := json.MarshalIndent(checkResult{Violations: violations, AllowConfig: allowConfig, RemoteTemplates: werfConfig.RemoteTemplates}, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(data))
+	default:
+		if len(werfConfig.RemoteTemplates) != 0 {
+			fmt.Println("werf.yaml templates are imported from remote repositories:")
+			for _, r := range werfConfig.RemoteTemplates {
+				fmt.Printf("  %s at commit %s\n", r.Url, r.Commit)
+			}
+			fmt.Println()
+		}
+
+		for _, v := range violations {
+			printViolation(v)
+		}
+
+		if allowConfig != "" {
+			fmt.Println("werf-giterminism.yaml directives to allow violations:")
+			fmt.Println()
+			fmt.Print(allowConfig)
+			fmt.Println()
+		}
+	}
+
+	if len(violations) != 0 {
+		return fmt.Errorf("giterminism check failed, violations found: %d", len(violations))
+	}
+
+	if outputFormat != common.OutputFormatJSON {
+		fmt.Println("no giterminism violations found")
+	}
+
+	return nil
+}
+
+func printViolation(v *giterminism_inspector.Violation) {
+	fmt.Printf("[%s] %s\n", v.Source, v.Message)
+
+	if v.Allow == "" {
+		fmt.Println("  cannot be allowed with werf-giterminism.yaml")
+	} else {
+		fmt.Println("  allow with werf-giterminism.yaml:")
+		for _, line := range strings.Split(strings.TrimSuffix(v.Allow, "\n"), "\n") {
+			fmt.Printf("    %s\n", line)
+		}
+	}
+
+	fmt.Println()
+}
@@ -0,0 +1,226 @@
+package check
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/werf/werf/pkg/giterminism_inspector"
+)
+
+func TestCheck(t *testing.T) {
+	tests := []struct {
+		name             string
+		files            map[string]string
+		uncommittedFiles map[string]string
+		expectedErr      string
+		expectedOutput   []string
+	}{
+		{
+			name: "no violations",
+			files: map[string]string{
+				"werf.yaml": "project: test\nconfigVersion: 1\n",
+			},
+			expectedOutput: []string{"no giterminism violations found"},
+		},
+		{
+			name: "uncommitted werf.yaml",
+			files: map[string]string{
+				"werf.yaml": "project: test\nconfigVersion: 1\n",
+			},
+			uncommittedFiles: map[string]string{
+				"werf.yaml": "project: test\nconfigVersion: 1\n# changed\n",
+			},
+			expectedErr: "violations found: 1",
+			expectedOutput: []string{
+				"[werf.yaml] ",
+				"config:\n      allowUncommitted: true",
+				"werf-giterminism.yaml directives to allow violations:",
+			},
+		},
+		{
+			name: "env variable",
+			files: map[string]string{
+				"werf.yaml": "project: test\nconfigVersion: 1\n---\nimage: {{ env \"FOO\" | default \"app\" }}\nfrom: alpine\n",
+			},
+			expectedErr: "violations found: 1",
+			expectedOutput: []string{
+				"env name FOO is forbidden",
+				"allowEnvVariables:\n        - FOO",
+			},
+		},
+		{
+			name: "uncommitted file of the dockerfile context",
+			files: map[string]string{
+				"werf.yaml":      "project: test\nconfigVersion: 1\n---\nimage: app\ncontext: app\ndockerfile: Dockerfile\n",
+				"app/Dockerfile": "FROM alpine\nCOPY . /app\n",
+				"app/src/main":   "committed",
+			},
+			uncommittedFiles: map[string]string{
+				"app/src/main": "changed",
+			},
+			expectedErr: "violations found: 1",
+			expectedOutput: []string{
+				"[image app] uncommitted changes of the dockerfile context file app/src/main are not taken into account",
+				"werf.yaml directive 'contextAddFile: [src/main]'",
+				"cannot be allowed with werf-giterminism.yaml",
+			},
+		},
+		{
+			name: "allowed uncommitted dockerfile",
+			files: map[string]string{
+				"werf.yaml":             "project: test\nconfigVersion: 1\n---\nimage: app\ncontext: app\ndockerfile: Dockerfile\n",
+				"werf-giterminism.yaml": "giterminismConfigVersion: \"1\"\nconfig:\n  dockerfile:\n    allowUncommitted: [app/Dockerfile]\n",
+				"app/Dockerfile":        "FROM alpine\n",
+			},
+			uncommittedFiles: map[string]string{
+				"app/Dockerfile": "FROM ubuntu\n",
+			},
+			expectedOutput: []string{"no giterminism violations found"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			projectDir := prepareTestProject(t, tt.files, tt.uncommittedFiles)
+			defer os.RemoveAll(projectDir)
+
+			output, err := runCheck(t, projectDir)
+			if tt.expectedErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.expectedErr) {
+					t.Fatalf("expected error containing %q, got %v\noutput:\n%s", tt.expectedErr, err, output)
+				}
+			} else if err != nil {
+				t.Fatalf("unexpected error: %s\noutput:\n%s", err, output)
+			}
+
+			for _, expected := range tt.expectedOutput {
+				if !strings.Contains(output, expected) {
+					t.Errorf("expected output containing %q, got:\n%s", expected, output)
+				}
+			}
+		})
+	}
+}
+
+func TestCheck_JSON(t *testing.T) {
+	projectDir := prepareTestProject(t, map[string]string{
+		"werf.yaml": "project: test\nconfigVersion: 1\n---\nimage: {{ env \"FOO\" | default \"app\" }}\nfrom: alpine\n",
+	}, nil)
+	defer os.RemoveAll(projectDir)
+
+	output, err := runCheck(t, projectDir, "--output-format", "json")
+	if err == nil {
+		t.Fatalf("expected error, got output:\n%s", output)
+	}
+
+	var res checkResult
+	if err := json.Unmarshal([]byte(output), &res); err != nil {
+		t.Fatalf("unable to unmarshal output: %s\n%s", err, output)
+	}
+
+	if len(res.Violations) != 1 {
+		t.Fatalf("expected 1 violation, got %d:\n%s", len(res.Violations), output)
+	}
+
+	if v := res.Violations[0]; v.Source != "werf.yaml" || v.Path != "FOO" || !strings.Contains(v.Allow, "allowEnvVariables") {
+		t.Errorf("unexpected violation %#v", v)
+	}
+
+	if !strings.Contains(res.AllowConfig, "giterminismConfigVersion") || !strings.Contains(res.AllowConfig, "- FOO") {
+		t.Errorf("unexpected allow config:\n%s", res.AllowConfig)
+	}
+}
+
+func TestCheck_LooseGiterminism(t *testing.T) {
+	projectDir := prepareTestProject(t, map[string]string{"werf.yaml": "project: test\nconfigVersion: 1\n"}, nil)
+	defer os.RemoveAll(projectDir)
+
+	if _, err := runCheck(t, projectDir, "--loose-giterminism"); err == nil || !strings.Contains(err.Error(), "loose giterminism mode") {
+		t.Errorf("expected loose giterminism error, got %v", err)
+	}
+}
+
+func runCheck(t *testing.T, projectDir string, args ...string) (string, error) {
+	giterminism_inspector.Violations = nil
+	giterminism_inspector.CheckMode = false
+	defer func() {
+		giterminism_inspector.Violations = nil
+		giterminism_inspector.CheckMode = false
+	}()
+
+	cmd := NewCmd()
+	cmd.SilenceUsage = true
+	cmd.SilenceErrors = true
+	cmd.SetArgs(append([]string{
+		"--dir", projectDir,
+		"--tmp-dir", filepath.Join(projectDir, ".tmp"),
+		"--home-dir", filepath.Join(projectDir, ".home"),
+	}, args...))
+
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+
+	stdout := os.Stdout
+	os.Stdout = w
+	execErr := cmd.Execute()
+	os.Stdout = stdout
+	w.Close()
+
+	output, err := ioutil.ReadAll(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return string(output), execErr
+}
+
+func prepareTestProject(t *testing.T, files, uncommittedFiles map[string]string) string {
+	projectDir, err := ioutil.TempDir("", "werf-giterminism-check-")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	writeFiles := func(files map[string]string) {
+		for relPath, content := range files {
+			path := filepath.Join(projectDir, relPath)
+			if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+				t.Fatal(err)
+			}
+
+			if err := ioutil.WriteFile(path, []byte(content), 0644); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	git := func(args ...string) {
+		cmd := exec.Command("git", append([]string{"-c", "user.name=werf", "-c", "user.email=werf@werf.io"}, args...)...)
+		cmd.Dir = projectDir
+		if output, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %s failed: %s\n%s", strings.Join(args, " "), err, output)
+		}
+	}
+
+	writeFiles(files)
+	writeFiles(map[string]string{".gitignore": "/.tmp\n/.home\n"})
+	git("init")
+	git("add", "-A")
+	git("commit", "-m", "+")
+	writeFiles(uncommittedFiles)
+
+	for _, dir := range []string{".tmp", ".home"} {
+		if err := os.MkdirAll(filepath.Join(projectDir, dir), 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return projectDir
+}
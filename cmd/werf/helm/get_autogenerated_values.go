@@ -48,6 +48,7 @@ These values includes project name, docker images ids and other`),
 
 	common.SetupDir(&getAutogeneratedValuedCmdData, cmd)
 	common.SetupConfigTemplatesDir(&getAutogeneratedValuedCmdData, cmd)
+	common.SetupConfigValues(&getAutogeneratedValuedCmdData, cmd)
 	common.SetupConfigPath(&getAutogeneratedValuedCmdData, cmd)
 	common.SetupEnvironment(&getAutogeneratedValuedCmdData, cmd)
 
@@ -56,6 +57,7 @@ These values includes project name, docker images ids and other`),
 	common.SetupTmpDir(&getAutogeneratedValuedCmdData, cmd)
 	common.SetupHomeDir(&getAutogeneratedValuedCmdData, cmd)
 	common.SetupSSHKey(&getAutogeneratedValuedCmdData, cmd)
+	common.SetupGitHTTPSCredentials(&getAutogeneratedValuedCmdData, cmd)
 
 	common.SetupSecondaryStagesStorageOptions(&getAutogeneratedValuedCmdData, cmd)
 	common.SetupStagesStorageOptions(&getAutogeneratedValuedCmdData, cmd)
@@ -143,6 +145,10 @@ func runGetServiceValues() error {
 		return err
 	}
 
+	if err := common.InitGitHTTPSCredentials(&getAutogeneratedValuedCmdData); err != nil {
+		return fmt.Errorf("cannot initialize git https credentials: %s", err)
+	}
+
 	if err := ssh_agent.Init(ctx, *getAutogeneratedValuedCmdData.SSHKeys); err != nil {
 		return fmt.Errorf("cannot initialize ssh agent: %s", err)
 	}
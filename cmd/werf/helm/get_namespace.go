@@ -30,6 +30,7 @@ func NewGetNamespaceCmd() *cobra.Command {
 
 	common.SetupDir(&getNamespaceCmdData, cmd)
 	common.SetupConfigTemplatesDir(&getNamespaceCmdData, cmd)
+	common.SetupConfigValues(&getNamespaceCmdData, cmd)
 	common.SetupConfigPath(&getNamespaceCmdData, cmd)
 	common.SetupEnvironment(&getNamespaceCmdData, cmd)
 
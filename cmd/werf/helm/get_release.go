@@ -30,6 +30,7 @@ func NewGetReleaseCmd() *cobra.Command {
 
 	common.SetupDir(&getReleaseCmdData, cmd)
 	common.SetupConfigTemplatesDir(&getReleaseCmdData, cmd)
+	common.SetupConfigValues(&getReleaseCmdData, cmd)
 	common.SetupConfigPath(&getReleaseCmdData, cmd)
 	common.SetupEnvironment(&getReleaseCmdData, cmd)
 
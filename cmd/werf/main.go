@@ -18,6 +18,7 @@ import (
 	"github.com/werf/werf/cmd/werf/converge"
 	"github.com/werf/werf/cmd/werf/dismiss"
 	"github.com/werf/werf/cmd/werf/helm"
+	"github.com/werf/werf/cmd/werf/plan"
 	"github.com/werf/werf/cmd/werf/purge"
 	"github.com/werf/werf/cmd/werf/run"
 	"github.com/werf/werf/cmd/werf/slugify"
@@ -39,13 +40,20 @@ import (
 
 	config_list "github.com/werf/werf/cmd/werf/config/list"
 	config_render "github.com/werf/werf/cmd/werf/config/render"
+	config_schema "github.com/werf/werf/cmd/werf/config/schema"
+	config_validate "github.com/werf/werf/cmd/werf/config/validate"
+	giterminism_check "github.com/werf/werf/cmd/werf/giterminism/check"
+	release_track "github.com/werf/werf/cmd/werf/release/track"
 	"github.com/werf/werf/cmd/werf/render"
 
 	"github.com/werf/werf/cmd/werf/completion"
 	"github.com/werf/werf/cmd/werf/docs"
 	"github.com/werf/werf/cmd/werf/version"
 
+	stage_diff "github.com/werf/werf/cmd/werf/stage/diff"
 	stage_image "github.com/werf/werf/cmd/werf/stage/image"
+	stage_inspect "github.com/werf/werf/cmd/werf/stage/inspect"
+	stage_ls "github.com/werf/werf/cmd/werf/stage/ls"
 
 	"github.com/werf/werf/cmd/werf/common"
 	"github.com/werf/werf/cmd/werf/common/templates"
@@ -89,8 +97,10 @@ Find more information at https://werf.io`),
 			Message: "Delivery commands",
 			Commands: []*cobra.Command{
 				converge.NewCmd(),
+				plan.NewCmd(),
 				dismiss.NewCmd(),
 				bundleCmd(),
+				releaseCmd(),
 			},
 		},
 		{
@@ -115,6 +125,7 @@ Find more information at https://werf.io`),
 			Message: "Low-level management commands",
 			Commands: []*cobra.Command{
 				configCmd(),
+				giterminismCmd(),
 				managedImagesCmd(),
 				hostCmd(),
 				helm.NewCmd(),
@@ -168,6 +179,18 @@ func bundleCmd() *cobra.Command {
 	return cmd
 }
 
+func releaseCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "release",
+		Short: "Work with releases deployed into Kubernetes",
+	}
+	cmd.AddCommand(
+		release_track.NewCmd(),
+	)
+
+	return cmd
+}
+
 func configCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "config",
@@ -176,6 +199,20 @@ func configCmd() *cobra.Command {
 	cmd.AddCommand(
 		config_render.NewCmd(),
 		config_list.NewCmd(),
+		config_validate.NewCmd(),
+		config_schema.NewCmd(),
+	)
+
+	return cmd
+}
+
+func giterminismCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "giterminism",
+		Short: "Work with werf giterminism mode",
+	}
+	cmd.AddCommand(
+		giterminism_check.NewCmd(),
 	)
 
 	return cmd
@@ -197,11 +234,15 @@ func managedImagesCmd() *cobra.Command {
 
 func stageCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:    "stage",
-		Hidden: true,
+		Use:     "stage",
+		Aliases: []string{"stages"},
+		Short:   "Work with stages",
 	}
 	cmd.AddCommand(
+		stage_diff.NewCmd(),
 		stage_image.NewCmd(),
+		stage_ls.NewCmd(),
+		stage_inspect.NewCmd(),
 	)
 
 	return cmd
@@ -0,0 +1,247 @@
+package plan
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/werf/kubedog/pkg/kube"
+	"github.com/werf/logboek"
+
+	"github.com/werf/werf/cmd/werf/common"
+	"github.com/werf/werf/pkg/api"
+	"github.com/werf/werf/pkg/deploy/helm"
+	"github.com/werf/werf/pkg/werf/global_warnings"
+)
+
+// ChangesExitCode is the exit code of the command when the release will be changed.
+const ChangesExitCode = 2
+
+var commonCmdData common.CmdData
+
+func NewCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "plan",
+		Short: "Show changes of release resources which will be made by converge",
+		Long: common.GetLongCommandDescription(`Show changes of release resources which will be made by converge.
+
+The chart is rendered the same way converge does (with the same values, service values, extra annotations and labels), images are built if needed. Rendered resources are compared with the live objects of the cluster taking into account the manifest of the current release revision (the three-way merge which converge uses to update resources). Created, updated and deleted resources are printed with changed fields. Data of Secrets and secret values are masked. Helm hooks are not compared.
+
+The command exits with the code 0 if there are no changes and with the code 2 if the release will be changed.`),
+		Example: `  # Show changes of the production release
+  $ werf plan --repo registry.mydomain.com/web --env production
+
+  # Get changes in JSON
+  $ werf plan --repo registry.mydomain.com/web --env production --output-format json`,
+		DisableFlagsInUseLine: true,
+		Annotations: map[string]string{
+			common.CmdEnvAnno: common.EnvsDescription(common.WerfDebugAnsibleArgs, common.WerfSecretKey),
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := common.BackgroundContext()
+
+			if err := common.ProcessLogOptions(&commonCmdData); err != nil {
+				common.PrintHelp(cmd)
+				return err
+			}
+
+			common.LogVersion()
+
+			var hasChanges bool
+			if err := common.LogRunningTime(func() error {
+				var err error
+				hasChanges, err = runPlan(ctx)
+				return err
+			}); err != nil {
+				global_warnings.PrintGlobalWarnings(ctx)
+				return err
+			}
+
+			global_warnings.PrintGlobalWarnings(ctx)
+			if hasChanges {
+				os.Exit(ChangesExitCode)
+			}
+
+			return nil
+		},
+	}
+
+	common.SetupDir(&commonCmdData, cmd)
+	common.SetupConfigTemplatesDir(&commonCmdData, cmd)
+	common.SetupConfigValues(&commonCmdData, cmd)
+	common.SetupConfigPath(&commonCmdData, cmd)
+	common.SetupEnvironment(&commonCmdData, cmd)
+
+	common.SetupGiterminismInspectorOptions(&commonCmdData, cmd)
+
+	common.SetupTmpDir(&commonCmdData, cmd)
+	common.SetupHomeDir(&commonCmdData, cmd)
+	common.SetupSSHKey(&commonCmdData, cmd)
+	common.SetupGitHTTPSCredentials(&commonCmdData, cmd)
+
+	common.SetupIntrospectAfterError(&commonCmdData, cmd)
+	common.SetupIntrospectBeforeError(&commonCmdData, cmd)
+	common.SetupIntrospectStage(&commonCmdData, cmd)
+
+	common.SetupReproducible(&commonCmdData, cmd)
+	common.SetupImportServer(&commonCmdData, cmd)
+
+	common.SetupSecondaryStagesStorageOptions(&commonCmdData, cmd)
+	common.SetupStagesStorageOptions(&commonCmdData, cmd)
+
+	common.SetupDockerConfig(&commonCmdData, cmd, "Command needs granted permissions to read, pull and push images into the specified repo and to pull base images")
+	common.SetupInsecureRegistry(&commonCmdData, cmd)
+	common.SetupSkipTlsVerifyRegistry(&commonCmdData, cmd)
+
+	common.SetupLogOptions(&commonCmdData, cmd)
+	common.SetupLogProjectDir(&commonCmdData, cmd)
+
+	common.SetupSynchronization(&commonCmdData, cmd)
+
+	common.SetupKubeConfig(&commonCmdData, cmd)
+	common.SetupKubeConfigBase64(&commonCmdData, cmd)
+	common.SetupKubeContext(&commonCmdData, cmd)
+
+	common.SetupRelease(&commonCmdData, cmd)
+	common.SetupNamespace(&commonCmdData, cmd)
+	common.SetupAddAnnotations(&commonCmdData, cmd)
+	common.SetupAddLabels(&commonCmdData, cmd)
+
+	common.SetupSet(&commonCmdData, cmd)
+	common.SetupSetString(&commonCmdData, cmd)
+	common.SetupSetFile(&commonCmdData, cmd)
+	common.SetupValues(&commonCmdData, cmd)
+	common.SetupSecretValues(&commonCmdData, cmd)
+	common.SetupIgnoreSecretKey(&commonCmdData, cmd)
+
+	common.SetupReportPath(&commonCmdData, cmd)
+	common.SetupReportFormat(&commonCmdData, cmd)
+
+	common.SetupVirtualMerge(&commonCmdData, cmd)
+	common.SetupVirtualMergeFromCommit(&commonCmdData, cmd)
+	common.SetupVirtualMergeIntoCommit(&commonCmdData, cmd)
+
+	common.SetupParallelOptions(&commonCmdData, cmd, common.DefaultBuildParallelTasksLimit)
+
+	common.SetupSkipBuild(&commonCmdData, cmd)
+
+	common.SetupOutputFormat(&commonCmdData, cmd)
+
+	return cmd
+}
+
+func runPlan(ctx context.Context) (bool, error) {
+	outputFormat, err := common.GetOutputFormat(&commonCmdData)
+	if err != nil {
+		return false, err
+	}
+
+	initOptions, err := common.GetInitOptions(&commonCmdData)
+	if err != nil {
+		return false, err
+	}
+
+	ctx, err = api.Init(ctx, initOptions)
+	if err != nil {
+		return false, err
+	}
+	defer func() {
+		if err := api.Terminate(); err != nil {
+			logboek.Warn().LogF("WARNING: ssh agent termination failed: %s\n", err)
+		}
+	}()
+
+	common.ProcessLogProjectDir(&commonCmdData, initOptions.ProjectDir)
+
+	common.SetupOndemandKubeInitializer(*commonCmdData.KubeContext, *commonCmdData.KubeConfig, *commonCmdData.KubeConfigBase64)
+
+	project, err := api.OpenProject(ctx, common.GetProjectOptions(&commonCmdData, initOptions.ProjectDir, true))
+	if err != nil {
+		return false, err
+	}
+
+	deployOptions, err := common.GetDeployOptions(&commonCmdData, project.WerfConfig, common.GetOptionalStagesStorageAddress(&commonCmdData))
+	if err != nil {
+		return false, err
+	}
+
+	res, err := api.Plan(ctx, project, api.PlanOptions{
+		DeployOptions: deployOptions,
+		KubeConfigOptions: kube.KubeConfigOptions{
+			Context:          *commonCmdData.KubeContext,
+			ConfigPath:       *commonCmdData.KubeConfig,
+			ConfigDataBase64: *commonCmdData.KubeConfigBase64,
+		},
+		KubeInitializer: common.GetOndemandKubeInitializer(),
+	})
+	if err != nil {
+		return false, err
+	}
+
+	switch outputFormat {
+	case common.OutputFormatJSON:
+		data, err := json.MarshalIndent(res, "", "  ")
+		if err != nil {
+			return false, err
+		}
+		fmt.Println(string(data))
+	default:
+		printPlan(res)
+	}
+
+	return res.HasChanges(), nil
+}
+
+var changeSigns = map[helm.ChangeAction]string{
+	helm.ChangeCreate: "+",
+	helm.ChangeUpdate: "~",
+	helm.ChangeDelete: "-",
+}
+
+func printPlan(res *api.PlanResult) {
+	fmt.Println()
+
+	if !res.HasChanges() {
+		fmt.Printf("Release %q in namespace %q is up to date\n", res.ReleaseName, res.Namespace)
+		return
+	}
+
+	if res.Revision == 0 {
+		fmt.Printf("Release %q in namespace %q will be installed:\n", res.ReleaseName, res.Namespace)
+	} else {
+		fmt.Printf("Release %q revision %d in namespace %q will be changed:\n", res.ReleaseName, res.Revision, res.Namespace)
+	}
+
+	counts := map[helm.ChangeAction]int{}
+	for _, change := range res.Changes {
+		counts[change.Action]++
+
+		fmt.Println()
+		fmt.Printf("%s %s\n", changeSigns[change.Action], change)
+		for _, field := range change.Fields {
+			switch field.Action {
+			case helm.ChangeCreate:
+				fmt.Printf("    + %s: %s\n", field.Path, formatValue(field.New))
+			case helm.ChangeUpdate:
+				fmt.Printf("    ~ %s: %s -> %s\n", field.Path, formatValue(field.Old), formatValue(field.New))
+			case helm.ChangeDelete:
+				fmt.Printf("    - %s: %s\n", field.Path, formatValue(field.Old))
+			}
+		}
+	}
+
+	fmt.Println()
+	fmt.Printf("Plan: %d to create, %d to update, %d to delete\n", counts[helm.ChangeCreate], counts[helm.ChangeUpdate], counts[helm.ChangeDelete])
+}
+
+func formatValue(value interface{}) string {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+
+	return string(data)
+}
@@ -0,0 +1,148 @@
+package track
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/werf/kubedog/pkg/kube"
+	"github.com/werf/logboek"
+
+	"github.com/werf/werf/cmd/werf/common"
+	"github.com/werf/werf/pkg/api"
+	"github.com/werf/werf/pkg/git_repo"
+	"github.com/werf/werf/pkg/true_git"
+	"github.com/werf/werf/pkg/werf"
+	"github.com/werf/werf/pkg/werf/global_warnings"
+)
+
+var cmdData struct {
+	Timeout             int
+	UpdateReleaseStatus bool
+}
+
+var commonCmdData common.CmdData
+
+func NewCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "track",
+		Short: "Track resources of the latest release revision until they become ready or failed",
+		Long: common.GetLongCommandDescription(`Track resources of the latest release revision until they become ready or failed.
+
+The command re-attaches to the release which deploy process has been interrupted (e.g. the CI job running werf converge has been cancelled or timed out): resources of the latest revision are taken from the release manifest and tracked the same way converge does, including werf.io/* deploy annotations. Resources are not applied and hooks are not executed.
+
+The command exits with the error if resources have failed or the timeout has been reached. With --update-release-status option the revision is marked as deployed or failed depending on the result.
+
+Helm Release name and Kubernetes Namespace are taken from werf.yaml the same way converge does. werf.yaml is not required if both --release and --namespace are specified.`),
+		Example: `  # Track the release of the production environment
+  $ werf release track --env production
+
+  # Track the release without werf.yaml and mark the revision as deployed or failed
+  $ werf release track --release myapp-production --namespace myapp-production --update-release-status`,
+		DisableFlagsInUseLine: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := common.BackgroundContext()
+			defer global_warnings.PrintGlobalWarnings(ctx)
+
+			if err := common.ProcessLogOptions(&commonCmdData); err != nil {
+				common.PrintHelp(cmd)
+				return err
+			}
+
+			common.LogVersion()
+
+			return common.LogRunningTime(func() error {
+				return runTrack(ctx)
+			})
+		},
+	}
+
+	common.SetupDir(&commonCmdData, cmd)
+	common.SetupConfigTemplatesDir(&commonCmdData, cmd)
+	common.SetupConfigValues(&commonCmdData, cmd)
+	common.SetupConfigPath(&commonCmdData, cmd)
+	common.SetupEnvironment(&commonCmdData, cmd)
+
+	common.SetupGiterminismInspectorOptions(&commonCmdData, cmd)
+
+	common.SetupTmpDir(&commonCmdData, cmd)
+	common.SetupHomeDir(&commonCmdData, cmd)
+
+	common.SetupLogOptions(&commonCmdData, cmd)
+
+	common.SetupKubeConfig(&commonCmdData, cmd)
+	common.SetupKubeConfigBase64(&commonCmdData, cmd)
+	common.SetupKubeContext(&commonCmdData, cmd)
+
+	common.SetupStatusProgressPeriod(&commonCmdData, cmd)
+
+	common.SetupRelease(&commonCmdData, cmd)
+	common.SetupNamespace(&commonCmdData, cmd)
+
+	cmd.Flags().IntVarP(&cmdData.Timeout, "timeout", "t", 0, "Resources tracking timeout in seconds")
+	cmd.Flags().BoolVarP(&cmdData.UpdateReleaseStatus, "update-release-status", "", common.GetBoolEnvironmentDefaultFalse("WERF_UPDATE_RELEASE_STATUS"), "Mark the tracked release revision as deployed or failed depending on the tracking result ($WERF_UPDATE_RELEASE_STATUS by default)")
+
+	return cmd
+}
+
+func runTrack(ctx context.Context) error {
+	if err := werf.Init(*commonCmdData.TmpDir, *commonCmdData.HomeDir); err != nil {
+		return fmt.Errorf("initialization error: %s", err)
+	}
+
+	var project *api.Project
+	if *commonCmdData.Release == "" || *commonCmdData.Namespace == "" {
+		p, err := openProject(ctx)
+		if err != nil {
+			return err
+		}
+		project = p
+	}
+
+	common.SetupOndemandKubeInitializer(*commonCmdData.KubeContext, *commonCmdData.KubeConfig, *commonCmdData.KubeConfigBase64)
+
+	res, err := api.TrackRelease(ctx, project, api.TrackReleaseOptions{
+		Release:   *commonCmdData.Release,
+		Namespace: *commonCmdData.Namespace,
+		KubeConfigOptions: kube.KubeConfigOptions{
+			Context:          *commonCmdData.KubeContext,
+			ConfigPath:       *commonCmdData.KubeConfig,
+			ConfigDataBase64: *commonCmdData.KubeConfigBase64,
+		},
+		KubeInitializer:      common.GetOndemandKubeInitializer(),
+		StatusProgressPeriod: time.Duration(*commonCmdData.StatusProgressPeriodSeconds) * time.Second,
+		Timeout:              time.Duration(cmdData.Timeout) * time.Second,
+		UpdateReleaseStatus:  cmdData.UpdateReleaseStatus,
+	})
+	if err != nil {
+		return err
+	}
+
+	logboek.Context(ctx).LogOptionalLn()
+	logboek.Context(ctx).Default().LogF("Release %q revision %d resources are ready\n", res.ReleaseName, res.Revision)
+
+	return nil
+}
+
+func openProject(ctx context.Context) (*api.Project, error) {
+	if err := common.InitGiterminismInspector(&commonCmdData); err != nil {
+		return nil, err
+	}
+
+	if err := git_repo.Init(); err != nil {
+		return nil, err
+	}
+
+	if err := true_git.Init(true_git.Options{LiveGitOutput: *commonCmdData.LogVerbose || *commonCmdData.LogDebug}); err != nil {
+		return nil, err
+	}
+
+	projectDir, err := common.GetProjectDir(&commonCmdData)
+	if err != nil {
+		return nil, fmt.Errorf("getting project dir failed: %s", err)
+	}
+
+	return api.OpenProject(ctx, common.GetProjectOptions(&commonCmdData, projectDir, false))
+}
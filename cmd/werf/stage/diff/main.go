@@ -0,0 +1,408 @@
+package diff
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/docker/go-units"
+	"github.com/spf13/cobra"
+
+	"github.com/werf/logboek"
+
+	"github.com/werf/werf/cmd/werf/common"
+	"github.com/werf/werf/pkg/build"
+	"github.com/werf/werf/pkg/build/stage"
+	"github.com/werf/werf/pkg/container_runtime"
+	"github.com/werf/werf/pkg/docker"
+	"github.com/werf/werf/pkg/git_repo"
+	"github.com/werf/werf/pkg/image"
+	"github.com/werf/werf/pkg/logging"
+	"github.com/werf/werf/pkg/ssh_agent"
+	"github.com/werf/werf/pkg/stage_diff"
+	"github.com/werf/werf/pkg/storage"
+	"github.com/werf/werf/pkg/storage/manager"
+	"github.com/werf/werf/pkg/tmp_manager"
+	"github.com/werf/werf/pkg/true_git"
+	"github.com/werf/werf/pkg/werf"
+)
+
+var cmdData struct {
+	StageIDs bool
+}
+
+var commonCmdData common.CmdData
+
+func NewCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "diff [options] [IMAGE_NAME [STAGE_A [STAGE_B]]]",
+		Short: "Show filesystem changes between stages",
+		Long: common.GetLongCommandDescription(`Show added, removed and modified files of the stage STAGE_B relative to the stage STAGE_A of the image IMAGE_NAME.
+
+If STAGE_B is not specified, the stage STAGE_A is compared with the previous non-empty stage of the image. If no stages are specified, the last stage of the image is compared with the previous non-empty stage.
+
+Stages of the image should be built. With --stage-ids option two arbitrary stage IDs (DIGEST-UNIQUEID) from the repo are compared.
+
+Changes are calculated from the stages images layers without running containers: layers are read from the local docker server or pulled from the repo.`),
+		Example: `  # Show changes made by the last stage of the image 'backend'
+  $ werf stage diff backend
+
+  # Show changes made by the setup stage
+  $ werf stage diff backend setup
+
+  # Show changes between the install and setup stages
+  $ werf stage diff backend install setup
+
+  # Show changes between two stages from the repo
+  $ werf stage diff --repo harbor.company.io/werf --stage-ids DIGEST_A-UNIQUEID_A DIGEST_B-UNIQUEID_B`,
+		DisableFlagsInUseLine: true,
+		Annotations: map[string]string{
+			common.DisableOptionsInUseLineAnno: "1",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := common.ProcessLogOptions(&commonCmdData); err != nil {
+				common.PrintHelp(cmd)
+				return err
+			}
+
+			if cmdData.StageIDs {
+				if len(args) != 2 {
+					common.PrintHelp(cmd)
+					return fmt.Errorf("two stage IDs required with --stage-ids option, received %d position arguments", len(args))
+				}
+			} else if len(args) > 3 {
+				common.PrintHelp(cmd)
+				return fmt.Errorf("no more than %d position arguments can be specified, received %d", 3, len(args))
+			}
+
+			return run(args)
+		},
+	}
+
+	common.SetupDir(&commonCmdData, cmd)
+	common.SetupConfigTemplatesDir(&commonCmdData, cmd)
+	common.SetupConfigValues(&commonCmdData, cmd)
+	common.SetupConfigPath(&commonCmdData, cmd)
+	common.SetupEnvironment(&commonCmdData, cmd)
+
+	common.SetupGiterminismInspectorOptions(&commonCmdData, cmd)
+
+	common.SetupTmpDir(&commonCmdData, cmd)
+	common.SetupHomeDir(&commonCmdData, cmd)
+	common.SetupSSHKey(&commonCmdData, cmd)
+	common.SetupGitHTTPSCredentials(&commonCmdData, cmd)
+
+	common.SetupSecondaryStagesStorageOptions(&commonCmdData, cmd)
+	common.SetupStagesStorageOptions(&commonCmdData, cmd)
+
+	common.SetupDockerConfig(&commonCmdData, cmd, "Command needs granted permissions to read and pull images from the specified repo")
+	common.SetupInsecureRegistry(&commonCmdData, cmd)
+	common.SetupSkipTlsVerifyRegistry(&commonCmdData, cmd)
+
+	common.SetupLogOptions(&commonCmdData, cmd)
+	common.SetupLogProjectDir(&commonCmdData, cmd)
+
+	common.SetupSynchronization(&commonCmdData, cmd)
+	common.SetupKubeConfig(&commonCmdData, cmd)
+	common.SetupKubeConfigBase64(&commonCmdData, cmd)
+	common.SetupKubeContext(&commonCmdData, cmd)
+
+	common.SetupVirtualMerge(&commonCmdData, cmd)
+	common.SetupVirtualMergeFromCommit(&commonCmdData, cmd)
+	common.SetupVirtualMergeIntoCommit(&commonCmdData, cmd)
+
+	cmd.Flags().BoolVarP(&cmdData.StageIDs, "stage-ids", "", common.GetBoolEnvironmentDefaultFalse("WERF_STAGE_IDS"), "Compare two stages specified by IDs in the DIGEST-UNIQUEID format instead of the image stages (default $WERF_STAGE_IDS)")
+
+	return cmd
+}
+
+func run(args []string) error {
+	ctx := common.BackgroundContext()
+
+	if err := werf.Init(*commonCmdData.TmpDir, *commonCmdData.HomeDir); err != nil {
+		return fmt.Errorf("initialization error: %s", err)
+	}
+
+	if err := common.InitGiterminismInspector(&commonCmdData); err != nil {
+		return err
+	}
+
+	if err := git_repo.Init(); err != nil {
+		return err
+	}
+
+	if err := image.Init(); err != nil {
+		return err
+	}
+
+	if err := true_git.Init(true_git.Options{LiveGitOutput: *commonCmdData.LogVerbose || *commonCmdData.LogDebug}); err != nil {
+		return err
+	}
+
+	if err := common.DockerRegistryInit(&commonCmdData); err != nil {
+		return err
+	}
+
+	if err := docker.Init(ctx, *commonCmdData.DockerConfig, *commonCmdData.LogVerbose, *commonCmdData.LogDebug); err != nil {
+		return err
+	}
+
+	ctxWithDockerCli, err := docker.NewContext(ctx)
+	if err != nil {
+		return err
+	}
+	ctx = ctxWithDockerCli
+
+	projectDir, err := common.GetProjectDir(&commonCmdData)
+	if err != nil {
+		return fmt.Errorf("getting project dir failed: %s", err)
+	}
+
+	common.ProcessLogProjectDir(&commonCmdData, projectDir)
+
+	localGitRepo, err := common.OpenLocalGitRepo(projectDir)
+	if err != nil {
+		return fmt.Errorf("unable to open local repo %s: %s", projectDir, err)
+	}
+
+	werfConfig, err := common.GetRequiredWerfConfig(ctx, projectDir, &commonCmdData, localGitRepo, common.GetWerfConfigOptions(&commonCmdData, false))
+	if err != nil {
+		return fmt.Errorf("unable to load werf config: %s", err)
+	}
+
+	projectName := werfConfig.Meta.Project
+
+	projectTmpDir, err := tmp_manager.CreateProjectDir(ctx)
+	if err != nil {
+		return fmt.Errorf("getting project tmp dir failed: %s", err)
+	}
+	defer tmp_manager.ReleaseProjectDir(projectTmpDir)
+
+	if err := common.InitGitHTTPSCredentials(&commonCmdData); err != nil {
+		return fmt.Errorf("cannot initialize git https credentials: %s", err)
+	}
+
+	if err := ssh_agent.Init(ctx, *commonCmdData.SSHKeys); err != nil {
+		return fmt.Errorf("cannot initialize ssh agent: %s", err)
+	}
+	defer func() {
+		err := ssh_agent.Terminate()
+		if err != nil {
+			logboek.Warn().LogF("WARNING: ssh agent termination failed: %s\n", err)
+		}
+	}()
+
+	containerRuntime := &container_runtime.LocalDockerServerRuntime{} // TODO
+
+	stagesStorageAddress := common.GetOptionalStagesStorageAddress(&commonCmdData)
+	stagesStorage, err := common.GetStagesStorage(stagesStorageAddress, containerRuntime, &commonCmdData)
+	if err != nil {
+		return err
+	}
+
+	var descA, descB *image.StageDescription
+	if cmdData.StageIDs {
+		if descA, err = getStageDescriptionByID(ctx, stagesStorage, projectName, args[0]); err != nil {
+			return err
+		}
+
+		if descB, err = getStageDescriptionByID(ctx, stagesStorage, projectName, args[1]); err != nil {
+			return err
+		}
+	} else {
+		var imageName string
+		if len(args) > 0 {
+			imageName = args[0]
+		} else if len(werfConfig.GetAllImages()) == 1 {
+			imageName = werfConfig.GetAllImages()[0].GetName()
+		}
+
+		if !werfConfig.HasImageOrArtifact(imageName) {
+			return fmt.Errorf("image '%s' is not defined in werf.yaml", logging.ImageLogName(imageName, false))
+		}
+
+		synchronization, err := common.GetSynchronization(ctx, &commonCmdData, projectName, stagesStorage)
+		if err != nil {
+			return err
+		}
+		stagesStorageCache, err := common.GetStagesStorageCache(synchronization)
+		if err != nil {
+			return err
+		}
+		storageLockManager, err := common.GetStorageLockManager(ctx, synchronization)
+		if err != nil {
+			return err
+		}
+		secondaryStagesStorageList, err := common.GetSecondaryStagesStorageList(stagesStorage, containerRuntime, &commonCmdData)
+		if err != nil {
+			return err
+		}
+
+		storageManager := manager.NewStorageManager(projectName, stagesStorage, secondaryStagesStorageList, storageLockManager, stagesStorageCache)
+
+		conveyorWithRetry := build.NewConveyorWithRetryWrapper(werfConfig, localGitRepo, []string{imageName}, projectDir, projectTmpDir, ssh_agent.SSHAuthSock, containerRuntime, storageManager, storageLockManager, common.GetConveyorOptions(&commonCmdData))
+		defer conveyorWithRetry.Terminate()
+
+		if err := conveyorWithRetry.WithRetryBlock(ctx, func(c *build.Conveyor) error {
+			if err := c.ShouldBeBuilt(ctx); err != nil {
+				return err
+			}
+
+			descA, descB, err = getImageStagesDescriptions(c.GetImage(imageName), args[1:])
+			return err
+		}); err != nil {
+			return err
+		}
+	}
+
+	differ := stage_diff.NewDiffer(stagesStorage)
+	defer differ.Cleanup()
+
+	changes, err := differ.Diff(ctx, descA, descB)
+	if err != nil {
+		return err
+	}
+
+	printChanges(descA, descB, changes)
+
+	return nil
+}
+
+func getStageDescriptionByID(ctx context.Context, stagesStorage storage.StagesStorage, projectName, stageID string) (*image.StageDescription, error) {
+	id, err := image.ParseStageID(stageID)
+	if err != nil {
+		return nil, err
+	}
+
+	desc, err := stagesStorage.GetStageDescription(ctx, projectName, id.Digest, id.UniqueID)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get stage %s description: %s", stageID, err)
+	} else if desc == nil {
+		return nil, fmt.Errorf("stage %s not found in the %s", stageID, stagesStorage.String())
+	}
+
+	return desc, nil
+}
+
+// getImageStagesDescriptions returns descriptions of the stages to compare, the first one is nil if the stage has no previous non-empty stage.
+func getImageStagesDescriptions(img *build.Image, stageNames []string) (*image.StageDescription, *image.StageDescription, error) {
+	var stages []stage.Interface
+	for _, stg := range img.GetStages() {
+		if stg.GetImage() == nil || stg.GetImage().GetStageDescription() == nil {
+			continue
+		}
+
+		// empty stage uses the image of the previous stage
+		if len(stages) != 0 && stageDescription(stages[len(stages)-1]).StageID.String() == stageDescription(stg).StageID.String() {
+			continue
+		}
+
+		stages = append(stages, stg)
+	}
+
+	if len(stages) == 0 {
+		return nil, nil, fmt.Errorf("no built stages found for image %s", img.LogName())
+	}
+
+	findStage := func(name string) (int, error) {
+		if img.GetStage(stage.StageName(name)) == nil {
+			return 0, fmt.Errorf("stage %q is not defined for image %s", name, img.LogName())
+		}
+
+		for ind, stg := range stages {
+			if string(stg.Name()) == name {
+				return ind, nil
+			}
+		}
+
+		return 0, fmt.Errorf("stage %q of image %s is empty", name, img.LogName())
+	}
+
+	previousDescription := func(ind int) *image.StageDescription {
+		if ind == 0 {
+			return nil
+		}
+		return stageDescription(stages[ind-1])
+	}
+
+	switch len(stageNames) {
+	case 0:
+		return previousDescription(len(stages) - 1), stageDescription(stages[len(stages)-1]), nil
+	case 1:
+		ind, err := findStage(stageNames[0])
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return previousDescription(ind), stageDescription(stages[ind]), nil
+	default:
+		indA, err := findStage(stageNames[0])
+		if err != nil {
+			return nil, nil, err
+		}
+
+		indB, err := findStage(stageNames[1])
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return stageDescription(stages[indA]), stageDescription(stages[indB]), nil
+	}
+}
+
+func stageDescription(stg stage.Interface) *image.StageDescription {
+	return stg.GetImage().GetStageDescription()
+}
+
+func printChanges(descA, descB *image.StageDescription, changes []*stage_diff.Change) {
+	if descA != nil {
+		fmt.Printf("--- %s\n", descA.Info.Name)
+	} else {
+		fmt.Printf("--- (empty filesystem)\n")
+	}
+	fmt.Printf("+++ %s\n", descB.Info.Name)
+
+	var added, removed, modified int
+	for _, change := range changes {
+		switch change.Type {
+		case stage_diff.Added:
+			added++
+			fmt.Printf("A %s %s %s\n", change.New.Mode, units.BytesSize(float64(change.New.Size)), formatPath(change.New))
+		case stage_diff.Removed:
+			removed++
+			fmt.Printf("D %s %s %s\n", change.Old.Mode, units.BytesSize(float64(change.Old.Size)), formatPath(change.Old))
+		case stage_diff.Modified:
+			modified++
+			fmt.Printf("M %s %s %s (%s)\n", change.New.Mode, units.BytesSize(float64(change.New.Size)), formatPath(change.New), strings.Join(describeModification(change.Old, change.New), ", "))
+		}
+	}
+
+	fmt.Printf("\n%d added, %d removed, %d modified\n", added, removed, modified)
+}
+
+func formatPath(file *stage_diff.FileInfo) string {
+	if file.Linkname != "" {
+		return fmt.Sprintf("%s -> %s", file.Path, file.Linkname)
+	}
+	return file.Path
+}
+
+func describeModification(old, new *stage_diff.FileInfo) []string {
+	var res []string
+	if old.Mode != new.Mode {
+		res = append(res, fmt.Sprintf("mode %s -> %s", old.Mode, new.Mode))
+	}
+	if old.Uid != new.Uid || old.Gid != new.Gid {
+		res = append(res, fmt.Sprintf("owner %d:%d -> %d:%d", old.Uid, old.Gid, new.Uid, new.Gid))
+	}
+	if old.Size != new.Size {
+		res = append(res, fmt.Sprintf("size %s -> %s", units.BytesSize(float64(old.Size)), units.BytesSize(float64(new.Size))))
+	}
+	if old.Linkname != new.Linkname {
+		res = append(res, fmt.Sprintf("link %s -> %s", old.Linkname, new.Linkname))
+	}
+	if old.Digest != new.Digest && old.Size == new.Size {
+		res = append(res, "content")
+	}
+	return res
+}
@@ -0,0 +1,315 @@
+package inspect
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/docker/go-units"
+	"github.com/gosuri/uitable"
+	"github.com/spf13/cobra"
+
+	"github.com/werf/werf/cmd/werf/common"
+	"github.com/werf/werf/pkg/container_runtime"
+	"github.com/werf/werf/pkg/docker"
+	"github.com/werf/werf/pkg/git_repo"
+	"github.com/werf/werf/pkg/image"
+	"github.com/werf/werf/pkg/stage_inspect"
+	"github.com/werf/werf/pkg/storage/manager"
+	"github.com/werf/werf/pkg/tmp_manager"
+	"github.com/werf/werf/pkg/true_git"
+	"github.com/werf/werf/pkg/util"
+	"github.com/werf/werf/pkg/werf"
+)
+
+var commonCmdData common.CmdData
+
+func NewCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                   "inspect STAGE_ID",
+		DisableFlagsInUseLine: true,
+		Short:                 "Show details of the stage from the stages storage",
+		Long:                  common.GetLongCommandDescription(`Show details of the stage STAGE_ID (DIGEST-UNIQUEID): commits the stage has been built for, virtual merge info, parent stage, size, image metadata records referencing the stage and all stage labels.`),
+		Example: `  # Inspect the stage from the repo
+  $ werf stage inspect --repo harbor.company.io/werf DIGEST-UNIQUEID
+
+  # Inspect the stage as JSON
+  $ werf stages inspect --output-format json DIGEST-UNIQUEID`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := common.ProcessLogOptions(&commonCmdData); err != nil {
+				common.PrintHelp(cmd)
+				return err
+			}
+
+			if len(args) != 1 {
+				common.PrintHelp(cmd)
+				return fmt.Errorf("stage ID required")
+			}
+
+			return run(args[0])
+		},
+	}
+
+	common.SetupProjectName(&commonCmdData, cmd)
+	common.SetupDir(&commonCmdData, cmd)
+	common.SetupConfigTemplatesDir(&commonCmdData, cmd)
+	common.SetupConfigValues(&commonCmdData, cmd)
+	common.SetupConfigPath(&commonCmdData, cmd)
+	common.SetupEnvironment(&commonCmdData, cmd)
+
+	common.SetupGiterminismInspectorOptions(&commonCmdData, cmd)
+
+	common.SetupTmpDir(&commonCmdData, cmd)
+	common.SetupHomeDir(&commonCmdData, cmd)
+	common.SetupSSHKey(&commonCmdData, cmd)
+
+	common.SetupSecondaryStagesStorageOptions(&commonCmdData, cmd)
+	common.SetupStagesStorageOptions(&commonCmdData, cmd)
+
+	common.SetupDockerConfig(&commonCmdData, cmd, "Command needs granted permissions to read images from the specified repo")
+	common.SetupInsecureRegistry(&commonCmdData, cmd)
+	common.SetupSkipTlsVerifyRegistry(&commonCmdData, cmd)
+
+	common.SetupLogOptions(&commonCmdData, cmd)
+	common.SetupLogProjectDir(&commonCmdData, cmd)
+
+	common.SetupSynchronization(&commonCmdData, cmd)
+	common.SetupKubeConfig(&commonCmdData, cmd)
+	common.SetupKubeConfigBase64(&commonCmdData, cmd)
+	common.SetupKubeContext(&commonCmdData, cmd)
+
+	common.SetupParallelOptions(&commonCmdData, cmd, common.DefaultCleanupParallelTasksLimit)
+
+	common.SetupOutputFormat(&commonCmdData, cmd)
+
+	return cmd
+}
+
+func run(stageID string) error {
+	ctx := common.BackgroundContext()
+
+	if err := werf.Init(*commonCmdData.TmpDir, *commonCmdData.HomeDir); err != nil {
+		return fmt.Errorf("initialization error: %s", err)
+	}
+
+	outputFormat, err := common.GetOutputFormat(&commonCmdData)
+	if err != nil {
+		return err
+	}
+
+	if _, err := image.ParseStageID(stageID); err != nil {
+		return err
+	}
+
+	if err := common.InitGiterminismInspector(&commonCmdData); err != nil {
+		return err
+	}
+
+	if err := git_repo.Init(); err != nil {
+		return err
+	}
+
+	if err := true_git.Init(true_git.Options{LiveGitOutput: *commonCmdData.LogVerbose || *commonCmdData.LogDebug}); err != nil {
+		return err
+	}
+
+	if err := image.Init(); err != nil {
+		return err
+	}
+
+	if err := common.DockerRegistryInit(&commonCmdData); err != nil {
+		return err
+	}
+
+	if err := docker.Init(ctx, *commonCmdData.DockerConfig, *commonCmdData.LogVerbose, *commonCmdData.LogDebug); err != nil {
+		return err
+	}
+
+	ctxWithDockerCli, err := docker.NewContext(ctx)
+	if err != nil {
+		return err
+	}
+	ctx = ctxWithDockerCli
+
+	projectDir, err := common.GetProjectDir(&commonCmdData)
+	if err != nil {
+		return fmt.Errorf("getting project dir failed: %s", err)
+	}
+
+	projectTmpDir, err := tmp_manager.CreateProjectDir(ctx)
+	if err != nil {
+		return fmt.Errorf("getting project tmp dir failed: %s", err)
+	}
+	defer tmp_manager.ReleaseProjectDir(projectTmpDir)
+
+	localGitRepo, err := common.OpenLocalGitRepo(projectDir)
+	if err != nil {
+		return fmt.Errorf("unable to open local repo %s: %s", projectDir, err)
+	}
+
+	werfConfig, err := common.GetOptionalWerfConfig(ctx, projectDir, &commonCmdData, localGitRepo, common.GetWerfConfigOptions(&commonCmdData, false))
+	if err != nil {
+		return fmt.Errorf("unable to load werf config: %s", err)
+	}
+
+	var projectName string
+	if werfConfig != nil {
+		projectName = werfConfig.Meta.Project
+	} else if *commonCmdData.ProjectName != "" {
+		projectName = *commonCmdData.ProjectName
+	} else {
+		return fmt.Errorf("run command in the project directory with werf.yaml or specify --project-name=PROJECT_NAME param")
+	}
+
+	stagesStorageAddress := common.GetOptionalStagesStorageAddress(&commonCmdData)
+	containerRuntime := &container_runtime.LocalDockerServerRuntime{} // TODO
+	stagesStorage, err := common.GetStagesStorage(stagesStorageAddress, containerRuntime, &commonCmdData)
+	if err != nil {
+		return err
+	}
+
+	synchronization, err := common.GetSynchronization(ctx, &commonCmdData, projectName, stagesStorage)
+	if err != nil {
+		return err
+	}
+	stagesStorageCache, err := common.GetStagesStorageCache(synchronization)
+	if err != nil {
+		return err
+	}
+	storageLockManager, err := common.GetStorageLockManager(ctx, synchronization)
+	if err != nil {
+		return err
+	}
+	secondaryStagesStorageList, err := common.GetSecondaryStagesStorageList(stagesStorage, containerRuntime, &commonCmdData)
+	if err != nil {
+		return err
+	}
+
+	storageManager := manager.NewStorageManager(projectName, stagesStorage, secondaryStagesStorageList, storageLockManager, stagesStorageCache)
+	if *commonCmdData.Parallel {
+		storageManager.EnableParallel(int(*commonCmdData.ParallelTasksLimit))
+	}
+
+	imageNameList, err := stagesStorage.GetManagedImages(ctx, projectName)
+	if err != nil {
+		return fmt.Errorf("unable to list known config image names for project %q: %s", projectName, err)
+	}
+
+	if werfConfig != nil {
+		for _, img := range werfConfig.GetAllImages() {
+			if !util.IsStringsContainValue(imageNameList, img.GetName()) {
+				imageNameList = append(imageNameList, img.GetName())
+			}
+		}
+	}
+
+	stages, err := stage_inspect.GetStages(ctx, storageManager, imageNameList)
+	if err != nil {
+		return err
+	}
+
+	var stg *stage_inspect.Stage
+	for _, s := range stages {
+		if s.StageID == stageID {
+			stg = s
+			break
+		}
+	}
+
+	if stg == nil {
+		return fmt.Errorf("stage %s not found in the %s", stageID, stagesStorage.String())
+	}
+
+	switch outputFormat {
+	case common.OutputFormatJSON:
+		data, err := json.MarshalIndent(stg, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(data))
+	default:
+		printStage(stg)
+	}
+
+	return nil
+}
+
+func printStage(stg *stage_inspect.Stage) {
+	t := uitable.New()
+	t.Wrap = true
+	t.MaxColWidth = 100
+
+	t.AddRow("Stage ID:", stg.StageID)
+	t.AddRow("Stage name:", valueOrDash(stg.StageName))
+	t.AddRow("Docker image name:", stg.DockerImageName)
+	t.AddRow("Docker image ID:", stg.ImageID)
+	t.AddRow("Created:", stg.CreatedAt.Format("2006-01-02 15:04:05 -0700 MST"))
+	t.AddRow("Size:", units.BytesSize(float64(stg.Size)))
+	if stg.ParentImageID != "" {
+		t.AddRow("Parent image:", stg.ParentImageID)
+	} else {
+		t.AddRow("Parent stage:", valueOrDash(stg.ParentStageID))
+	}
+	t.AddRow("werf version:", valueOrDash(stg.WerfVersion))
+	t.AddRow("Project repo commit:", valueOrDash(stg.ProjectRepoCommit))
+	fmt.Println(t)
+
+	if len(stg.GitMappings) != 0 {
+		fmt.Println()
+		fmt.Println("Git mappings:")
+
+		t := uitable.New()
+		t.AddRow("  PARAMSHASH", "COMMIT", "VIRTUAL MERGE", "FROM COMMIT", "INTO COMMIT")
+		for _, gm := range stg.GitMappings {
+			virtualMerge := "no"
+			if gm.VirtualMerge {
+				virtualMerge = "yes"
+			}
+
+			t.AddRow("  "+gm.ParamsHash, valueOrDash(gm.Commit), virtualMerge, valueOrDash(gm.VirtualMergeFromCommit), valueOrDash(gm.VirtualMergeIntoCommit))
+		}
+		fmt.Println(t)
+	}
+
+	fmt.Println()
+	if len(stg.ImageMetadata) == 0 {
+		fmt.Println("Image metadata: no records reference the stage")
+	} else {
+		fmt.Println("Image metadata:")
+
+		t := uitable.New()
+		t.AddRow("  IMAGE", "COMMITS")
+		for _, imageMetadata := range stg.ImageMetadata {
+			imageName := imageMetadata.ImageName
+			if imageName == "" {
+				imageName = "~"
+			}
+
+			t.AddRow("  "+imageName, strings.Join(imageMetadata.Commits, ", "))
+		}
+		fmt.Println(t)
+	}
+
+	fmt.Println()
+	fmt.Println("Labels:")
+
+	var labelNames []string
+	for name := range stg.Labels {
+		labelNames = append(labelNames, name)
+	}
+	sort.Strings(labelNames)
+
+	t = uitable.New()
+	for _, name := range labelNames {
+		t.AddRow("  "+name+":", stg.Labels[name])
+	}
+	fmt.Println(t)
+}
+
+func valueOrDash(value string) string {
+	if value == "" {
+		return "-"
+	}
+	return value
+}
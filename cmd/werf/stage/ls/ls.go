@@ -0,0 +1,386 @@
+package ls
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/docker/go-units"
+	"github.com/gosuri/uitable"
+	"github.com/spf13/cobra"
+
+	"github.com/werf/werf/cmd/werf/common"
+	"github.com/werf/werf/pkg/container_runtime"
+	"github.com/werf/werf/pkg/docker"
+	"github.com/werf/werf/pkg/git_repo"
+	"github.com/werf/werf/pkg/image"
+	"github.com/werf/werf/pkg/stage_inspect"
+	"github.com/werf/werf/pkg/storage/manager"
+	"github.com/werf/werf/pkg/tmp_manager"
+	"github.com/werf/werf/pkg/true_git"
+	"github.com/werf/werf/pkg/util"
+	"github.com/werf/werf/pkg/werf"
+)
+
+var cmdData struct {
+	Image     string
+	StageName string
+	OlderThan string
+	NewerThan string
+	MinSize   string
+	MaxSize   string
+}
+
+var commonCmdData common.CmdData
+
+func NewCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                   "ls",
+		DisableFlagsInUseLine: true,
+		Short:                 "List stages from the stages storage",
+		Long: common.GetLongCommandDescription(`List stages from the stages storage with commits they have been built for, virtual merge info, parent stage, size and image metadata records referencing them.
+
+Stages of the image are the stages referenced by the image metadata records of the image and their parent stages.
+
+Stage name is known only for the stages built by werf versions which set the werf-stage-name label.`),
+		Example: `  # List all stages of the project from the repo
+  $ werf stage ls --repo harbor.company.io/werf
+
+  # List stages of the image 'backend' older than 3 days and larger than 100MiB
+  $ werf stages ls --image backend --older-than 72h --min-size 100MiB
+
+  # List stages as JSON
+  $ werf stages ls --output-format json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := common.ProcessLogOptions(&commonCmdData); err != nil {
+				common.PrintHelp(cmd)
+				return err
+			}
+
+			return run()
+		},
+	}
+
+	common.SetupProjectName(&commonCmdData, cmd)
+	common.SetupDir(&commonCmdData, cmd)
+	common.SetupConfigTemplatesDir(&commonCmdData, cmd)
+	common.SetupConfigValues(&commonCmdData, cmd)
+	common.SetupConfigPath(&commonCmdData, cmd)
+	common.SetupEnvironment(&commonCmdData, cmd)
+
+	common.SetupGiterminismInspectorOptions(&commonCmdData, cmd)
+
+	common.SetupTmpDir(&commonCmdData, cmd)
+	common.SetupHomeDir(&commonCmdData, cmd)
+	common.SetupSSHKey(&commonCmdData, cmd)
+
+	common.SetupSecondaryStagesStorageOptions(&commonCmdData, cmd)
+	common.SetupStagesStorageOptions(&commonCmdData, cmd)
+
+	common.SetupDockerConfig(&commonCmdData, cmd, "Command needs granted permissions to read images from the specified repo")
+	common.SetupInsecureRegistry(&commonCmdData, cmd)
+	common.SetupSkipTlsVerifyRegistry(&commonCmdData, cmd)
+
+	common.SetupLogOptions(&commonCmdData, cmd)
+	common.SetupLogProjectDir(&commonCmdData, cmd)
+
+	common.SetupSynchronization(&commonCmdData, cmd)
+	common.SetupKubeConfig(&commonCmdData, cmd)
+	common.SetupKubeConfigBase64(&commonCmdData, cmd)
+	common.SetupKubeContext(&commonCmdData, cmd)
+
+	common.SetupParallelOptions(&commonCmdData, cmd, common.DefaultCleanupParallelTasksLimit)
+
+	common.SetupOutputFormat(&commonCmdData, cmd)
+
+	cmd.Flags().StringVarP(&cmdData.Image, "image", "", os.Getenv("WERF_IMAGE"), "List only stages of the specified image (default $WERF_IMAGE)")
+	cmd.Flags().StringVarP(&cmdData.StageName, "stage-name", "", os.Getenv("WERF_STAGE_NAME"), "List only stages with the specified name, e.g. install or gitLatestPatch (default $WERF_STAGE_NAME)")
+	cmd.Flags().StringVarP(&cmdData.OlderThan, "older-than", "", os.Getenv("WERF_OLDER_THAN"), "List only stages created more than the specified duration ago, e.g. 72h (default $WERF_OLDER_THAN)")
+	cmd.Flags().StringVarP(&cmdData.NewerThan, "newer-than", "", os.Getenv("WERF_NEWER_THAN"), "List only stages created less than the specified duration ago, e.g. 30m (default $WERF_NEWER_THAN)")
+	cmd.Flags().StringVarP(&cmdData.MinSize, "min-size", "", os.Getenv("WERF_MIN_SIZE"), "List only stages not smaller than the specified size, e.g. 100MiB (default $WERF_MIN_SIZE)")
+	cmd.Flags().StringVarP(&cmdData.MaxSize, "max-size", "", os.Getenv("WERF_MAX_SIZE"), "List only stages not larger than the specified size, e.g. 1GiB (default $WERF_MAX_SIZE)")
+
+	return cmd
+}
+
+type stagesFilter struct {
+	ImageName string
+	StageName string
+	OlderThan time.Duration
+	NewerThan time.Duration
+	MinSize   int64
+	MaxSize   int64
+}
+
+func getStagesFilter() (*stagesFilter, error) {
+	filter := &stagesFilter{ImageName: cmdData.Image, StageName: cmdData.StageName}
+
+	for _, opt := range []struct {
+		name  string
+		value string
+		dest  *time.Duration
+	}{
+		{"--older-than", cmdData.OlderThan, &filter.OlderThan},
+		{"--newer-than", cmdData.NewerThan, &filter.NewerThan},
+	} {
+		if opt.value == "" {
+			continue
+		}
+
+		d, err := time.ParseDuration(opt.value)
+		if err != nil {
+			return nil, fmt.Errorf("bad %s given %q: %s", opt.name, opt.value, err)
+		}
+		*opt.dest = d
+	}
+
+	for _, opt := range []struct {
+		name  string
+		value string
+		dest  *int64
+	}{
+		{"--min-size", cmdData.MinSize, &filter.MinSize},
+		{"--max-size", cmdData.MaxSize, &filter.MaxSize},
+	} {
+		if opt.value == "" {
+			continue
+		}
+
+		size, err := units.RAMInBytes(opt.value)
+		if err != nil {
+			return nil, fmt.Errorf("bad %s given %q: %s", opt.name, opt.value, err)
+		}
+		*opt.dest = size
+	}
+
+	return filter, nil
+}
+
+func (f *stagesFilter) Apply(stages []*stage_inspect.Stage) []*stage_inspect.Stage {
+	if f.ImageName != "" {
+		stages = stage_inspect.ImageStages(stages, f.ImageName)
+	}
+
+	now := time.Now()
+
+	var res []*stage_inspect.Stage
+	for _, stg := range stages {
+		switch {
+		case f.StageName != "" && stg.StageName != f.StageName:
+		case f.OlderThan != 0 && now.Sub(stg.CreatedAt) < f.OlderThan:
+		case f.NewerThan != 0 && now.Sub(stg.CreatedAt) > f.NewerThan:
+		case f.MinSize != 0 && stg.Size < f.MinSize:
+		case f.MaxSize != 0 && stg.Size > f.MaxSize:
+		default:
+			res = append(res, stg)
+		}
+	}
+
+	return res
+}
+
+func run() error {
+	ctx := common.BackgroundContext()
+
+	if err := werf.Init(*commonCmdData.TmpDir, *commonCmdData.HomeDir); err != nil {
+		return fmt.Errorf("initialization error: %s", err)
+	}
+
+	outputFormat, err := common.GetOutputFormat(&commonCmdData)
+	if err != nil {
+		return err
+	}
+
+	filter, err := getStagesFilter()
+	if err != nil {
+		return err
+	}
+
+	if err := common.InitGiterminismInspector(&commonCmdData); err != nil {
+		return err
+	}
+
+	if err := git_repo.Init(); err != nil {
+		return err
+	}
+
+	if err := true_git.Init(true_git.Options{LiveGitOutput: *commonCmdData.LogVerbose || *commonCmdData.LogDebug}); err != nil {
+		return err
+	}
+
+	if err := image.Init(); err != nil {
+		return err
+	}
+
+	if err := common.DockerRegistryInit(&commonCmdData); err != nil {
+		return err
+	}
+
+	if err := docker.Init(ctx, *commonCmdData.DockerConfig, *commonCmdData.LogVerbose, *commonCmdData.LogDebug); err != nil {
+		return err
+	}
+
+	ctxWithDockerCli, err := docker.NewContext(ctx)
+	if err != nil {
+		return err
+	}
+	ctx = ctxWithDockerCli
+
+	projectDir, err := common.GetProjectDir(&commonCmdData)
+	if err != nil {
+		return fmt.Errorf("getting project dir failed: %s", err)
+	}
+
+	projectTmpDir, err := tmp_manager.CreateProjectDir(ctx)
+	if err != nil {
+		return fmt.Errorf("getting project tmp dir failed: %s", err)
+	}
+	defer tmp_manager.ReleaseProjectDir(projectTmpDir)
+
+	localGitRepo, err := common.OpenLocalGitRepo(projectDir)
+	if err != nil {
+		return fmt.Errorf("unable to open local repo %s: %s", projectDir, err)
+	}
+
+	werfConfig, err := common.GetOptionalWerfConfig(ctx, projectDir, &commonCmdData, localGitRepo, common.GetWerfConfigOptions(&commonCmdData, false))
+	if err != nil {
+		return fmt.Errorf("unable to load werf config: %s", err)
+	}
+
+	var projectName string
+	if werfConfig != nil {
+		projectName = werfConfig.Meta.Project
+	} else if *commonCmdData.ProjectName != "" {
+		projectName = *commonCmdData.ProjectName
+	} else {
+		return fmt.Errorf("run command in the project directory with werf.yaml or specify --project-name=PROJECT_NAME param")
+	}
+
+	stagesStorageAddress := common.GetOptionalStagesStorageAddress(&commonCmdData)
+	containerRuntime := &container_runtime.LocalDockerServerRuntime{} // TODO
+	stagesStorage, err := common.GetStagesStorage(stagesStorageAddress, containerRuntime, &commonCmdData)
+	if err != nil {
+		return err
+	}
+
+	synchronization, err := common.GetSynchronization(ctx, &commonCmdData, projectName, stagesStorage)
+	if err != nil {
+		return err
+	}
+	stagesStorageCache, err := common.GetStagesStorageCache(synchronization)
+	if err != nil {
+		return err
+	}
+	storageLockManager, err := common.GetStorageLockManager(ctx, synchronization)
+	if err != nil {
+		return err
+	}
+	secondaryStagesStorageList, err := common.GetSecondaryStagesStorageList(stagesStorage, containerRuntime, &commonCmdData)
+	if err != nil {
+		return err
+	}
+
+	storageManager := manager.NewStorageManager(projectName, stagesStorage, secondaryStagesStorageList, storageLockManager, stagesStorageCache)
+	if *commonCmdData.Parallel {
+		storageManager.EnableParallel(int(*commonCmdData.ParallelTasksLimit))
+	}
+
+	imageNameList, err := stagesStorage.GetManagedImages(ctx, projectName)
+	if err != nil {
+		return fmt.Errorf("unable to list known config image names for project %q: %s", projectName, err)
+	}
+
+	if werfConfig != nil {
+		for _, img := range werfConfig.GetAllImages() {
+			if !util.IsStringsContainValue(imageNameList, img.GetName()) {
+				imageNameList = append(imageNameList, img.GetName())
+			}
+		}
+	}
+
+	stages, err := stage_inspect.GetStages(ctx, storageManager, imageNameList)
+	if err != nil {
+		return err
+	}
+
+	stages = filter.Apply(stages)
+
+	switch outputFormat {
+	case common.OutputFormatJSON:
+		// labels are available with werf stage inspect
+		for _, stg := range stages {
+			stg.Labels = nil
+		}
+
+		data, err := json.MarshalIndent(stages, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(data))
+	default:
+		printStagesTable(stages)
+	}
+
+	return nil
+}
+
+func printStagesTable(stages []*stage_inspect.Stage) {
+	t := uitable.New()
+	t.MaxColWidth = 80
+	t.AddRow("STAGE ID", "NAME", "CREATED", "SIZE", "PARENT STAGE", "COMMIT", "GIT COMMITS", "VIRTUAL MERGE", "IMAGES")
+
+	now := time.Now()
+	for _, stg := range stages {
+		var gitCommits []string
+		for _, gm := range stg.GitMappings {
+			gitCommits = append(gitCommits, shortCommit(gm.Commit))
+		}
+
+		var images []string
+		for _, imageMetadata := range stg.ImageMetadata {
+			images = append(images, fmt.Sprintf("%s (%d)", imageMetadataImageName(imageMetadata.ImageName), len(imageMetadata.Commits)))
+		}
+
+		virtualMerge := "no"
+		if stg.HasVirtualMerge() {
+			virtualMerge = "yes"
+		}
+
+		t.AddRow(
+			stg.StageID,
+			valueOrDash(stg.StageName),
+			units.HumanDuration(now.Sub(stg.CreatedAt))+" ago",
+			units.BytesSize(float64(stg.Size)),
+			valueOrDash(stg.ParentStageID),
+			valueOrDash(shortCommit(stg.ProjectRepoCommit)),
+			valueOrDash(strings.Join(gitCommits, ", ")),
+			virtualMerge,
+			valueOrDash(strings.Join(images, ", ")),
+		)
+	}
+
+	fmt.Println(t)
+}
+
+func imageMetadataImageName(imageName string) string {
+	if imageName == "" {
+		return "~"
+	}
+	return imageName
+}
+
+func shortCommit(commit string) string {
+	if len(commit) > 8 {
+		return commit[:8]
+	}
+	return commit
+}
+
+func valueOrDash(value string) string {
+	if value == "" {
+		return "-"
+	}
+	return value
+}
@@ -116,7 +116,7 @@ func runSynchronization() error {
 			return fmt.Errorf("cannot initialize kube: %s", err)
 		}
 
-		if err := common.InitKubedog(ctx); err != nil {
+		if err := kubeutils.InitKubedog(ctx); err != nil {
 			return fmt.Errorf("cannot init kubedog: %s", err)
 		}
 
@@ -0,0 +1,113 @@
+// Package api allows to build, render and converge werf projects from Go programs the same way werf CLI commands do.
+//
+// werf keeps process-wide state (home and tmp dirs, docker client, giterminism inspector, helm settings),
+// so Init should be called once per process and operations should not be run concurrently.
+//
+//	ctx, err := api.Init(context.Background(), api.InitOptions{ProjectDir: dir})
+//	if err != nil {
+//		return err
+//	}
+//	defer api.Terminate()
+//
+//	project, err := api.OpenProject(ctx, api.ProjectOptions{Dir: dir})
+//	if err != nil {
+//		return err
+//	}
+//
+//	res, err := api.Build(ctx, project, api.BuildOptions{Storage: api.StorageOptions{Repo: "registry.example.com/project"}})
+package api
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/werf/werf/pkg/docker"
+	"github.com/werf/werf/pkg/docker_registry"
+	"github.com/werf/werf/pkg/git_repo"
+	"github.com/werf/werf/pkg/giterminism_inspector"
+	"github.com/werf/werf/pkg/image"
+	"github.com/werf/werf/pkg/ssh_agent"
+	"github.com/werf/werf/pkg/true_git"
+	"github.com/werf/werf/pkg/werf"
+)
+
+type InitOptions struct {
+	// TmpDir and HomeDir are system tmp dir and ~/.werf by default
+	TmpDir  string
+	HomeDir string
+
+	// ProjectDir is the dir the giterminism inspector reads werf-giterminism.yaml from
+	ProjectDir                     string
+	LooseGiterminism               bool
+	NonStrictGiterminismInspection bool
+	DevMode                        bool
+
+	// DockerConfig is the docker config dir, ~/.docker by default
+	DockerConfig          string
+	InsecureRegistry      bool
+	SkipTlsVerifyRegistry bool
+
+	// SSHKeys are used for remote git repositories, keys of the running ssh agent or ~/.ssh/id_rsa are used by default
+	SSHKeys             []string
+	GitHTTPSCredentials []*true_git.HTTPSCredential
+
+	// LogVerbose and LogDebug enable verbose output of git and docker
+	LogVerbose bool
+	LogDebug   bool
+}
+
+// Init initializes werf and returns the context which should be used for all operations.
+func Init(ctx context.Context, opts InitOptions) (context.Context, error) {
+	if err := werf.Init(opts.TmpDir, opts.HomeDir); err != nil {
+		return nil, fmt.Errorf("initialization error: %s", err)
+	}
+
+	if err := giterminism_inspector.Init(opts.ProjectDir, giterminism_inspector.InspectionOptions{
+		LooseGiterminism: opts.LooseGiterminism,
+		NonStrict:        opts.NonStrictGiterminismInspection,
+		DevMode:          opts.DevMode,
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := git_repo.Init(); err != nil {
+		return nil, err
+	}
+
+	if err := image.Init(); err != nil {
+		return nil, err
+	}
+
+	if err := true_git.Init(true_git.Options{LiveGitOutput: opts.LogVerbose || opts.LogDebug}); err != nil {
+		return nil, err
+	}
+
+	if err := docker_registry.Init(ctx, opts.InsecureRegistry, opts.SkipTlsVerifyRegistry); err != nil {
+		return nil, err
+	}
+
+	if err := docker.Init(ctx, opts.DockerConfig, opts.LogVerbose, opts.LogDebug); err != nil {
+		return nil, err
+	}
+
+	ctxWithDockerCli, err := docker.NewContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ctx = ctxWithDockerCli
+
+	if err := true_git.InitHTTPSCredentials(opts.GitHTTPSCredentials); err != nil {
+		return nil, fmt.Errorf("cannot initialize git https credentials: %s", err)
+	}
+
+	if err := ssh_agent.Init(ctx, opts.SSHKeys); err != nil {
+		return nil, fmt.Errorf("cannot initialize ssh agent: %s", err)
+	}
+
+	return ctx, nil
+}
+
+// Terminate stops the ssh agent started by Init.
+func Terminate() error {
+	return ssh_agent.Terminate()
+}
@@ -0,0 +1,358 @@
+package api
+
+import (
+	"context"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-containerregistry/pkg/registry"
+	"helm.sh/helm/v3/pkg/release"
+
+	"github.com/werf/logboek"
+
+	"github.com/werf/werf/pkg/config"
+	"github.com/werf/werf/pkg/storage"
+)
+
+func TestStorageOptions_StagesStorageAddress(t *testing.T) {
+	if address := (StorageOptions{}).stagesStorageAddress(); address != storage.LocalStorageAddress {
+		t.Errorf("expected %q by default, got %q", storage.LocalStorageAddress, address)
+	}
+
+	if address := (StorageOptions{Repo: "registry.example.com/app"}).stagesStorageAddress(); address != "registry.example.com/app" {
+		t.Errorf("expected repo, got %q", address)
+	}
+}
+
+func TestGetProjectDir(t *testing.T) {
+	currentDir, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		dir      string
+		expected string
+	}{
+		{dir: "", expected: currentDir},
+		{dir: "project/../app", expected: filepath.Join(currentDir, "app")},
+		{dir: "/project", expected: "/project"},
+	}
+
+	for _, tt := range tests {
+		if dir, err := GetProjectDir(tt.dir); err != nil {
+			t.Errorf("%q: unexpected error: %s", tt.dir, err)
+		} else if dir != tt.expected {
+			t.Errorf("%q: expected %q, got %q", tt.dir, tt.expected, dir)
+		}
+	}
+}
+
+func TestGetHelmChartDir(t *testing.T) {
+	werfConfig := newTestWerfConfig()
+	if dir := GetHelmChartDir(werfConfig); dir != ".helm" {
+		t.Errorf("expected .helm by default, got %q", dir)
+	}
+
+	chartDir := "deploy/chart"
+	werfConfig.Meta.Deploy.HelmChartDir = &chartDir
+	if dir := GetHelmChartDir(werfConfig); dir != chartDir {
+		t.Errorf("expected %q, got %q", chartDir, dir)
+	}
+}
+
+func TestValidateRepoImplementation(t *testing.T) {
+	for _, implementation := range []string{"", "auto", "default", "harbor"} {
+		if err := ValidateRepoImplementation(implementation); err != nil {
+			t.Errorf("%q: unexpected error: %s", implementation, err)
+		}
+	}
+
+	if err := ValidateRepoImplementation("unknown"); err == nil {
+		t.Errorf("expected error for unknown implementation")
+	}
+}
+
+func TestPrepareDeploy_ReleaseAndNamespace(t *testing.T) {
+	tests := []struct {
+		name              string
+		env               string
+		opts              DeployOptions
+		hasImages         bool
+		expectedRelease   string
+		expectedNamespace string
+		expectedErr       string
+	}{
+		{
+			name:              "project name by default",
+			expectedRelease:   "app",
+			expectedNamespace: "app",
+		},
+		{
+			name:              "project name and env",
+			env:               "production",
+			expectedRelease:   "app-production",
+			expectedNamespace: "app-production",
+		},
+		{
+			name:              "specified release and namespace",
+			env:               "production",
+			opts:              DeployOptions{Release: "release", Namespace: "namespace"},
+			expectedRelease:   "release",
+			expectedNamespace: "namespace",
+		},
+		{
+			name:              "images are not built without repo",
+			hasImages:         true,
+			opts:              DeployOptions{SkipBuild: true},
+			expectedRelease:   "app",
+			expectedNamespace: "app",
+		},
+		{
+			name:        "bad release",
+			opts:        DeployOptions{Release: "Release_1"},
+			expectedErr: "bad Helm release specified 'Release_1'",
+		},
+		{
+			name:        "bad namespace",
+			opts:        DeployOptions{Namespace: "Namespace_1"},
+			expectedErr: "bad Kubernetes namespace specified 'Namespace_1'",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			project := newTestProject(tt.hasImages)
+			project.Env = tt.env
+
+			res, imagesInfoGetters, err := prepareDeploy(newTestContext(), project, tt.opts)
+			if tt.expectedErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.expectedErr) {
+					t.Fatalf("expected error containing %q, got %v", tt.expectedErr, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if res.ReleaseName != tt.expectedRelease || res.Namespace != tt.expectedNamespace || res.Repo != "" || res.Images != nil {
+				t.Errorf("unexpected result %+v", *res)
+			}
+
+			if imagesInfoGetters != nil {
+				t.Errorf("expected no images, got %v", imagesInfoGetters)
+			}
+		})
+	}
+}
+
+func TestErrors(t *testing.T) {
+	ctx := newTestContext()
+
+	tests := []struct {
+		name        string
+		f           func() error
+		expectedErr string
+	}{
+		{
+			name: "converge without repo",
+			f: func() error {
+				_, err := Converge(ctx, newTestProject(true), ConvergeOptions{})
+				return err
+			},
+			expectedErr: "repo is required to converge the project with images",
+		},
+		{
+			name: "plan without repo",
+			f: func() error {
+				_, err := Plan(ctx, newTestProject(true), PlanOptions{})
+				return err
+			},
+			expectedErr: "repo is required to plan the project with images",
+		},
+		{
+			name: "converge clusters without repo",
+			f: func() error {
+				_, err := ConvergeClusters(ctx, newTestProject(true), ConvergeClustersOptions{Waves: [][]string{{"eu"}}})
+				return err
+			},
+			expectedErr: "repo is required to converge the project with images",
+		},
+		{
+			name: "converge clusters without waves",
+			f: func() error {
+				_, err := ConvergeClusters(ctx, newTestProject(false), ConvergeClustersOptions{})
+				return err
+			},
+			expectedErr: "no clusters to converge",
+		},
+		{
+			name: "track release without project and namespace",
+			f: func() error {
+				_, err := TrackRelease(ctx, nil, TrackReleaseOptions{Release: "app"})
+				return err
+			},
+			expectedErr: "release and namespace are required to track the release without the project",
+		},
+		{
+			name: "build unknown image",
+			f: func() error {
+				_, err := Build(ctx, newTestProject(true), BuildOptions{ImagesToProcess: []string{"unknown"}})
+				return err
+			},
+			expectedErr: "is not defined in werf.yaml",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.f(); err == nil || !strings.Contains(err.Error(), tt.expectedErr) {
+				t.Errorf("expected error containing %q, got %v", tt.expectedErr, err)
+			}
+		})
+	}
+}
+
+// TestPrepareDeploy_SkipBuild checks that deploy with SkipBuild fails if stages of images are not in the repo.
+// The repo is the in-memory registry and the docker server is stubbed with the empty list of images.
+func TestPrepareDeploy_SkipBuild(t *testing.T) {
+	dir, err := ioutil.TempDir("", "werf-api-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	projectDir := filepath.Join(dir, "project")
+	if err := os.MkdirAll(projectDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := ioutil.WriteFile(filepath.Join(projectDir, "werf.yaml"), []byte("project: app\nconfigVersion: 1\n---\nimage: app\nfrom: alpine\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, args := range [][]string{{"init"}, {"add", "-A"}, {"commit", "-m", "+"}} {
+		cmd := exec.Command("git", append([]string{"-c", "user.name=werf", "-c", "user.email=werf@werf.io"}, args...)...)
+		cmd.Dir = projectDir
+		if output, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %s failed: %s\n%s", strings.Join(args, " "), err, output)
+		}
+	}
+
+	dockerServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Api-Version", "1.40")
+		if strings.HasSuffix(r.URL.Path, "/_ping") {
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte("[]"))
+	}))
+	defer dockerServer.Close()
+
+	dockerHost, isDockerHostSet := os.LookupEnv("DOCKER_HOST")
+	if err := os.Setenv("DOCKER_HOST", strings.Replace(dockerServer.URL, "http://", "tcp://", 1)); err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if isDockerHostSet {
+			_ = os.Setenv("DOCKER_HOST", dockerHost)
+		} else {
+			_ = os.Unsetenv("DOCKER_HOST")
+		}
+	}()
+
+	// the registry does not support listing of tags, the repo has no tags
+	registryHandler := registry.New(registry.Logger(log.New(ioutil.Discard, "", 0)))
+	registryServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/tags/list") {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"name": "app", "tags": []}`))
+			return
+		}
+
+		registryHandler.ServeHTTP(w, r)
+	}))
+	defer registryServer.Close()
+
+	ctx, err := Init(newTestContext(), InitOptions{
+		TmpDir:           dir,
+		HomeDir:          filepath.Join(dir, "home"),
+		ProjectDir:       projectDir,
+		DockerConfig:     filepath.Join(dir, "docker"),
+		InsecureRegistry: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer Terminate()
+
+	project, err := OpenProject(ctx, ProjectOptions{Dir: projectDir})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, _, err = prepareDeploy(ctx, project, DeployOptions{
+		SkipBuild: true,
+		Storage: StorageOptions{
+			Repo:            strings.TrimPrefix(registryServer.URL, "http://") + "/app",
+			Synchronization: ":local",
+		},
+	})
+	if err == nil || !strings.Contains(err.Error(), "stages required") {
+		t.Errorf("expected stages required error, got %v", err)
+	}
+}
+
+func TestReleaseManifests(t *testing.T) {
+	rel := &release.Release{
+		Manifest: "---\n# Source: app/templates/service.yaml\nkind: Service\n",
+		Hooks: []*release.Hook{
+			{Path: "app/templates/job.yaml", Manifest: "kind: Job"},
+			{Path: "app/templates/secret.yaml", Manifest: "kind: Secret"},
+		},
+	}
+
+	expected := `---
+# Source: app/templates/service.yaml
+kind: Service
+
+---
+# Source: app/templates/job.yaml
+kind: Job
+
+---
+# Source: app/templates/secret.yaml
+kind: Secret
+`
+
+	if res := string(releaseManifests(rel)); res != expected {
+		t.Errorf("expected:\n%s\ngot:\n%s", expected, res)
+	}
+}
+
+func newTestProject(hasImages bool) *Project {
+	werfConfig := newTestWerfConfig()
+	if hasImages {
+		werfConfig.ImagesFromDockerfile = []*config.ImageFromDockerfile{{Name: "app"}}
+	}
+
+	return &Project{Dir: "/project", WerfConfig: werfConfig}
+}
+
+func newTestWerfConfig() *config.WerfConfig {
+	return &config.WerfConfig{Meta: &config.Meta{ConfigVersion: 1, Project: "app"}}
+}
+
+func newTestContext() context.Context {
+	return logboek.NewContext(context.Background(), logboek.NewLogger(ioutil.Discard, ioutil.Discard))
+}
@@ -0,0 +1,176 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+
+	"github.com/werf/logboek"
+
+	"github.com/werf/werf/pkg/build"
+	"github.com/werf/werf/pkg/logging"
+	"github.com/werf/werf/pkg/ssh_agent"
+	"github.com/werf/werf/pkg/tmp_manager"
+	"github.com/werf/werf/pkg/util"
+)
+
+type BuildOptions struct {
+	Storage StorageOptions
+
+	// ImagesToProcess are names of images and artifacts to build, all images are built by default
+	ImagesToProcess []string
+
+	Build    build.BuildOptions
+	Conveyor build.ConveyorOptions
+
+	// AffectedSince is a git revision, only images affected by changes since the revision are built
+	AffectedSince string
+	// Shard limits images to build to the shard of independent images
+	Shard *build.ShardOptions
+}
+
+type ImageResult struct {
+	Name            string                 `json:"name"`
+	IsArtifact      bool                   `json:"isArtifact,omitempty"`
+	DockerImageName string                 `json:"dockerImageName,omitempty"`
+	DockerTag       string                 `json:"dockerTag,omitempty"`
+	DockerImageID   string                 `json:"dockerImageID,omitempty"`
+	Status          build.ImageBuildStatus `json:"status"`
+	Error           string                 `json:"error,omitempty"`
+}
+
+type BuildResult struct {
+	// Repo is the address of the stages storage images are stored in
+	Repo   string         `json:"repo"`
+	Images []*ImageResult `json:"images"`
+}
+
+// Build builds images of the project and stores stages in the stages storage.
+// In the keep-going mode the result with statuses of all images is returned along with the build error.
+func Build(ctx context.Context, project *Project, opts BuildOptions) (*BuildResult, error) {
+	for _, imageToProcess := range opts.ImagesToProcess {
+		if !project.WerfConfig.HasImageOrArtifact(imageToProcess) {
+			return nil, fmt.Errorf("specified image %s is not defined in werf.yaml", logging.ImageLogName(imageToProcess, false))
+		}
+	}
+
+	res := &BuildResult{Repo: opts.Storage.stagesStorageAddress()}
+
+	imagesToProcess := opts.ImagesToProcess
+	if opts.Shard != nil {
+		var err error
+		imagesToProcess, err = build.SelectShardImages(ctx, project.WerfConfig, imagesToProcess, *opts.Shard)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(imagesToProcess) == 0 {
+			logboek.Context(ctx).Default().LogF("Shard %s has no images to build\n", opts.Shard)
+			return res, nil
+		}
+	}
+
+	buildOptions := opts.Build
+	if opts.AffectedSince != "" {
+		affectedSinceOptions, err := getAffectedSinceOptions(project, opts.AffectedSince)
+		if err != nil {
+			return nil, err
+		}
+		buildOptions.AffectedSince = affectedSinceOptions
+	}
+
+	err := withConveyor(ctx, project, opts.Storage, imagesToProcess, opts.Conveyor, func(c *build.Conveyor) error {
+		buildErr := c.Build(ctx, buildOptions)
+		res.Images = getImageResults(c, buildErr)
+		return buildErr
+	})
+
+	if res.Images == nil {
+		return nil, err
+	}
+
+	return res, err
+}
+
+// withConveyor runs f with the conveyor of the project, f is rerun with the new conveyor if the stages storage cache is reset.
+func withConveyor(ctx context.Context, project *Project, storageOptions StorageOptions, imagesToProcess []string, conveyorOptions build.ConveyorOptions, f func(c *build.Conveyor) error) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	projectTmpDir, err := tmp_manager.CreateProjectDir(ctx)
+	if err != nil {
+		return fmt.Errorf("getting project tmp dir failed: %s", err)
+	}
+	defer tmp_manager.ReleaseProjectDir(projectTmpDir)
+
+	s, err := NewStorage(ctx, project.Name(), storageOptions)
+	if err != nil {
+		return err
+	}
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	logboek.LogOptionalLn()
+
+	conveyorWithRetry := build.NewConveyorWithRetryWrapper(project.WerfConfig, project.LocalGitRepo, imagesToProcess, project.Dir, projectTmpDir, ssh_agent.SSHAuthSock, s.ContainerRuntime, s.StorageManager, s.StorageLockManager, conveyorOptions)
+	defer conveyorWithRetry.Terminate()
+
+	return conveyorWithRetry.WithRetryBlock(ctx, func(c *build.Conveyor) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		return f(c)
+	})
+}
+
+func getImageResults(c *build.Conveyor, buildErr error) []*ImageResult {
+	var res []*ImageResult
+	for _, img := range c.GetImages() {
+		imageResult := &ImageResult{
+			Name:       img.GetName(),
+			IsArtifact: img.IsArtifact(),
+			Status:     build.ImageBuildSucceeded,
+		}
+
+		if buildResult := c.GetImageBuildResult(img.GetName()); buildResult != nil {
+			imageResult.Status = buildResult.Status
+			if buildResult.Err != nil {
+				imageResult.Error = buildResult.Err.Error()
+			}
+		} else if buildErr != nil {
+			imageResult.Status = build.ImageBuildFailed
+			imageResult.Error = buildErr.Error()
+		}
+
+		if imageResult.Status == build.ImageBuildSucceeded {
+			infoGetter := img.GetImageInfoGetter()
+			imageResult.DockerImageName = infoGetter.GetName()
+			imageResult.DockerTag = infoGetter.GetTag()
+			imageResult.DockerImageID = c.GetImageIDForLastImageStage(img.GetName())
+		}
+
+		res = append(res, imageResult)
+	}
+
+	return res
+}
+
+func getAffectedSinceOptions(project *Project, revision string) (*build.AffectedSinceOptions, error) {
+	if project.LocalGitRepo == nil {
+		return nil, fmt.Errorf("affected since option requires the project dir to be in the git repo")
+	}
+
+	werfConfigPath := project.ConfigPath
+	if filepath.IsAbs(werfConfigPath) {
+		werfConfigPath = util.GetRelativeToBaseFilepath(project.Dir, werfConfigPath)
+	}
+
+	return &build.AffectedSinceOptions{
+		Revision:    revision,
+		ConfigPaths: []string{werfConfigPath, project.ConfigTemplatesDir},
+	}, nil
+}
@@ -0,0 +1,566 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	cmd_helm "helm.sh/helm/v3/cmd/helm"
+	"helm.sh/helm/v3/pkg/action"
+	"helm.sh/helm/v3/pkg/chart"
+	"helm.sh/helm/v3/pkg/chart/loader"
+	"helm.sh/helm/v3/pkg/cli/values"
+	"helm.sh/helm/v3/pkg/getter"
+	"helm.sh/helm/v3/pkg/release"
+
+	"github.com/werf/kubedog/pkg/kube"
+	"github.com/werf/logboek"
+
+	"github.com/werf/werf/pkg/build"
+	"github.com/werf/werf/pkg/config"
+	"github.com/werf/werf/pkg/deploy"
+	"github.com/werf/werf/pkg/deploy/helm"
+	"github.com/werf/werf/pkg/deploy/lock_manager"
+	"github.com/werf/werf/pkg/deploy/validation"
+	"github.com/werf/werf/pkg/deploy/werf_chart"
+	"github.com/werf/werf/pkg/image"
+	"github.com/werf/werf/pkg/kubeutils"
+)
+
+type DeployOptions struct {
+	Storage  StorageOptions
+	Build    build.BuildOptions
+	Conveyor build.ConveyorOptions
+	// SkipBuild fails if images are not built instead of building them
+	SkipBuild bool
+
+	// Release and Namespace are taken from werf.yaml by default
+	Release   string
+	Namespace string
+
+	Values           values.Options
+	SecretValueFiles []string
+	IgnoreSecretKey  bool
+	ExtraAnnotations map[string]string
+	ExtraLabels      map[string]string
+
+	// Validate enables validation of rendered manifests against Kubernetes schemas without access to the cluster
+	Validate *validation.Options
+}
+
+type DeployResult struct {
+	ReleaseName string `json:"releaseName"`
+	Namespace   string `json:"namespace"`
+	// Repo is empty if images are not used or stub images are rendered
+	Repo   string         `json:"repo,omitempty"`
+	Images []*ImageResult `json:"images,omitempty"`
+}
+
+type RenderOptions struct {
+	DeployOptions
+
+	// Output is the writer rendered manifests are written to
+	Output io.Writer
+	Debug  bool
+}
+
+// Render renders manifests of the project chart into the output.
+// Images are built if the repo is specified, otherwise stub image names are rendered.
+func Render(ctx context.Context, project *Project, opts RenderOptions) (*DeployResult, error) {
+	res, imagesInfoGetters, err := prepareDeploy(ctx, project, opts.DeployOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	isStub := project.hasImages() && res.Repo == ""
+	imagesRepository := res.Repo
+	if isStub {
+		imagesRepository = "REPO"
+	}
+
+	wc, err := newWerfChart(ctx, project, opts.DeployOptions, res, imagesRepository, imagesInfoGetters, isStub, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	actionConfig := new(action.Configuration)
+	if err := helm.InitActionConfig(ctx, nil, res.Namespace, cmd_helm.Settings, actionConfig, helm.InitActionConfigOptions{}); err != nil {
+		return nil, err
+	}
+
+	cmd_helm.Settings.Debug = opts.Debug
+
+	setGlobalLoadOptions(ctx, project, wc)
+
+	var manifests bytes.Buffer
+	valueOpts := opts.Values
+	helmTemplateCmd, _ := cmd_helm.NewTemplateCmd(actionConfig, &manifests, cmd_helm.TemplateCmdOptions{
+		PostRenderer: wc.ExtraAnnotationsAndLabelsPostRenderer,
+		ValueOpts:    &valueOpts,
+	})
+	if err := wc.WrapTemplate(ctx, func() error {
+		return helmTemplateCmd.RunE(helmTemplateCmd, []string{res.ReleaseName, wc.ChartDir})
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := wc.CheckPolicies(ctx, manifests.Bytes()); err != nil {
+		return res, err
+	}
+
+	if _, err := opts.Output.Write(manifests.Bytes()); err != nil {
+		return res, fmt.Errorf("unable to write manifests: %s", err)
+	}
+
+	if opts.Validate != nil {
+		if err := checkManifests(ctx, actionConfig, wc, res.ReleaseName, opts.Values, opts.Validate); err != nil {
+			return res, err
+		}
+	}
+
+	return res, nil
+}
+
+type ConvergeOptions struct {
+	DeployOptions
+
+	KubeConfigOptions kube.KubeConfigOptions
+	// KubeInitializer is created with KubeConfigOptions by default
+	KubeInitializer *kubeutils.OndemandKubeInitializer
+
+	StatusProgressPeriod      time.Duration
+	HooksStatusProgressPeriod time.Duration
+	ReleasesHistoryMax        int
+
+	Timeout      time.Duration
+	AutoRollback bool
+}
+
+// Converge builds images of the project and installs or upgrades the release of the project chart in kubernetes.
+// The repo is required if the project has images.
+func Converge(ctx context.Context, project *Project, opts ConvergeOptions) (*DeployResult, error) {
+	if project.hasImages() && opts.Storage.Repo == "" {
+		return nil, fmt.Errorf("repo is required to converge the project with images")
+	}
+
+	kubeInitializer := opts.KubeInitializer
+	if kubeInitializer == nil {
+		kubeInitializer = kubeutils.NewOndemandKubeInitializer(opts.KubeConfigOptions.Context, opts.KubeConfigOptions.ConfigPath, opts.KubeConfigOptions.ConfigDataBase64)
+	}
+	if err := kubeInitializer.Init(ctx); err != nil {
+		return nil, err
+	}
+
+	res, imagesInfoGetters, err := prepareDeploy(ctx, project, opts.DeployOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	lockManager, err := lock_manager.NewLockManager(res.Namespace)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create lock manager: %s", err)
+	}
+
+	wc, err := newWerfChart(ctx, project, opts.DeployOptions, res, res.Repo, imagesInfoGetters, false, lockManager)
+	if err != nil {
+		return nil, err
+	}
+
+	actionConfig := new(action.Configuration)
+	if err := helm.InitActionConfig(ctx, kubeInitializer, res.Namespace, cmd_helm.Settings, actionConfig, helm.InitActionConfigOptions{
+		StatusProgressPeriod:      opts.StatusProgressPeriod,
+		HooksStatusProgressPeriod: opts.HooksStatusProgressPeriod,
+		KubeConfigOptions:         opts.KubeConfigOptions,
+		ReleasesHistoryMax:        opts.ReleasesHistoryMax,
+	}); err != nil {
+		return nil, err
+	}
+
+	setGlobalLoadOptions(ctx, project, wc)
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	if err := checkManifests(ctx, actionConfig, wc, res.ReleaseName, opts.Values, opts.Validate); err != nil {
+		return res, err
+	}
+
+	valueOpts := opts.Values
+	trueValue := true
+	helmUpgradeCmd, _ := cmd_helm.NewUpgradeCmd(actionConfig, logboek.ProxyOutStream(), cmd_helm.UpgradeCmdOptions{
+		PostRenderer:    wc.ExtraAnnotationsAndLabelsPostRenderer,
+		ValueOpts:       &valueOpts,
+		CreateNamespace: &trueValue,
+		Install:         &trueValue,
+		Wait:            &trueValue,
+		Atomic:          &opts.AutoRollback,
+		Timeout:         &opts.Timeout,
+	})
+	if err := wc.WrapUpgrade(ctx, func() error {
+		return helmUpgradeCmd.RunE(helmUpgradeCmd, []string{res.ReleaseName, wc.ChartDir})
+	}); err != nil {
+		return res, err
+	}
+
+	return res, nil
+}
+
+type TrackReleaseOptions struct {
+	// Release and Namespace are taken from werf.yaml by default
+	Release   string
+	Namespace string
+
+	KubeConfigOptions kube.KubeConfigOptions
+	// KubeInitializer is created with KubeConfigOptions by default
+	KubeInitializer *kubeutils.OndemandKubeInitializer
+
+	StatusProgressPeriod time.Duration
+
+	Timeout time.Duration
+	// UpdateReleaseStatus marks the latest revision of the release as deployed or failed depending on the tracking result
+	UpdateReleaseStatus bool
+}
+
+type TrackReleaseResult struct {
+	ReleaseName string `json:"releaseName"`
+	Namespace   string `json:"namespace"`
+	Revision    int    `json:"revision"`
+	Status      string `json:"status"`
+}
+
+// TrackRelease waits for resources of the latest revision of the project release to become ready or failed,
+// e.g. to re-attach to the release which deploy process has been interrupted.
+// The project can be nil if both the release and the namespace are specified.
+func TrackRelease(ctx context.Context, project *Project, opts TrackReleaseOptions) (*TrackReleaseResult, error) {
+	if project == nil && (opts.Release == "" || opts.Namespace == "") {
+		return nil, fmt.Errorf("release and namespace are required to track the release without the project")
+	}
+
+	var env string
+	var werfConfig *config.WerfConfig
+	if project != nil {
+		env = project.Env
+		werfConfig = project.WerfConfig
+	}
+
+	releaseName, err := deploy.GetHelmRelease(opts.Release, env, werfConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	namespace, err := deploy.GetKubernetesNamespace(opts.Namespace, env, werfConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	kubeInitializer := opts.KubeInitializer
+	if kubeInitializer == nil {
+		kubeInitializer = kubeutils.NewOndemandKubeInitializer(opts.KubeConfigOptions.Context, opts.KubeConfigOptions.ConfigPath, opts.KubeConfigOptions.ConfigDataBase64)
+	}
+	if err := kubeInitializer.Init(ctx); err != nil {
+		return nil, err
+	}
+
+	actionConfig := new(action.Configuration)
+	if err := helm.InitActionConfig(ctx, kubeInitializer, namespace, cmd_helm.Settings, actionConfig, helm.InitActionConfigOptions{
+		StatusProgressPeriod: opts.StatusProgressPeriod,
+		KubeConfigOptions:    opts.KubeConfigOptions,
+	}); err != nil {
+		return nil, err
+	}
+
+	trackOpts := helm.TrackReleaseOptions{
+		Timeout:             opts.Timeout,
+		UpdateReleaseStatus: opts.UpdateReleaseStatus,
+	}
+
+	if opts.UpdateReleaseStatus {
+		lockManager, err := lock_manager.NewLockManager(namespace)
+		if err != nil {
+			return nil, fmt.Errorf("unable to create lock manager: %s", err)
+		}
+		trackOpts.LockManager = lockManager
+	}
+
+	res := &TrackReleaseResult{ReleaseName: releaseName, Namespace: namespace}
+	rel, err := helm.TrackRelease(ctx, actionConfig, releaseName, trackOpts)
+	if rel != nil {
+		res.Revision = rel.Version
+		res.Status = rel.Info.Status.String()
+	}
+
+	return res, err
+}
+
+type PlanOptions struct {
+	DeployOptions
+
+	KubeConfigOptions kube.KubeConfigOptions
+	// KubeInitializer is created with KubeConfigOptions by default
+	KubeInitializer *kubeutils.OndemandKubeInitializer
+}
+
+type PlanResult struct {
+	DeployResult
+	// Revision is the revision of the release which is compared with the rendered chart, 0 if the release does not exist
+	Revision int                    `json:"revision,omitempty"`
+	Changes  []*helm.ResourceChange `json:"changes"`
+}
+
+func (r *PlanResult) HasChanges() bool {
+	return len(r.Changes) != 0
+}
+
+// Plan renders the project chart the same way Converge does and returns changes of release resources
+// which will be made by Converge, secret values are masked. Hooks are not taken into account.
+func Plan(ctx context.Context, project *Project, opts PlanOptions) (*PlanResult, error) {
+	if project.hasImages() && opts.Storage.Repo == "" {
+		return nil, fmt.Errorf("repo is required to plan the project with images")
+	}
+
+	kubeInitializer := opts.KubeInitializer
+	if kubeInitializer == nil {
+		kubeInitializer = kubeutils.NewOndemandKubeInitializer(opts.KubeConfigOptions.Context, opts.KubeConfigOptions.ConfigPath, opts.KubeConfigOptions.ConfigDataBase64)
+	}
+	if err := kubeInitializer.Init(ctx); err != nil {
+		return nil, err
+	}
+
+	deployRes, imagesInfoGetters, err := prepareDeploy(ctx, project, opts.DeployOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	wc, err := newWerfChart(ctx, project, opts.DeployOptions, deployRes, deployRes.Repo, imagesInfoGetters, false, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	actionConfig := new(action.Configuration)
+	if err := helm.InitActionConfig(ctx, kubeInitializer, deployRes.Namespace, cmd_helm.Settings, actionConfig, helm.InitActionConfigOptions{
+		KubeConfigOptions: opts.KubeConfigOptions,
+	}); err != nil {
+		return nil, err
+	}
+
+	setGlobalLoadOptions(ctx, project, wc)
+
+	res := &PlanResult{DeployResult: *deployRes}
+
+	currentRelease, err := helm.GetCurrentRelease(actionConfig, deployRes.ReleaseName)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get release %q: %s", deployRes.ReleaseName, err)
+	}
+	if currentRelease != nil {
+		res.Revision = currentRelease.Version
+	}
+
+	var targetRelease *release.Release
+	if err := wc.WrapTemplate(ctx, func() error {
+		chartPath, err := loader.GlobalLoadOptions.LocateChartFunc(wc.ChartDir, cmd_helm.Settings)
+		if err != nil {
+			return err
+		}
+
+		vals, err := opts.Values.MergeValues(getter.All(cmd_helm.Settings), loader.GlobalLoadOptions.ReadFileFunc)
+		if err != nil {
+			return err
+		}
+
+		ch, err := loader.Load(chartPath)
+		if err != nil {
+			return err
+		}
+		if req := ch.Metadata.Dependencies; req != nil {
+			if err := action.CheckDependencies(ch, req); err != nil {
+				return err
+			}
+		}
+
+		if currentRelease == nil {
+			installClient := action.NewInstall(actionConfig)
+			installClient.DryRun = true
+			installClient.ReleaseName = deployRes.ReleaseName
+			installClient.Namespace = deployRes.Namespace
+			installClient.PostRenderer = wc.ExtraAnnotationsAndLabelsPostRenderer
+
+			targetRelease, err = installClient.Run(ch, vals)
+		} else {
+			upgradeClient := action.NewUpgrade(actionConfig)
+			upgradeClient.DryRun = true
+			upgradeClient.Namespace = deployRes.Namespace
+			upgradeClient.PostRenderer = wc.ExtraAnnotationsAndLabelsPostRenderer
+
+			targetRelease, err = upgradeClient.Run(deployRes.ReleaseName, ch, vals)
+		}
+
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := wc.CheckPolicies(ctx, releaseManifests(targetRelease)); err != nil {
+		return nil, err
+	}
+
+	res.Changes, err = helm.PlanRelease(actionConfig, targetRelease, currentRelease, helm.PlanReleaseOptions{
+		SecretValuesToMask: wc.GetSecretValuesToMask(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// prepareDeploy resolves the release and namespace and builds images, images are not built for the local stages storage.
+func prepareDeploy(ctx context.Context, project *Project, opts DeployOptions) (*DeployResult, []*image.InfoGetter, error) {
+	releaseName, err := deploy.GetHelmRelease(opts.Release, project.Env, project.WerfConfig)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	namespace, err := deploy.GetKubernetesNamespace(opts.Namespace, project.Env, project.WerfConfig)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	res := &DeployResult{ReleaseName: releaseName, Namespace: namespace}
+	if !project.hasImages() || opts.Storage.Repo == "" {
+		return res, nil, nil
+	}
+
+	var imagesInfoGetters []*image.InfoGetter
+	if err := withConveyor(ctx, project, opts.Storage, nil, opts.Conveyor, func(c *build.Conveyor) error {
+		res.Repo = c.StorageManager.StagesStorage.String()
+
+		if opts.SkipBuild {
+			if err := c.ShouldBeBuilt(ctx); err != nil {
+				return err
+			}
+		} else {
+			if err := c.Build(ctx, opts.Build); err != nil {
+				return err
+			}
+		}
+
+		res.Images = getImageResults(c, nil)
+		imagesInfoGetters = c.GetImageInfoGetters()
+
+		return nil
+	}); err != nil {
+		return nil, nil, err
+	}
+
+	logboek.LogOptionalLn()
+
+	if err := ctx.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	return res, imagesInfoGetters, nil
+}
+
+func newWerfChart(ctx context.Context, project *Project, opts DeployOptions, res *DeployResult, imagesRepository string, imagesInfoGetters []*image.InfoGetter, isStub bool, lockManager *lock_manager.LockManager) (*werf_chart.WerfChart, error) {
+	chartDir := GetHelmChartDir(project.WerfConfig)
+
+	if err := deploy.ReportHelmSetValues(ctx, opts.Values, project.LocalGitRepo); err != nil {
+		return nil, err
+	}
+
+	secretsManager, err := deploy.GetSafeSecretManager(ctx, project.Dir, chartDir, opts.SecretValueFiles, project.LocalGitRepo, opts.IgnoreSecretKey)
+	if err != nil {
+		return nil, err
+	}
+
+	wc := werf_chart.NewWerfChart(ctx, project.LocalGitRepo, project.Dir, werf_chart.WerfChartOptions{
+		ReleaseName: res.ReleaseName,
+		ChartDir:    chartDir,
+
+		SecretValueFiles: opts.SecretValueFiles,
+		ExtraAnnotations: opts.ExtraAnnotations,
+		ExtraLabels:      opts.ExtraLabels,
+
+		LockManager:    lockManager,
+		SecretsManager: secretsManager,
+	})
+	if err := wc.SetEnv(project.Env); err != nil {
+		return nil, err
+	}
+	if err := wc.SetWerfConfig(project.WerfConfig); err != nil {
+		return nil, err
+	}
+	if vals, err := werf_chart.GetServiceValues(ctx, project.Name(), imagesRepository, imagesInfoGetters, werf_chart.ServiceValuesOptions{Namespace: res.Namespace, Env: project.Env, IsStub: isStub}); err != nil {
+		return nil, fmt.Errorf("error creating service values: %s", err)
+	} else if err := wc.SetServiceValues(vals); err != nil {
+		return nil, err
+	}
+
+	return wc, nil
+}
+
+// checkManifests renders manifests of the chart including hooks and CRDs of the chart without access to the cluster,
+// checks them with policy rules of the chart and validates them if validation options are specified.
+func checkManifests(ctx context.Context, actionConfig *action.Configuration, wc *werf_chart.WerfChart, releaseName string, valueOpts values.Options, validateOpts *validation.Options) error {
+	var manifests bytes.Buffer
+	helmTemplateCmd, _ := cmd_helm.NewTemplateCmd(actionConfig, &manifests, cmd_helm.TemplateCmdOptions{
+		PostRenderer: wc.ExtraAnnotationsAndLabelsPostRenderer,
+		ValueOpts:    &valueOpts,
+	})
+	if err := helmTemplateCmd.Flags().Set("include-crds", "true"); err != nil {
+		return err
+	}
+
+	if err := wc.WrapTemplate(ctx, func() error {
+		return helmTemplateCmd.RunE(helmTemplateCmd, []string{releaseName, wc.ChartDir})
+	}); err != nil {
+		return err
+	}
+
+	if err := wc.CheckPolicies(ctx, manifests.Bytes()); err != nil {
+		return err
+	}
+
+	if validateOpts == nil {
+		return nil
+	}
+
+	return logboek.Context(ctx).Default().LogProcess("Validating manifests").DoError(func() error {
+		return validation.ValidateManifests(ctx, manifests.Bytes(), *validateOpts)
+	})
+}
+
+// releaseManifests returns manifests of the release including hooks in the helm template format.
+func releaseManifests(rel *release.Release) []byte {
+	var res bytes.Buffer
+	res.WriteString(rel.Manifest)
+	for _, hook := range rel.Hooks {
+		fmt.Fprintf(&res, "\n---\n# Source: %s\n%s\n", hook.Path, hook.Manifest)
+	}
+
+	return res.Bytes()
+}
+
+func setGlobalLoadOptions(ctx context.Context, project *Project, wc *werf_chart.WerfChart) {
+	loader.GlobalLoadOptions = &loader.LoadOptions{
+		ChartExtender: wc,
+		SubchartExtenderFactoryFunc: func() chart.ChartExtender {
+			return werf_chart.NewWerfChart(ctx, nil, project.Dir, werf_chart.WerfChartOptions{})
+		},
+		LoadDirFunc:     deploy.MakeChartDirLoadFunc(ctx, project.LocalGitRepo, project.Dir),
+		LocateChartFunc: deploy.MakeLocateChartFunc(ctx, project.LocalGitRepo, project.Dir),
+		ReadFileFunc:    deploy.MakeHelmReadFileFunc(ctx, project.LocalGitRepo, project.Dir),
+	}
+}
+
+// GetHelmChartDir returns the chart dir relative to the project dir.
+func GetHelmChartDir(werfConfig *config.WerfConfig) string {
+	if werfConfig.Meta.Deploy.HelmChartDir != nil && *werfConfig.Meta.Deploy.HelmChartDir != "" {
+		return *werfConfig.Meta.Deploy.HelmChartDir
+	}
+
+	return ".helm"
+}
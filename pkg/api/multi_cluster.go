@@ -0,0 +1,129 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/werf/logboek"
+
+	"github.com/werf/werf/pkg/config"
+	"github.com/werf/werf/pkg/kubeutils"
+)
+
+const (
+	ClusterStatusDeployed = "deployed"
+	ClusterStatusFailed   = "failed"
+	ClusterStatusSkipped  = "skipped"
+)
+
+type ConvergeClustersOptions struct {
+	// ConvergeOptions are used to deploy into each cluster,
+	// KubeConfigOptions.Context and KubeInitializer are replaced with the kube context of the cluster
+	ConvergeOptions
+
+	// Waves are kube contexts of clusters: waves are deployed one by one, the failure policy is applied after each wave
+	Waves [][]string
+	// FailurePolicy is config.MultiClusterFailurePolicyStop (default) or config.MultiClusterFailurePolicyContinue
+	FailurePolicy string
+}
+
+type ClusterResult struct {
+	KubeContext string `json:"kubeContext"`
+	Wave        int    `json:"wave"`
+	Status      string `json:"status"`
+	Error       string `json:"error,omitempty"`
+}
+
+type ConvergeClustersResult struct {
+	DeployResult
+	Clusters []*ClusterResult `json:"clusters"`
+}
+
+func (r *ConvergeClustersResult) FailedClusters() []string {
+	var res []string
+	for _, c := range r.Clusters {
+		if c.Status == ClusterStatusFailed {
+			res = append(res, c.KubeContext)
+		}
+	}
+	return res
+}
+
+// ConvergeClusters builds images of the project once, then deploys the release into multiple clusters wave by wave.
+// Remaining waves are skipped after the failed wave unless the failure policy is continue.
+// The result with statuses of all clusters is returned along with the error.
+//
+// Kube clients are shared by the process, thus clusters are deployed one by one, each with its own kube initializer.
+func ConvergeClusters(ctx context.Context, project *Project, opts ConvergeClustersOptions) (*ConvergeClustersResult, error) {
+	if project.hasImages() && opts.Storage.Repo == "" {
+		return nil, fmt.Errorf("repo is required to converge the project with images")
+	}
+
+	if len(opts.Waves) == 0 {
+		return nil, fmt.Errorf("no clusters to converge")
+	}
+
+	deployRes, _, err := prepareDeploy(ctx, project, opts.DeployOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &ConvergeClustersResult{DeployResult: *deployRes}
+	err = convergeWaves(ctx, res, opts.Waves, opts.FailurePolicy, func(ctx context.Context, kubeContext string) error {
+		clusterOpts := opts.ConvergeOptions
+		// images are already built
+		clusterOpts.SkipBuild = true
+		clusterOpts.KubeConfigOptions.Context = kubeContext
+		clusterOpts.KubeInitializer = kubeutils.NewOndemandKubeInitializer(kubeContext, opts.KubeConfigOptions.ConfigPath, opts.KubeConfigOptions.ConfigDataBase64)
+
+		_, err := Converge(ctx, project, clusterOpts)
+		return err
+	})
+
+	return res, err
+}
+
+// convergeWaves calls convergeCluster for clusters of waves and records their statuses into the result.
+func convergeWaves(ctx context.Context, res *ConvergeClustersResult, waves [][]string, failurePolicy string, convergeCluster func(ctx context.Context, kubeContext string) error) error {
+	var wavesResults [][]*ClusterResult
+	for i, wave := range waves {
+		var waveResults []*ClusterResult
+		for _, kubeContext := range wave {
+			waveResults = append(waveResults, &ClusterResult{KubeContext: kubeContext, Wave: i + 1, Status: ClusterStatusSkipped})
+		}
+
+		wavesResults = append(wavesResults, waveResults)
+		res.Clusters = append(res.Clusters, waveResults...)
+	}
+
+	var isFailed bool
+	for _, waveResults := range wavesResults {
+		if isFailed && failurePolicy != config.MultiClusterFailurePolicyContinue {
+			break
+		}
+
+		// the error of the cluster does not stop other clusters of the wave
+		for _, clusterRes := range waveResults {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+
+			if err := logboek.Context(ctx).Default().LogProcess("Converging cluster %s (wave %d/%d)", clusterRes.KubeContext, clusterRes.Wave, len(waves)).DoError(func() error {
+				return convergeCluster(ctx, clusterRes.KubeContext)
+			}); err != nil {
+				clusterRes.Status = ClusterStatusFailed
+				clusterRes.Error = err.Error()
+				isFailed = true
+			} else {
+				clusterRes.Status = ClusterStatusDeployed
+			}
+		}
+	}
+
+	if failedClusters := res.FailedClusters(); len(failedClusters) != 0 {
+		return fmt.Errorf("deploy into %d of %d clusters failed: %s", len(failedClusters), len(res.Clusters), strings.Join(failedClusters, ", "))
+	}
+
+	return nil
+}
@@ -0,0 +1,93 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"reflect"
+	"testing"
+
+	"github.com/werf/logboek"
+
+	"github.com/werf/werf/pkg/config"
+)
+
+func TestConvergeWaves(t *testing.T) {
+	ctx := logboek.NewContext(context.Background(), logboek.NewLogger(ioutil.Discard, ioutil.Discard))
+	waves := [][]string{{"eu-west", "eu-central"}, {"us-east"}, {"asia"}}
+
+	tests := []struct {
+		name           string
+		failurePolicy  string
+		failedClusters []string
+		converged      []string
+		statuses       []string
+		isErr          bool
+	}{
+		{
+			name:      "allDeployed",
+			converged: []string{"eu-west", "eu-central", "us-east", "asia"},
+			statuses:  []string{ClusterStatusDeployed, ClusterStatusDeployed, ClusterStatusDeployed, ClusterStatusDeployed},
+		},
+		{
+			name:           "stopAfterFailedWave",
+			failurePolicy:  config.MultiClusterFailurePolicyStop,
+			failedClusters: []string{"eu-west"},
+			converged:      []string{"eu-west", "eu-central"},
+			statuses:       []string{ClusterStatusFailed, ClusterStatusDeployed, ClusterStatusSkipped, ClusterStatusSkipped},
+			isErr:          true,
+		},
+		{
+			name:           "stopByDefault",
+			failedClusters: []string{"us-east"},
+			converged:      []string{"eu-west", "eu-central", "us-east"},
+			statuses:       []string{ClusterStatusDeployed, ClusterStatusDeployed, ClusterStatusFailed, ClusterStatusSkipped},
+			isErr:          true,
+		},
+		{
+			name:           "continueAfterFailedWave",
+			failurePolicy:  config.MultiClusterFailurePolicyContinue,
+			failedClusters: []string{"eu-central"},
+			converged:      []string{"eu-west", "eu-central", "us-east", "asia"},
+			statuses:       []string{ClusterStatusDeployed, ClusterStatusFailed, ClusterStatusDeployed, ClusterStatusDeployed},
+			isErr:          true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var converged []string
+			res := &ConvergeClustersResult{}
+			err := convergeWaves(ctx, res, waves, test.failurePolicy, func(ctx context.Context, kubeContext string) error {
+				converged = append(converged, kubeContext)
+				for _, failedCluster := range test.failedClusters {
+					if kubeContext == failedCluster {
+						return fmt.Errorf("failed")
+					}
+				}
+				return nil
+			})
+
+			if isErr := err != nil; isErr != test.isErr {
+				t.Errorf("\n[EXPECTED ERROR]: %v\n[GOT ERROR]: %v", test.isErr, err)
+			}
+
+			if !reflect.DeepEqual(converged, test.converged) {
+				t.Errorf("\n[EXPECTED CONVERGED]: %v\n[GOT CONVERGED]: %v", test.converged, converged)
+			}
+
+			var statuses []string
+			for _, clusterRes := range res.Clusters {
+				statuses = append(statuses, clusterRes.Status)
+			}
+
+			if !reflect.DeepEqual(statuses, test.statuses) {
+				t.Errorf("\n[EXPECTED STATUSES]: %v\n[GOT STATUSES]: %v", test.statuses, statuses)
+			}
+
+			if !reflect.DeepEqual(res.FailedClusters(), test.failedClusters) {
+				t.Errorf("\n[EXPECTED FAILED]: %v\n[GOT FAILED]: %v", test.failedClusters, res.FailedClusters())
+			}
+		})
+	}
+}
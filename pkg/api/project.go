@@ -0,0 +1,103 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/werf/werf/pkg/config"
+	"github.com/werf/werf/pkg/git_repo"
+	"github.com/werf/werf/pkg/giterminism_inspector"
+)
+
+type ProjectOptions struct {
+	// Dir is the project dir, the current dir by default
+	Dir string
+	// ConfigPath is the custom path to werf.yaml
+	ConfigPath string
+	// ConfigTemplatesDir is the custom dir with werf.yaml templates, .werf in the project dir by default
+	ConfigTemplatesDir string
+	Env                string
+	// ConfigValuesFiles and ConfigSetValues are merged into .Values of werf.yaml templates
+	ConfigValuesFiles []string
+	ConfigSetValues   []string
+
+	LogRenderedConfigPath bool
+}
+
+type Project struct {
+	Dir                string
+	ConfigPath         string
+	ConfigTemplatesDir string
+	Env                string
+
+	// LocalGitRepo is nil if the project dir is not in the git repo
+	LocalGitRepo *git_repo.Local
+	WerfConfig   *config.WerfConfig
+}
+
+func (p *Project) Name() string {
+	return p.WerfConfig.Meta.Project
+}
+
+// OpenProject opens the local git repo of the project and loads werf.yaml.
+func OpenProject(ctx context.Context, opts ProjectOptions) (*Project, error) {
+	projectDir, err := GetProjectDir(opts.Dir)
+	if err != nil {
+		return nil, fmt.Errorf("getting project dir failed: %s", err)
+	}
+
+	localGitRepo, err := git_repo.OpenLocalRepo("own", projectDir, giterminism_inspector.DevMode)
+	if err != nil {
+		return nil, fmt.Errorf("unable to open local repo %s: %s", projectDir, err)
+	}
+
+	configPath, err := config.GetWerfConfigPath(ctx, projectDir, opts.ConfigPath, true, localGitRepo)
+	if err != nil {
+		return nil, fmt.Errorf("unable to load werf config: %s", err)
+	}
+
+	configTemplatesDir := config.GetWerfConfigTemplatesDir(projectDir, opts.ConfigTemplatesDir)
+
+	werfConfig, err := config.GetWerfConfig(ctx, projectDir, configPath, configTemplatesDir, localGitRepo, config.WerfConfigOptions{
+		LogRenderedFilePath: opts.LogRenderedConfigPath,
+		Env:                 opts.Env,
+		ConfigValuesFiles:   opts.ConfigValuesFiles,
+		ConfigSetValues:     opts.ConfigSetValues,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to load werf config: %s", err)
+	}
+
+	return &Project{
+		Dir:                projectDir,
+		ConfigPath:         configPath,
+		ConfigTemplatesDir: configTemplatesDir,
+		Env:                opts.Env,
+		LocalGitRepo:       localGitRepo,
+		WerfConfig:         werfConfig,
+	}, nil
+}
+
+// GetProjectDir returns the absolute path of the dir, relative paths are relative to the current dir.
+func GetProjectDir(dir string) (string, error) {
+	currentDir, err := os.Getwd()
+	if err != nil {
+		return "", err
+	}
+
+	if dir != "" {
+		if filepath.IsAbs(dir) {
+			return dir, nil
+		} else {
+			return filepath.Clean(filepath.Join(currentDir, dir)), nil
+		}
+	}
+
+	return currentDir, nil
+}
+
+func (p *Project) hasImages() bool {
+	return len(p.WerfConfig.StapelImages) != 0 || len(p.WerfConfig.ImagesFromDockerfile) != 0
+}
@@ -0,0 +1,125 @@
+package api
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/werf/werf/pkg/container_runtime"
+	"github.com/werf/werf/pkg/docker_registry"
+	"github.com/werf/werf/pkg/storage"
+	"github.com/werf/werf/pkg/storage/manager"
+)
+
+type StorageOptions struct {
+	// Repo is the address of the stages storage, the local docker server is used by default
+	Repo                  string
+	RepoImplementation    string
+	DockerRegistryOptions docker_registry.DockerRegistryOptions
+
+	// SecondaryRepos are read-only stages storages which stages are copied from into the Repo
+	SecondaryRepos []string
+
+	// Synchronization is the address of synchronizer for multiple werf processes to work with a single repo:
+	// :local, kubernetes://NAMESPACE[:CONTEXT][@(base64:CONFIG_DATA)|CONFIG_PATH] or http[s]://HOST:PORT.
+	// :local is used by default for the local stages storage and https://synchronization.werf.io for the repo
+	Synchronization string
+}
+
+func (opts StorageOptions) stagesStorageAddress() string {
+	if opts.Repo == "" {
+		return storage.LocalStorageAddress
+	}
+
+	return opts.Repo
+}
+
+type Storage struct {
+	ContainerRuntime   container_runtime.ContainerRuntime
+	StorageManager     *manager.StorageManager
+	StorageLockManager storage.LockManager
+}
+
+// NewStorage creates the stages storage of the project with secondary stages storages and synchronization.
+func NewStorage(ctx context.Context, projectName string, opts StorageOptions) (*Storage, error) {
+	containerRuntime := &container_runtime.LocalDockerServerRuntime{} // TODO
+
+	stagesStorage, err := NewStagesStorage(opts.stagesStorageAddress(), containerRuntime, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	synchronization, err := GetSynchronization(ctx, projectName, opts.Synchronization, stagesStorage)
+	if err != nil {
+		return nil, err
+	}
+	stagesStorageCache, err := GetStagesStorageCache(synchronization)
+	if err != nil {
+		return nil, err
+	}
+	storageLockManager, err := GetStorageLockManager(ctx, synchronization)
+	if err != nil {
+		return nil, err
+	}
+	secondaryStagesStorageList, err := NewSecondaryStagesStorageList(stagesStorage, containerRuntime, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Storage{
+		ContainerRuntime:   containerRuntime,
+		StorageManager:     manager.NewStorageManager(projectName, stagesStorage, secondaryStagesStorageList, storageLockManager, stagesStorageCache),
+		StorageLockManager: storageLockManager,
+	}, nil
+}
+
+func NewStagesStorage(stagesStorageAddress string, containerRuntime container_runtime.ContainerRuntime, opts StorageOptions) (storage.StagesStorage, error) {
+	if err := ValidateRepoImplementation(opts.RepoImplementation); err != nil {
+		return nil, err
+	}
+
+	return storage.NewStagesStorage(
+		stagesStorageAddress,
+		containerRuntime,
+		storage.StagesStorageOptions{
+			RepoStagesStorageOptions: storage.RepoStagesStorageOptions{
+				Implementation:        opts.RepoImplementation,
+				DockerRegistryOptions: opts.DockerRegistryOptions,
+			},
+		},
+	)
+}
+
+// NewSecondaryStagesStorageList returns the local stages storage for the repo stages storage and the secondary repos.
+func NewSecondaryStagesStorageList(stagesStorage storage.StagesStorage, containerRuntime container_runtime.ContainerRuntime, opts StorageOptions) ([]storage.StagesStorage, error) {
+	var res []storage.StagesStorage
+	if stagesStorage.Address() != storage.LocalStorageAddress {
+		localStagesStorage, err := storage.NewStagesStorage(storage.LocalStorageAddress, containerRuntime, storage.StagesStorageOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("unable to create local secondary stages storage: %s", err)
+		}
+		res = append(res, localStagesStorage)
+	}
+
+	for _, address := range opts.SecondaryRepos {
+		repoStagesStorage, err := storage.NewStagesStorage(address, containerRuntime, storage.StagesStorageOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("unable to create secondary stages storage at %s: %s", address, err)
+		}
+		res = append(res, repoStagesStorage)
+	}
+
+	return res, nil
+}
+
+func ValidateRepoImplementation(implementation string) error {
+	supportedValues := docker_registry.ImplementationList()
+	supportedValues = append(supportedValues, "auto", "")
+
+	for _, supportedImplementation := range supportedValues {
+		if supportedImplementation == implementation {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("specified docker registry implementation '%s' is not supported", implementation)
+}
@@ -0,0 +1,329 @@
+package build
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/werf/logboek"
+	"github.com/werf/logboek/pkg/style"
+	"github.com/werf/logboek/pkg/types"
+
+	"github.com/werf/werf/pkg/config"
+	"github.com/werf/werf/pkg/image"
+	"github.com/werf/werf/pkg/path_matcher"
+	"github.com/werf/werf/pkg/true_git"
+)
+
+type AffectedSinceOptions struct {
+	// Revision is a git branch, tag, commit or an expression (e.g. HEAD~1) to compare the head commit with
+	Revision string
+	// ConfigPaths are paths of werf.yaml and templates relative to the project dir, their changes affect all images
+	ConfigPaths []string
+}
+
+// changedPaths are paths of files changed since the revision.
+type changedPaths struct {
+	// repoPaths are relative to the git repo root like paths of git mappings
+	repoPaths []string
+	// projectPaths are relative to the project dir like paths of werf.yaml and dockerfile contexts
+	projectPaths []string
+}
+
+// newChangedPaths rebases paths relative to the git repo root onto the project dir, paths outside the project dir are omitted.
+func newChangedPaths(repoPaths []string, projectDirInRepo string) changedPaths {
+	projectDirInRepo = path.Clean(filepath.ToSlash(projectDirInRepo))
+	if projectDirInRepo == "." {
+		return changedPaths{repoPaths: repoPaths, projectPaths: repoPaths}
+	}
+
+	res := changedPaths{repoPaths: repoPaths}
+	for _, p := range repoPaths {
+		if strings.HasPrefix(p, projectDirInRepo+"/") {
+			res.projectPaths = append(res.projectPaths, strings.TrimPrefix(p, projectDirInRepo+"/"))
+		}
+	}
+
+	return res
+}
+
+// determineAffectedImages marks images which inputs have been changed since the revision and images which depend on them.
+// Only affected images and their dependencies are processed by the build phase,
+// other images use the stages built for the revision commit (see determineSkippedImages).
+func (c *Conveyor) determineAffectedImages(ctx context.Context, opts AffectedSinceOptions) error {
+	return logboek.Context(ctx).Default().LogProcess("Determining of images affected since %s", opts.Revision).
+		Options(func(options types.LogProcessOptionsInterface) {
+			options.Style(style.Highlight())
+		}).
+		DoError(func() error {
+			return c.doDetermineAffectedImages(ctx, opts)
+		})
+}
+
+func (c *Conveyor) doDetermineAffectedImages(ctx context.Context, opts AffectedSinceOptions) error {
+	if c.localGitRepo == nil {
+		return fmt.Errorf("local git repo is required to determine affected images")
+	}
+
+	fromCommit, err := c.localGitRepo.ResolveCommit(ctx, opts.Revision)
+	if err != nil {
+		return err
+	}
+
+	headCommit, err := c.localGitRepo.HeadCommit(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to get local repo head commit: %s", err)
+	}
+
+	repoChangedPaths, err := c.localGitRepo.ChangedPaths(ctx, fromCommit, headCommit)
+	if err != nil {
+		return fmt.Errorf("unable to get changed paths between commits %s and %s: %s", fromCommit, headCommit, err)
+	}
+
+	projectDirInRepo, err := c.getProjectDirInRepo()
+	if err != nil {
+		return err
+	}
+
+	changedPaths := newChangedPaths(repoChangedPaths, projectDirInRepo)
+
+	logboek.Context(ctx).Info().LogF("Changed paths between commits %s and %s: %d\n", fromCommit, headCommit, len(repoChangedPaths))
+
+	c.affectedImages = map[string]string{}
+
+	if len(opts.ConfigPaths) != 0 {
+		configPathMatcher := path_matcher.NewSimplePathMatcher("", opts.ConfigPaths, false)
+		if changedPath := firstMatchedPath(configPathMatcher, changedPaths.projectPaths); changedPath != "" {
+			for _, img := range c.images {
+				c.affectedImages[img.GetName()] = fmt.Sprintf("werf configuration %s changed", changedPath)
+			}
+		}
+	}
+
+	for _, imageSet := range c.imageSets {
+		for _, img := range imageSet {
+			if _, isAffected := c.affectedImages[img.GetName()]; isAffected {
+				continue
+			}
+
+			if reason := c.getImageAffectedReason(img, changedPaths); reason != "" {
+				c.affectedImages[img.GetName()] = reason
+			}
+		}
+	}
+
+	if err := c.determineSkippedImages(ctx, fromCommit); err != nil {
+		return err
+	}
+
+	for _, img := range c.images {
+		if reason, isAffected := c.affectedImages[img.GetName()]; isAffected {
+			logboek.Context(ctx).Default().LogFDetails("%s: affected (%s)\n", img.LogDetailedName(), reason)
+		} else if stageDesc := c.skippedImages[img.GetName()]; stageDesc != nil {
+			logboek.Context(ctx).Default().LogFDetails("%s: not affected, using %s built for commit %s\n", img.LogDetailedName(), stageDesc.Info.Name, fromCommit)
+		} else if c.isImageSkipped(img.GetName()) {
+			logboek.Context(ctx).Default().LogFDetails("%s: not affected, not required\n", img.LogDetailedName())
+		} else {
+			logboek.Context(ctx).Default().LogFDetails("%s: not affected, stages will be taken from the repo\n", img.LogDetailedName())
+		}
+	}
+
+	return nil
+}
+
+// getProjectDirInRepo returns the project dir relative to the git repo root.
+func (c *Conveyor) getProjectDirInRepo() (string, error) {
+	prefix, err := true_git.GetWorkTreePrefix(c.projectDir)
+	if err != nil {
+		return "", fmt.Errorf("unable to get the project dir %s path in the git repo: %s", c.projectDir, err)
+	}
+
+	return prefix, nil
+}
+
+// determineSkippedImages selects not affected images which are not processed by the build phase.
+// The last stage of such image is the stage built for the revision commit (image metadata), such image is not needed to be built or its digest calculated.
+// Artifacts do not have image metadata, so only artifacts which are not required by the processed images are skipped.
+func (c *Conveyor) determineSkippedImages(ctx context.Context, fromCommit string) error {
+	var unaffectedImageNames []string
+	for _, img := range c.images {
+		if !img.isArtifact && !c.isImageAffected(img.GetName()) {
+			unaffectedImageNames = append(unaffectedImageNames, img.GetName())
+		}
+	}
+
+	revisionStages := map[string]*image.StageDescription{}
+	if len(unaffectedImageNames) != 0 {
+		imageMetadataByImageName, _, err := c.StorageManager.StagesStorage.GetAllAndGroupImageMetadataByImageName(ctx, c.projectName(), unaffectedImageNames)
+		if err != nil {
+			return fmt.Errorf("unable to get images metadata: %s", err)
+		}
+
+		for _, imageName := range unaffectedImageNames {
+			for _, stageID := range imageMetadataByImageName[imageName][fromCommit] {
+				stageDesc, err := c.getStageDescriptionByStageID(ctx, stageID)
+				if err != nil {
+					return err
+				}
+
+				if stageDesc != nil {
+					revisionStages[imageName] = stageDesc
+					break
+				}
+			}
+		}
+	}
+
+	c.skippedImages = c.selectSkippedImages(revisionStages)
+
+	return nil
+}
+
+// selectSkippedImages returns not affected images with the revision stages which are not dependencies of the processed images.
+// Skipped artifacts have no stage.
+func (c *Conveyor) selectSkippedImages(revisionStages map[string]*image.StageDescription) map[string]*image.StageDescription {
+	isSkippable := func(img *Image) bool {
+		if c.isImageAffected(img.GetName()) {
+			return false
+		}
+
+		_, hasRevisionStage := revisionStages[img.GetName()]
+		return img.isArtifact || hasRevisionStage
+	}
+
+	requiredImages := map[string]bool{}
+	var requireImage func(imageName string)
+	requireImage = func(imageName string) {
+		if requiredImages[imageName] {
+			return
+		}
+
+		requiredImages[imageName] = true
+		for _, depName := range c.werfConfig.GetImageDependenciesNames(imageName) {
+			requireImage(depName)
+		}
+	}
+
+	for _, img := range c.images {
+		if !isSkippable(img) {
+			requireImage(img.GetName())
+		}
+	}
+
+	res := map[string]*image.StageDescription{}
+	for _, img := range c.images {
+		if isSkippable(img) && !requiredImages[img.GetName()] {
+			res[img.GetName()] = revisionStages[img.GetName()]
+		}
+	}
+
+	return res
+}
+
+func (c *Conveyor) getStageDescriptionByStageID(ctx context.Context, stageID string) (*image.StageDescription, error) {
+	id, err := image.ParseStageID(stageID)
+	if err != nil {
+		return nil, err
+	}
+
+	stageDesc, err := c.StorageManager.StagesStorage.GetStageDescription(ctx, c.projectName(), id.Digest, id.UniqueID)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get stage %s description: %s", stageID, err)
+	}
+
+	return stageDesc, nil
+}
+
+func (c *Conveyor) getImageAffectedReason(img *Image, changedPaths changedPaths) string {
+	// images are processed set by set, so dependencies are already determined
+	for _, depName := range c.werfConfig.GetImageDependenciesNames(img.GetName()) {
+		if _, isAffected := c.affectedImages[depName]; isAffected {
+			return fmt.Sprintf("dependency %s affected", depName)
+		}
+	}
+
+	var imageConfig config.ImageInterface
+	if img.isArtifact {
+		imageConfig = c.werfConfig.GetArtifact(img.GetName())
+	} else {
+		imageConfig = c.werfConfig.GetImage(img.GetName())
+	}
+
+	switch imageConfig := imageConfig.(type) {
+	case config.StapelImageInterface:
+		imageBaseConfig := imageConfig.ImageBaseConfig()
+
+		if imageBaseConfig.FromLatest {
+			return "fromLatest is used"
+		}
+
+		if imageBaseConfig.Git == nil {
+			return ""
+		}
+
+		for _, remoteGitMappingConfig := range imageBaseConfig.Git.Remote {
+			// branches and tags of the remote repo can be moved independently of the local repo
+			if remoteGitMappingConfig.Commit == "" {
+				return fmt.Sprintf("remote git %s is not pinned to a commit", remoteGitMappingConfig.Url)
+			}
+		}
+
+		for _, localGitMappingConfig := range imageBaseConfig.Git.Local {
+			// stageDependencies are subsets of the git mapping, so they are also covered
+			pathMatcher := path_matcher.NewGitMappingPathMatcher(
+				localGitMappingConfig.GitMappingAdd(),
+				localGitMappingConfig.IncludePaths,
+				localGitMappingConfig.ExcludePaths,
+				false,
+			)
+
+			if changedPath := firstMatchedPath(pathMatcher, changedPaths.repoPaths); changedPath != "" {
+				return fmt.Sprintf("git mapping file %s changed", changedPath)
+			}
+		}
+	case *config.ImageFromDockerfile:
+		// files added with contextAddFile are not committed, so their changes cannot be determined
+		if len(imageConfig.ContextAddFile) != 0 {
+			return "contextAddFile is used"
+		}
+
+		dockerfilePathMatcher := path_matcher.NewSimplePathMatcher("", []string{filepath.Join(imageConfig.Context, imageConfig.Dockerfile)}, false)
+		if changedPath := firstMatchedPath(dockerfilePathMatcher, changedPaths.projectPaths); changedPath != "" {
+			return fmt.Sprintf("dockerfile %s changed", changedPath)
+		}
+
+		contextPathMatcher := path_matcher.NewSimplePathMatcher(imageConfig.Context, nil, false)
+		if changedPath := firstMatchedPath(contextPathMatcher, changedPaths.projectPaths); changedPath != "" {
+			return fmt.Sprintf("context file %s changed", changedPath)
+		}
+	}
+
+	return ""
+}
+
+func (c *Conveyor) isImageAffected(imageName string) bool {
+	if c.affectedImages == nil {
+		return true
+	}
+
+	_, isAffected := c.affectedImages[imageName]
+	return isAffected
+}
+
+// isImageSkipped returns true if the image is not processed by the build phase (see determineSkippedImages).
+func (c *Conveyor) isImageSkipped(imageName string) bool {
+	_, isSkipped := c.skippedImages[imageName]
+	return isSkipped
+}
+
+func firstMatchedPath(pathMatcher path_matcher.PathMatcher, paths []string) string {
+	for _, p := range paths {
+		if pathMatcher.MatchPath(p) {
+			return p
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,183 @@
+package build
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/werf/werf/pkg/config"
+	"github.com/werf/werf/pkg/image"
+)
+
+func newAffectedTestStapelImage(name, fromImageName string, localGit []*config.ExportBase, remoteGit []*config.GitRemote) *config.StapelImage {
+	gitManager := &config.GitManager{Remote: remoteGit}
+	for _, exportBase := range localGit {
+		gitManager.Local = append(gitManager.Local, &config.GitLocal{
+			GitLocalExport: &config.GitLocalExport{GitExportBase: &config.GitExportBase{GitExport: &config.GitExport{ExportBase: exportBase}}},
+		})
+	}
+
+	return &config.StapelImage{StapelImageBase: &config.StapelImageBase{Name: name, FromImageName: fromImageName, Git: gitManager}}
+}
+
+func TestGetImageAffectedReason(t *testing.T) {
+	werfConfig := &config.WerfConfig{
+		StapelImages: []*config.StapelImage{
+			newAffectedTestStapelImage("backend", "", []*config.ExportBase{{Add: "/backend", To: "/app", ExcludePaths: []string{"docs"}}}, nil),
+			newAffectedTestStapelImage("frontend", "", []*config.ExportBase{{Add: "/", To: "/app", IncludePaths: []string{"frontend/**/*.js"}}}, nil),
+			newAffectedTestStapelImage("backend-tests", "backend", nil, nil),
+			newAffectedTestStapelImage("pinned", "", nil, []*config.GitRemote{
+				{Url: "https://github.com/company/pinned.git", GitRemoteExport: &config.GitRemoteExport{Commit: "1b7c782"}},
+			}),
+			newAffectedTestStapelImage("unpinned", "", nil, []*config.GitRemote{
+				{Url: "https://github.com/company/unpinned.git", GitRemoteExport: &config.GitRemoteExport{Branch: "master"}},
+			}),
+			{StapelImageBase: &config.StapelImageBase{Name: "latest", FromLatest: true}},
+		},
+		ImagesFromDockerfile: []*config.ImageFromDockerfile{
+			{Name: "dockerfile", Context: "docker", Dockerfile: "Dockerfile"},
+			{Name: "dockerfile-outside-context", Context: "docker", Dockerfile: "../Dockerfile.docker"},
+			{Name: "dockerfile-with-context-add-file", Context: "docker", Dockerfile: "Dockerfile", ContextAddFile: []string{"generated"}},
+		},
+	}
+
+	tests := []struct {
+		name             string
+		imageName        string
+		affectedImages   []string
+		changedPaths     []string
+		projectDirInRepo string
+		affected         bool
+	}{
+		{name: "gitMappingFileChanged", imageName: "backend", changedPaths: []string{"README.md", "backend/main.go"}, affected: true},
+		{name: "excludedGitMappingFileChanged", imageName: "backend", changedPaths: []string{"backend/docs/index.md"}},
+		{name: "gitMappingPrefixFileChanged", imageName: "backend", changedPaths: []string{"backend-tools/main.go"}},
+		{name: "includedGitMappingFileChanged", imageName: "frontend", changedPaths: []string{"frontend/src/index.js"}, affected: true},
+		{name: "notIncludedGitMappingFileChanged", imageName: "frontend", changedPaths: []string{"frontend/src/index.css", "backend/main.go"}},
+		{name: "dependencyAffected", imageName: "backend-tests", affectedImages: []string{"backend"}, affected: true},
+		{name: "dependencyNotAffected", imageName: "backend-tests", affectedImages: []string{"frontend"}, changedPaths: []string{"backend/docs/index.md"}},
+		{name: "remoteGitPinnedToCommit", imageName: "pinned", changedPaths: []string{"backend/main.go"}},
+		{name: "remoteGitNotPinnedToCommit", imageName: "unpinned", affected: true},
+		{name: "fromLatest", imageName: "latest", affected: true},
+		{name: "dockerfileContextFileChanged", imageName: "dockerfile", changedPaths: []string{"docker/app/main.go"}, affected: true},
+		{name: "dockerfileOutsideContextChanged", imageName: "dockerfile-outside-context", changedPaths: []string{"Dockerfile.docker"}, affected: true},
+		{name: "fileOutsideContextChanged", imageName: "dockerfile", changedPaths: []string{"backend/main.go", "dockerfile/main.go"}},
+		{name: "contextAddFile", imageName: "dockerfile-with-context-add-file", affected: true},
+		{name: "gitMappingFileChangedInSubdirProject", imageName: "backend", changedPaths: []string{"backend/main.go"}, projectDirInRepo: "project", affected: true},
+		{name: "dockerfileContextFileChangedInSubdirProject", imageName: "dockerfile", changedPaths: []string{"project/docker/app/main.go"}, projectDirInRepo: "project", affected: true},
+		{name: "dockerfileContextFileOutsideSubdirProjectChanged", imageName: "dockerfile", changedPaths: []string{"docker/app/main.go"}, projectDirInRepo: "project"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := &Conveyor{werfConfig: werfConfig, affectedImages: map[string]string{}}
+			for _, imageName := range test.affectedImages {
+				c.affectedImages[imageName] = "test"
+			}
+
+			reason := c.getImageAffectedReason(&Image{name: test.imageName}, newChangedPaths(test.changedPaths, test.projectDirInRepo))
+			if affected := reason != ""; affected != test.affected {
+				t.Errorf("\n[EXPECTED AFFECTED]: %v\n[GOT AFFECTED]: %v (reason %q)", test.affected, affected, reason)
+			}
+		})
+	}
+}
+
+func TestNewChangedPaths(t *testing.T) {
+	repoPaths := []string{"README.md", "project/werf.yaml", "project/docker/Dockerfile", "project-tools/main.go"}
+
+	tests := []struct {
+		name             string
+		projectDirInRepo string
+		projectPaths     []string
+	}{
+		{name: "repoRoot", projectDirInRepo: "", projectPaths: repoPaths},
+		{name: "repoRootDot", projectDirInRepo: ".", projectPaths: repoPaths},
+		{name: "subdir", projectDirInRepo: "project", projectPaths: []string{"werf.yaml", "docker/Dockerfile"}},
+		{name: "subdirWithTrailingSlash", projectDirInRepo: "project/", projectPaths: []string{"werf.yaml", "docker/Dockerfile"}},
+		{name: "nestedSubdir", projectDirInRepo: "project/docker", projectPaths: []string{"Dockerfile"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res := newChangedPaths(repoPaths, test.projectDirInRepo)
+
+			if !reflect.DeepEqual(res.repoPaths, repoPaths) {
+				t.Errorf("\n[EXPECTED REPO PATHS]: %v\n[GOT REPO PATHS]: %v", repoPaths, res.repoPaths)
+			}
+
+			if !reflect.DeepEqual(res.projectPaths, test.projectPaths) {
+				t.Errorf("\n[EXPECTED PROJECT PATHS]: %v\n[GOT PROJECT PATHS]: %v", test.projectPaths, res.projectPaths)
+			}
+		})
+	}
+}
+
+func TestSelectSkippedImages(t *testing.T) {
+	werfConfig := &config.WerfConfig{
+		StapelImages: []*config.StapelImage{
+			newAffectedTestStapelImage("base", "", nil, nil),
+			newAffectedTestStapelImage("backend", "base", nil, nil),
+			newAffectedTestStapelImage("frontend", "base", nil, nil),
+			newAffectedTestStapelImage("docs", "", nil, nil),
+		},
+	}
+
+	images := []*Image{{name: "base"}, {name: "backend"}, {name: "frontend"}, {name: "docs"}, {name: "artifact", isArtifact: true}}
+
+	tests := []struct {
+		name           string
+		affectedImages []string
+		revisionStages []string
+		skippedImages  []string
+	}{
+		{
+			name:           "notAffectedImagesSkipped",
+			affectedImages: []string{"docs"},
+			revisionStages: []string{"base", "backend", "frontend"},
+			skippedImages:  []string{"artifact", "backend", "base", "frontend"},
+		},
+		{
+			name:           "dependencyOfAffectedImageProcessed",
+			affectedImages: []string{"backend"},
+			revisionStages: []string{"base", "frontend", "docs"},
+			skippedImages:  []string{"artifact", "docs", "frontend"},
+		},
+		{
+			name:           "dependencyOfImageWithoutRevisionStageProcessed",
+			revisionStages: []string{"base", "backend", "docs"},
+			skippedImages:  []string{"artifact", "backend", "docs"},
+		},
+		{
+			name:           "allAffected",
+			affectedImages: []string{"base", "backend", "frontend", "docs", "artifact"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := &Conveyor{werfConfig: werfConfig, images: images, affectedImages: map[string]string{}}
+			for _, imageName := range test.affectedImages {
+				c.affectedImages[imageName] = "test"
+			}
+
+			revisionStages := map[string]*image.StageDescription{}
+			for _, imageName := range test.revisionStages {
+				revisionStages[imageName] = &image.StageDescription{Info: &image.Info{Name: imageName}}
+			}
+
+			var skippedImages []string
+			for imageName, stageDesc := range c.selectSkippedImages(revisionStages) {
+				if stageDesc != revisionStages[imageName] {
+					t.Errorf("unexpected stage of skipped image %s", imageName)
+				}
+				skippedImages = append(skippedImages, imageName)
+			}
+			sort.Strings(skippedImages)
+
+			if !reflect.DeepEqual(skippedImages, test.skippedImages) {
+				t.Errorf("\n[EXPECTED SKIPPED]: %v\n[GOT SKIPPED]: %v", test.skippedImages, skippedImages)
+			}
+		})
+	}
+}
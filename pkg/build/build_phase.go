@@ -22,6 +22,7 @@ import (
 
 	"github.com/werf/werf/pkg/build/stage"
 	"github.com/werf/werf/pkg/container_runtime"
+	"github.com/werf/werf/pkg/docker"
 	"github.com/werf/werf/pkg/image"
 	imagePkg "github.com/werf/werf/pkg/image"
 	"github.com/werf/werf/pkg/stapel"
@@ -42,6 +43,12 @@ type BuildOptions struct {
 	ReportPath   string
 	ReportFormat ReportFormat
 
+	// VerifyReproducibility enables rebuilding of already stored stages to compare their content with the stored one
+	VerifyReproducibility bool
+
+	// AffectedSince enables building of only images affected by changes since the revision
+	AffectedSince *AffectedSinceOptions
+
 	DryRun bool
 }
 
@@ -154,7 +161,16 @@ func (phase *BuildPhase) Name() string {
 	return "build"
 }
 
-func (phase *BuildPhase) BeforeImages(_ context.Context) error {
+func (phase *BuildPhase) BeforeImages(ctx context.Context) error {
+	// the stage image must not depend on the commit the stage is built at, so the fixed epoch is used instead of the commit time
+	if phase.ImageBuildOptions.Reproducible && phase.ImageBuildOptions.SourceDateEpoch.IsZero() {
+		phase.ImageBuildOptions.SourceDateEpoch = time.Unix(0, 0).UTC()
+	}
+
+	if phase.ImageBuildOptions.Reproducible {
+		logboek.Context(ctx).Info().LogF("Using source date epoch %d for reproducible stages\n", phase.ImageBuildOptions.SourceDateEpoch.Unix())
+	}
+
 	return nil
 }
 
@@ -164,7 +180,7 @@ func (phase *BuildPhase) AfterImages(ctx context.Context) error {
 
 func (phase *BuildPhase) createReport(ctx context.Context) error {
 	for _, img := range phase.Conveyor.images {
-		if img.isArtifact {
+		if img.isArtifact || !phase.Conveyor.isImageBuildSucceeded(img.GetName()) {
 			continue
 		}
 
@@ -218,11 +234,19 @@ func (phase *BuildPhase) BeforeImageStages(_ context.Context, img *Image) error
 }
 
 func (phase *BuildPhase) AfterImageStages(ctx context.Context, img *Image) error {
-	img.SetLastNonEmptyStage(phase.StagesIterator.PrevNonEmptyStage)
-	img.SetContentDigest(phase.StagesIterator.PrevNonEmptyStage.GetContentDigest())
+	if phase.Conveyor.isImageSkipped(img.GetName()) {
+		if img.isArtifact {
+			return nil
+		}
 
-	if img.isArtifact {
-		return nil
+		phase.useSkippedImageStage(ctx, img)
+	} else {
+		img.SetLastNonEmptyStage(phase.StagesIterator.PrevNonEmptyStage)
+		img.SetContentDigest(phase.StagesIterator.PrevNonEmptyStage.GetContentDigest())
+
+		if img.isArtifact {
+			return nil
+		}
 	}
 
 	if err := phase.addManagedImage(ctx, img); err != nil {
@@ -236,6 +260,23 @@ func (phase *BuildPhase) AfterImageStages(ctx context.Context, img *Image) error
 	return nil
 }
 
+// useSkippedImageStage sets the stage built for the affected since revision as the last stage of the not affected image.
+func (phase *BuildPhase) useSkippedImageStage(ctx context.Context, img *Image) {
+	stageDesc := phase.Conveyor.skippedImages[img.GetName()]
+
+	i := phase.Conveyor.GetOrCreateStageImage(nil, stageDesc.Info.Name)
+	i.SetStageDescription(stageDesc)
+
+	stg := img.GetStages()[len(img.GetStages())-1]
+	stg.SetImage(i)
+	stg.SetContentDigest(stageDesc.Info.Labels[imagePkg.WerfStageContentDigestLabel])
+
+	img.SetLastNonEmptyStage(stg)
+	img.SetContentDigest(stg.GetContentDigest())
+
+	logboek.Context(ctx).Default().LogFHighlight("Use %s built for %s\n", stageDesc.Info.Name, phase.AffectedSince.Revision)
+}
+
 func (phase *BuildPhase) addManagedImage(ctx context.Context, img *Image) error {
 	if phase.ShouldAddManagedImageRecord {
 		if err := phase.Conveyor.StorageManager.StagesStorage.AddManagedImage(ctx, phase.Conveyor.projectName(), img.GetName()); err != nil {
@@ -284,6 +325,10 @@ func (phase *BuildPhase) getPrevNonEmptyStageImageSize() int64 {
 }
 
 func (phase *BuildPhase) OnImageStage(ctx context.Context, img *Image, stg stage.Interface) error {
+	if phase.Conveyor.isImageSkipped(img.GetName()) {
+		return nil
+	}
+
 	return phase.StagesIterator.OnImageStage(ctx, img, stg, func(img *Image, stg stage.Interface, isEmpty bool) error {
 		return phase.onImageStage(ctx, img, stg, isEmpty)
 	})
@@ -331,6 +376,12 @@ func (phase *BuildPhase) onImageStage(ctx context.Context, img *Image, stg stage
 			}
 		}
 
+		if phase.VerifyReproducibility {
+			if err := phase.verifyStageReproducibility(ctx, img, stg); err != nil {
+				return err
+			}
+		}
+
 		return nil
 	}
 
@@ -345,6 +396,11 @@ func (phase *BuildPhase) onImageStage(ctx context.Context, img *Image, stg stage
 			return fmt.Errorf("stages required")
 		}
 
+		if !phase.Conveyor.isImageAffected(img.GetName()) {
+			phase.printShouldBeBuiltError(ctx, img, stg)
+			return fmt.Errorf("image %s is not affected since %s, but its stages are not found in the repo: build the image without --affected-since", img.LogName(), phase.AffectedSince.Revision)
+		}
+
 		// Will build a new stage
 		i := phase.Conveyor.GetOrCreateStageImage(castToStageImage(phase.StagesIterator.GetPrevImage(img, stg)), uuid.New().String())
 		stg.SetImage(i)
@@ -526,6 +582,7 @@ func (phase *BuildPhase) prepareStageInstructions(ctx context.Context, img *Imag
 		imagePkg.WerfCacheVersionLabel:       imagePkg.BuildCacheVersion,
 		imagePkg.WerfImageLabel:              "false",
 		imagePkg.WerfStageDigestLabel:        stg.GetDigest(),
+		imagePkg.WerfStageNameLabel:          string(stg.Name()),
 		imagePkg.WerfStageContentDigestLabel: stg.GetContentDigest(),
 	}
 
@@ -686,6 +743,89 @@ func (phase *BuildPhase) atomicBuildStageImage(ctx context.Context, img *Image,
 	}
 }
 
+// verifyStageReproducibility rebuilds the stage on top of the same previous stage and compares the rebuilt image with the stored one.
+// The content digest of the stage (GetContentDigest) is calculated from the stage inputs and is recorded into the
+// werf-stage-content-digest label of the built images, so labels of the stored and rebuilt images are compared first
+// to ensure that both images are built from the same inputs. The label cannot reveal differences of the built files though,
+// so filesystems of the images are compared by layers diff ids: a diff id is sha256 of the uncompressed layer tar,
+// equal diff ids mean byte-identical files with the same metadata.
+func (phase *BuildPhase) verifyStageReproducibility(ctx context.Context, img *Image, stg stage.Interface) error {
+	return logboek.Context(ctx).Default().LogProcess("Verifying reproducibility of stage %s", stg.LogDetailedName()).
+		Options(func(options types.LogProcessOptionsInterface) {
+			options.Style(style.Highlight())
+		}).
+		DoError(func() error {
+			if err := phase.Conveyor.StorageManager.FetchStage(ctx, stg); err != nil {
+				return err
+			}
+
+			storedInspect, err := docker.ImageInspect(ctx, stg.GetImage().Name())
+			if err != nil {
+				return fmt.Errorf("unable to inspect stage %s image %s: %s", stg.LogDetailedName(), stg.GetImage().Name(), err)
+			}
+
+			storedStageImage := stg.GetImage()
+			defer stg.SetImage(storedStageImage)
+
+			rebuiltStageImage := phase.Conveyor.GetOrCreateStageImage(castToStageImage(phase.StagesIterator.GetPrevImage(img, stg)), uuid.New().String())
+			defer phase.Conveyor.UnsetStageImage(rebuiltStageImage.Name())
+			stg.SetImage(rebuiltStageImage)
+
+			if err := phase.fetchBaseImageForStage(ctx, img, stg); err != nil {
+				return err
+			}
+
+			if err := phase.prepareStageInstructions(ctx, img, stg); err != nil {
+				return err
+			}
+
+			if !img.isDockerfileImage {
+				if _, err := stapel.GetOrCreateContainer(ctx); err != nil {
+					return fmt.Errorf("get or create stapel container failed: %s", err)
+				}
+			}
+
+			if err := stg.PreRunHook(ctx, phase.Conveyor); err != nil {
+				return fmt.Errorf("%s preRunHook failed: %s", stg.LogDetailedName(), err)
+			}
+
+			buildOptions := phase.ImageBuildOptions
+			buildOptions.Reproducible = true
+
+			if err := logboek.Context(ctx).Streams().DoErrorWithTag(fmt.Sprintf("%s/%s", img.LogName(), stg.Name()), img.LogTagStyle(), func() error {
+				return rebuiltStageImage.Build(ctx, buildOptions)
+			}); err != nil {
+				return fmt.Errorf("failed to rebuild image for stage %s with digest %s: %s", stg.Name(), stg.GetDigest(), err)
+			}
+
+			if !img.isDockerfileImage {
+				defer func() {
+					if err := docker.CliRmi(ctx, rebuiltStageImage.MustGetBuiltId()); err != nil {
+						logboek.Context(ctx).Warn().LogF("WARNING: unable to remove rebuilt image %s: %s\n", rebuiltStageImage.MustGetBuiltId(), err)
+					}
+				}()
+			}
+
+			storedInputsDigest := storedInspect.Config.Labels[imagePkg.WerfStageContentDigestLabel]
+			rebuiltInputsDigest := rebuiltStageImage.GetInspect().Config.Labels[imagePkg.WerfStageContentDigestLabel]
+			if storedInputsDigest != rebuiltInputsDigest {
+				return fmt.Errorf("stage %s with digest %s is not reproducible: stored image %s is built from other inputs (content digest label %q) than the rebuilt image (content digest label %q)", stg.LogDetailedName(), stg.GetDigest(), storedStageImage.Name(), storedInputsDigest, rebuiltInputsDigest)
+			}
+
+			storedContentDigest := container_runtime.ImageContentDigest(storedInspect)
+			rebuiltContentDigest := container_runtime.ImageContentDigest(rebuiltStageImage.GetInspect())
+
+			logboek.Context(ctx).Default().LogFDetails(logImageInfoFormat, "stored", storedContentDigest)
+			logboek.Context(ctx).Default().LogFDetails(logImageInfoFormat, "rebuilt", rebuiltContentDigest)
+
+			if storedContentDigest != rebuiltContentDigest {
+				return fmt.Errorf("stage %s with digest %s and content digest %s is not reproducible: stored image %s content digest %s differs from rebuilt image content digest %s", stg.LogDetailedName(), stg.GetDigest(), stg.GetContentDigest(), storedStageImage.Name(), storedContentDigest, rebuiltContentDigest)
+			}
+
+			return nil
+		})
+}
+
 func introspectStage(ctx context.Context, s stage.Interface) error {
 	return logboek.Context(ctx).Info().LogProcess("Introspecting stage %s", s.Name()).
 		Options(func(options types.LogProcessOptionsInterface) {
@@ -27,6 +27,7 @@ import (
 	"github.com/werf/werf/pkg/build/stage"
 	"github.com/werf/werf/pkg/config"
 	"github.com/werf/werf/pkg/container_runtime"
+	"github.com/werf/werf/pkg/docker_registry"
 	"github.com/werf/werf/pkg/git_repo"
 	"github.com/werf/werf/pkg/giterminism_inspector"
 	"github.com/werf/werf/pkg/image"
@@ -34,6 +35,7 @@ import (
 	"github.com/werf/werf/pkg/path_matcher"
 	"github.com/werf/werf/pkg/storage"
 	"github.com/werf/werf/pkg/storage/manager"
+	"github.com/werf/werf/pkg/true_git"
 	"github.com/werf/werf/pkg/util"
 	"github.com/werf/werf/pkg/util/parallel"
 )
@@ -70,6 +72,12 @@ type Conveyor struct {
 	onTerminateFuncs []func() error
 	importServers    map[string]import_server.ImportServer
 
+	externalImportImages map[string]string
+
+	imagesBuildResults map[string]*ImageBuildResult
+	affectedImages     map[string]string
+	skippedImages      map[string]*image.StageDescription
+
 	ConveyorOptions
 
 	mutex            sync.Mutex
@@ -81,6 +89,10 @@ type ConveyorOptions struct {
 	Parallel                        bool
 	ParallelTasksLimit              int64
 	LocalGitRepoVirtualMergeOptions stage.VirtualMergeOptions
+	ImportServerType                string
+
+	// KeepGoing makes conveyor continue processing of images which do not depend on the failed ones
+	KeepGoing bool
 }
 
 func NewConveyor(werfConfig *config.WerfConfig, localGitRepo *git_repo.Local, imageNamesToProcess []string, projectDir, baseTmpDir, sshAuthSock string, containerRuntime container_runtime.ContainerRuntime, storageManager *manager.StorageManager, storageLockManager storage.LockManager, opts ConveyorOptions) *Conveyor {
@@ -105,6 +117,8 @@ func NewConveyor(werfConfig *config.WerfConfig, localGitRepo *git_repo.Local, im
 		remoteGitRepos:         make(map[string]*git_repo.Remote),
 		tmpDir:                 filepath.Join(baseTmpDir, util.GenerateConsistentRandomString(10)),
 		importServers:          make(map[string]import_server.ImportServer),
+		externalImportImages:   make(map[string]string),
+		imagesBuildResults:     make(map[string]*ImageBuildResult),
 
 		ContainerRuntime:   containerRuntime,
 		StorageLockManager: storageLockManager,
@@ -192,51 +206,87 @@ func (c *Conveyor) GetLocalGitRepoVirtualMergeOptions() stage.VirtualMergeOption
 }
 
 func (c *Conveyor) GetImportServer(ctx context.Context, imageName, stageName string) (import_server.ImportServer, error) {
-	c.getServiceRWMutex("ImportServer").Lock()
-	defer c.getServiceRWMutex("ImportServer").Unlock()
-
 	importServerName := imageName
 	if stageName != "" {
 		importServerName += "/" + stageName
 	}
+
+	var tmpDirName string
+	if stageName == "" {
+		tmpDirName = imageName
+	} else {
+		tmpDirName = fmt.Sprintf("%s-%s", imageName, stageName)
+	}
+
+	return c.getOrRunImportServer(ctx, importServerName, tmpDirName, func() (string, error) {
+		if stageName == "" {
+			return c.GetImageNameForLastImageStage(imageName), nil
+		}
+		return c.GetImageNameForImageStage(imageName, stageName), nil
+	})
+}
+
+func (c *Conveyor) GetExternalImportServer(ctx context.Context, reference string) (import_server.ImportServer, error) {
+	importServerName := "external/" + reference
+	tmpDirName := "external-" + util.Sha256Hash(reference)
+
+	return c.getOrRunImportServer(ctx, importServerName, tmpDirName, func() (string, error) {
+		if err := c.FetchExternalImportImage(ctx, reference); err != nil {
+			return "", err
+		}
+		return c.GetExternalImportImageName(reference), nil
+	})
+}
+
+func (c *Conveyor) getOrRunImportServer(ctx context.Context, importServerName, tmpDirName string, getDockerImageNameFunc func() (string, error)) (import_server.ImportServer, error) {
+	c.getServiceRWMutex("ImportServer").Lock()
+	defer c.getServiceRWMutex("ImportServer").Unlock()
+
 	if srv, hasKey := c.importServers[importServerName]; hasKey {
 		return srv, nil
 	}
 
-	var srv *import_server.RsyncServer
+	var srv import_server.ImportServer
 
-	if err := logboek.Context(ctx).Info().LogProcess(fmt.Sprintf("Firing up import rsync server for image %s", imageName)).
+	if err := logboek.Context(ctx).Info().LogProcess(fmt.Sprintf("Firing up import %s server for image %s", c.getImportServerType(), importServerName)).
 		DoError(func() error {
-			var tmpDir string
-			if stageName == "" {
-				tmpDir = filepath.Join(c.tmpDir, "import-server", imageName)
-			} else {
-				tmpDir = filepath.Join(c.tmpDir, "import-server", fmt.Sprintf("%s-%s", imageName, stageName))
-			}
-
+			tmpDir := filepath.Join(c.tmpDir, "import-server", tmpDirName)
 			if err := os.MkdirAll(tmpDir, os.ModePerm); err != nil {
 				return fmt.Errorf("unable to create dir %s: %s", tmpDir, err)
 			}
 
-			var dockerImageName string
-			if stageName == "" {
-				dockerImageName = c.GetImageNameForLastImageStage(imageName)
-			} else {
-				dockerImageName = c.GetImageNameForImageStage(imageName, stageName)
+			dockerImageName, err := getDockerImageNameFunc()
+			if err != nil {
+				return err
+			}
+
+			switch c.getImportServerType() {
+			case import_server.RsyncImportServerType:
+				var rsyncServer *import_server.RsyncServer
+				rsyncServer, err = import_server.RunRsyncServer(ctx, dockerImageName, tmpDir)
+				if rsyncServer != nil {
+					srv = rsyncServer
+				}
+			case import_server.TarImportServerType:
+				var tarServer *import_server.TarServer
+				tarServer, err = import_server.RunTarServer(ctx, dockerImageName, tmpDir)
+				if tarServer != nil {
+					srv = tarServer
+				}
+			default:
+				return fmt.Errorf("unknown import server type %q", c.getImportServerType())
 			}
 
-			var err error
-			srv, err = import_server.RunRsyncServer(ctx, dockerImageName, tmpDir)
 			if srv != nil {
 				c.AppendOnTerminateFunc(func() error {
 					if err := srv.Shutdown(ctx); err != nil {
-						return fmt.Errorf("unable to shutdown import server %s: %s", srv.DockerContainerName, err)
+						return fmt.Errorf("unable to shutdown import server: %s", err)
 					}
 					return nil
 				})
 			}
 			if err != nil {
-				return fmt.Errorf("unable to run rsync import server: %s", err)
+				return fmt.Errorf("unable to run %s import server: %s", c.getImportServerType(), err)
 			}
 			return nil
 		}); err != nil {
@@ -248,6 +298,105 @@ func (c *Conveyor) GetImportServer(ctx context.Context, imageName, stageName str
 	return srv, nil
 }
 
+func (c *Conveyor) getImportServerType() string {
+	if c.ConveyorOptions.ImportServerType == "" {
+		return import_server.DefaultImportServerType
+	}
+
+	return c.ConveyorOptions.ImportServerType
+}
+
+// ResolveExternalImportImage resolves the external import image reference to the immutable REPOSITORY@DIGEST name.
+// The image itself is not pulled, use FetchExternalImportImage when the image is needed locally.
+func (c *Conveyor) ResolveExternalImportImage(ctx context.Context, reference string) (string, error) {
+	c.getServiceRWMutex("ExternalImportImages" + reference).Lock()
+	defer c.getServiceRWMutex("ExternalImportImages" + reference).Unlock()
+
+	if dockerImageName := c.getExternalImportImageName(reference); dockerImageName != "" {
+		return dockerImageName, nil
+	}
+
+	dockerImageName := reference
+	if !strings.Contains(reference, "@") {
+		processMsg := fmt.Sprintf("Resolving external import image digest (%s)", reference)
+		if err := logboek.Context(ctx).Info().LogProcessInline(processMsg).DoError(func() error {
+			repoImage, err := docker_registry.API().GetRepoImage(ctx, reference)
+			if err != nil {
+				return fmt.Errorf("unable to get external import image %s from registry: %s", reference, err)
+			}
+
+			dockerImageName = fmt.Sprintf("%s@%s", repoImage.Repository, repoImage.RepoDigest)
+			return nil
+		}); err != nil {
+			return "", err
+		}
+	}
+
+	c.getServiceRWMutex("ExternalImportImagesMap").Lock()
+	c.externalImportImages[reference] = dockerImageName
+	c.getServiceRWMutex("ExternalImportImagesMap").Unlock()
+
+	return dockerImageName, nil
+}
+
+// FetchExternalImportImage pulls the resolved external import image into the local docker server if it does not exist yet.
+func (c *Conveyor) FetchExternalImportImage(ctx context.Context, reference string) error {
+	dockerImageName, err := c.ResolveExternalImportImage(ctx, reference)
+	if err != nil {
+		return err
+	}
+
+	c.getServiceRWMutex("ExternalImportImages" + reference).Lock()
+	defer c.getServiceRWMutex("ExternalImportImages" + reference).Unlock()
+
+	localDockerServerRuntime := c.getLocalDockerServerRuntime()
+	if inspect, err := localDockerServerRuntime.GetImageInspect(ctx, dockerImageName); err != nil {
+		return fmt.Errorf("unable to inspect external import image %s: %s", dockerImageName, err)
+	} else if inspect != nil {
+		return nil
+	}
+
+	return logboek.Context(ctx).Default().LogProcess("Pulling external import image %s", dockerImageName).DoError(func() error {
+		return localDockerServerRuntime.PullImage(ctx, dockerImageName)
+	})
+}
+
+// IsImportSourceInRegistry reports whether the import source image is missing in the local docker server
+// and is available in the container registry (external image or stage from the repo stages storage).
+func (c *Conveyor) IsImportSourceInRegistry(ctx context.Context, dockerImageName string, isExternal bool) (bool, error) {
+	if inspect, err := c.getLocalDockerServerRuntime().GetImageInspect(ctx, dockerImageName); err != nil {
+		return false, fmt.Errorf("unable to inspect import source image %s: %s", dockerImageName, err)
+	} else if inspect != nil {
+		return false, nil
+	}
+
+	if isExternal {
+		return true, nil
+	}
+
+	return c.StorageManager.StagesStorage.Address() != storage.LocalStorageAddress, nil
+}
+
+func (c *Conveyor) getLocalDockerServerRuntime() *container_runtime.LocalDockerServerRuntime {
+	return c.ContainerRuntime.(*container_runtime.LocalDockerServerRuntime)
+}
+
+func (c *Conveyor) GetExternalImportImageName(reference string) string {
+	dockerImageName := c.getExternalImportImageName(reference)
+	if dockerImageName == "" {
+		panic(fmt.Sprintf("external import image %s has not been fetched", reference))
+	}
+
+	return dockerImageName
+}
+
+func (c *Conveyor) getExternalImportImageName(reference string) string {
+	c.getServiceRWMutex("ExternalImportImagesMap").RLock()
+	defer c.getServiceRWMutex("ExternalImportImagesMap").RUnlock()
+
+	return c.externalImportImages[reference]
+}
+
 func (c *Conveyor) AppendOnTerminateFunc(f func() error) {
 	c.onTerminateFuncs = append(c.onTerminateFuncs, f)
 }
@@ -377,6 +526,12 @@ func (c *Conveyor) Build(ctx context.Context, opts BuildOptions) error {
 		return err
 	}
 
+	if opts.AffectedSince != nil {
+		if err := c.determineAffectedImages(ctx, *opts.AffectedSince); err != nil {
+			return err
+		}
+	}
+
 	phases := []Phase{
 		NewBuildPhase(c, BuildPhaseOptions{
 			BuildOptions: opts,
@@ -468,8 +623,9 @@ func (c *Conveyor) runPhases(ctx context.Context, phases []Phase, logImages bool
 		logProcess.End()
 	}
 
-	if err := c.doImages(ctx, phases, logImages); err != nil {
-		return err
+	imagesErr := c.doImages(ctx, phases, logImages)
+	if imagesErr != nil && !c.KeepGoing {
+		return imagesErr
 	}
 
 	for _, phase := range phases {
@@ -485,10 +641,14 @@ func (c *Conveyor) runPhases(ctx context.Context, phases []Phase, logImages bool
 		}
 	}
 
-	return nil
+	return imagesErr
 }
 
 func (c *Conveyor) doImages(ctx context.Context, phases []Phase, logImages bool) error {
+	if c.KeepGoing {
+		return c.doImagesKeepGoing(ctx, phases, logImages)
+	}
+
 	if c.Parallel && len(c.images) > 1 {
 		return c.doImagesInParallel(ctx, phases, logImages)
 	} else {
@@ -649,6 +809,11 @@ func (c *Conveyor) GetImage(name string) *Image {
 	panic(fmt.Sprintf("Image '%s' not found!", name))
 }
 
+// GetImages returns images to process with their dependencies.
+func (c *Conveyor) GetImages() []*Image {
+	return c.images
+}
+
 func (c *Conveyor) GetImageStageContentDigest(imageName, stageName string) string {
 	return c.getImageStage(imageName, stageName).GetContentDigest()
 }
@@ -894,7 +1059,7 @@ func generateGitMappings(ctx context.Context, imageBaseConfig *config.StapelImag
 		}
 
 		for _, localGitMappingConfig := range imageBaseConfig.Git.Local {
-			gitMappings = append(gitMappings, gitLocalPathInit(localGitMappingConfig, localGitRepo, imageBaseConfig.Name, c))
+			gitMappings = append(gitMappings, gitLocalPathInit(ctx, localGitMappingConfig, localGitRepo, imageBaseConfig.Name, c))
 		}
 	}
 
@@ -906,6 +1071,12 @@ func generateGitMappings(ctx context.Context, imageBaseConfig *config.StapelImag
 			if err != nil {
 				return nil, fmt.Errorf("unable to open remote git repo %s by url %s: %s", remoteGitMappingConfig.Name, remoteGitMappingConfig.Url, err)
 			}
+			remoteGitRepo.PartialCloneFilter = getPartialCloneFilter(remoteGitMappingConfig.PartialClone)
+			if remoteGitRepo.PartialCloneFilter != "" {
+				if err := true_git.CheckPartialCloneConstraint(); err != nil {
+					return nil, fmt.Errorf("unable to use partialClone for remote git repo %s: %s", remoteGitMappingConfig.Name, err)
+				}
+			}
 
 			if err := logboek.Context(ctx).Info().LogProcess(fmt.Sprintf("Refreshing %s repository", remoteGitMappingConfig.Name)).
 				DoError(func() error {
@@ -917,7 +1088,15 @@ func generateGitMappings(ctx context.Context, imageBaseConfig *config.StapelImag
 			c.SetRemoteGitRepo(remoteGitMappingConfig.Name, remoteGitRepo)
 		}
 
-		gitMappings = append(gitMappings, gitRemoteArtifactInit(remoteGitMappingConfig, remoteGitRepo, imageBaseConfig.Name, c))
+		if remoteGitRepo.PartialCloneFilter != getPartialCloneFilter(remoteGitMappingConfig.PartialClone) {
+			return nil, fmt.Errorf("remote git repo %s should be used with the same partialClone setting in all git mappings", remoteGitMappingConfig.Name)
+		}
+
+		if remoteGitRepo.PartialCloneFilter != "" {
+			remoteGitRepo.AddSparsePaths(remoteGitMappingConfig.GitMappingSparsePaths()...)
+		}
+
+		gitMappings = append(gitMappings, gitRemoteArtifactInit(ctx, remoteGitMappingConfig, remoteGitRepo, imageBaseConfig.Name, c))
 	}
 
 	var res []*stage.GitMapping
@@ -1027,7 +1206,7 @@ func filterAndLogGitMappings(ctx context.Context, c *Conveyor, gitMappings []*st
 	return res, nil
 }
 
-func gitRemoteArtifactInit(remoteGitMappingConfig *config.GitRemote, remoteGitRepo *git_repo.Remote, imageName string, c *Conveyor) *stage.GitMapping {
+func gitRemoteArtifactInit(ctx context.Context, remoteGitMappingConfig *config.GitRemote, remoteGitRepo *git_repo.Remote, imageName string, c *Conveyor) *stage.GitMapping {
 	gitMapping := baseGitMappingInit(remoteGitMappingConfig.GitLocalExport, imageName, c)
 
 	gitMapping.Tag = remoteGitMappingConfig.Tag
@@ -1040,10 +1219,25 @@ func gitRemoteArtifactInit(remoteGitMappingConfig *config.GitRemote, remoteGitRe
 
 	gitMapping.GitRepoCache = c.GetOrCreateGitRepoCache(remoteGitRepo.GetName())
 
+	if gitMapping.LFS != nil && gitMapping.LFS.ServerURL == "" {
+		setDefaultLFSServer(ctx, gitMapping.LFS, remoteGitMappingConfig.Url)
+	}
+
 	return gitMapping
 }
 
-func gitLocalPathInit(localGitMappingConfig *config.GitLocal, localGitRepo *git_repo.Local, imageName string, c *Conveyor) *stage.GitMapping {
+func getPartialCloneFilter(partialClone string) string {
+	switch partialClone {
+	case config.BloblessPartialClone:
+		return git_repo.BloblessPartialCloneFilter
+	case config.TreelessPartialClone:
+		return git_repo.TreelessPartialCloneFilter
+	default:
+		return ""
+	}
+}
+
+func gitLocalPathInit(ctx context.Context, localGitMappingConfig *config.GitLocal, localGitRepo *git_repo.Local, imageName string, c *Conveyor) *stage.GitMapping {
 	gitMapping := baseGitMappingInit(localGitMappingConfig.GitLocalExport, imageName, c)
 
 	gitMapping.Name = "own"
@@ -1052,9 +1246,33 @@ func gitLocalPathInit(localGitMappingConfig *config.GitLocal, localGitRepo *git_
 
 	gitMapping.GitRepoCache = c.GetOrCreateGitRepoCache(localGitRepo.GetName())
 
+	if gitMapping.LFS != nil && gitMapping.LFS.ServerURL == "" {
+		if remoteOriginUrl, err := localGitRepo.RemoteOriginUrl(ctx); err != nil {
+			logboek.Context(ctx).Warn().LogF("WARNING: unable to get remote origin url of the local git repo to download Git LFS objects: %s\n", err)
+		} else if remoteOriginUrl != "" {
+			setDefaultLFSServer(ctx, gitMapping.LFS, remoteOriginUrl)
+		}
+	}
+
 	return gitMapping
 }
 
+// setDefaultLFSServer sets Git LFS server url by the git remote url, if the url can be determined.
+// Without the server url Git LFS objects are taken only from the local LFS store of the repository.
+// The server of the ssh remote is authenticated over ssh the same way git-lfs does.
+func setDefaultLFSServer(ctx context.Context, lfs *git_repo.LFSOptions, remoteURL string) {
+	serverURL, err := true_git.LFSServerURLFromRemoteURL(remoteURL)
+	if err != nil {
+		logboek.Context(ctx).Warn().LogF("WARNING: %s, Git LFS objects will be taken only from the local LFS store\n", err)
+		return
+	}
+
+	lfs.ServerURL = serverURL
+	if true_git.IsSSHRemoteURL(remoteURL) {
+		lfs.SSHRemoteURL = remoteURL
+	}
+}
+
 func baseGitMappingInit(local *config.GitLocalExport, imageName string, c *Conveyor) *stage.GitMapping {
 	var stageDependencies map[stage.StageName][]string
 	if local.StageDependencies != nil {
@@ -1076,6 +1294,10 @@ func baseGitMappingInit(local *config.GitLocalExport, imageName string, c *Conve
 	gitMapping.Group = local.Group
 	gitMapping.StagesDependencies = stageDependencies
 
+	if local.LFS {
+		gitMapping.LFS = &git_repo.LFSOptions{ServerURL: local.LFSUrl}
+	}
+
 	return gitMapping
 }
 
@@ -1129,40 +1351,20 @@ func prepareImageBasedOnImageFromDockerfile(ctx context.Context, imageFromDocker
 		return nil, fmt.Errorf("unable to get head commit: %s", err)
 	}
 
-	for _, contextAddFile := range imageFromDockerfileConfig.ContextAddFile {
-		relContextAddFile := filepath.Join(imageFromDockerfileConfig.Context, contextAddFile)
-		if err := giterminism_inspector.ReportConfigDockerfileContextAddFile(ctx, relContextAddFile); err != nil {
-			return nil, err
-		}
-
-		absContextAddFile := filepath.Join(c.projectDir, relContextAddFile)
-		exist, err := util.FileExists(absContextAddFile)
-		if err != nil {
-			return nil, fmt.Errorf("unable to check existence of file %s: %s", absContextAddFile, err)
-		}
-
-		if !exist {
-			return nil, fmt.Errorf("contextAddFile '%s' was not found (the path must be relative to the context '%s')", contextAddFile, imageFromDockerfileConfig.Context)
-		}
-	}
-
-	dockerfileData, err := getDockerfileData(ctx, imageFromDockerfileConfig, c, localGitRepo, headCommit)
-	if err != nil {
+	if err := checkContextAddFiles(ctx, imageFromDockerfileConfig, c.projectDir); err != nil {
 		return nil, err
 	}
 
-	dockerignorePatterns, err := getDockerignorePatterns(ctx, imageFromDockerfileConfig, c, localGitRepo, headCommit)
+	dockerfileData, err := getDockerfileData(ctx, imageFromDockerfileConfig, c.projectDir, localGitRepo, headCommit)
 	if err != nil {
 		return nil, err
 	}
 
-	dockerignorePatternMatcher, err := fileutils.NewPatternMatcher(dockerignorePatterns)
+	dockerignorePathMatcher, err := getDockerignorePathMatcher(ctx, imageFromDockerfileConfig, c.projectDir, localGitRepo, headCommit)
 	if err != nil {
 		return nil, err
 	}
 
-	dockerignorePathMatcher := path_matcher.NewDockerfileIgnorePathMatcher(imageFromDockerfileConfig.Context, dockerignorePatternMatcher, false)
-
 	p, err := parser.Parse(bytes.NewReader(dockerfileData))
 	if err != nil {
 		return nil, err
@@ -1229,13 +1431,48 @@ func prepareImageBasedOnImageFromDockerfile(ctx context.Context, imageFromDocker
 	return img, nil
 }
 
-func getDockerfileData(ctx context.Context, imageFromDockerfileConfig *config.ImageFromDockerfile, c *Conveyor, localGitRepo *git_repo.Local, headCommit string) ([]byte, error) {
+func checkContextAddFiles(ctx context.Context, imageFromDockerfileConfig *config.ImageFromDockerfile, projectDir string) error {
+	for _, contextAddFile := range imageFromDockerfileConfig.ContextAddFile {
+		relContextAddFile := filepath.Join(imageFromDockerfileConfig.Context, contextAddFile)
+		if err := giterminism_inspector.ReportConfigDockerfileContextAddFile(ctx, relContextAddFile); err != nil {
+			return err
+		}
+
+		absContextAddFile := filepath.Join(projectDir, relContextAddFile)
+		exist, err := util.FileExists(absContextAddFile)
+		if err != nil {
+			return fmt.Errorf("unable to check existence of file %s: %s", absContextAddFile, err)
+		}
+
+		if !exist {
+			return fmt.Errorf("contextAddFile '%s' was not found (the path must be relative to the context '%s')", contextAddFile, imageFromDockerfileConfig.Context)
+		}
+	}
+
+	return nil
+}
+
+func getDockerignorePathMatcher(ctx context.Context, imageFromDockerfileConfig *config.ImageFromDockerfile, projectDir string, localGitRepo *git_repo.Local, headCommit string) (*path_matcher.DockerfileIgnorePathMatcher, error) {
+	dockerignorePatterns, err := getDockerignorePatterns(ctx, imageFromDockerfileConfig, projectDir, localGitRepo, headCommit)
+	if err != nil {
+		return nil, err
+	}
+
+	dockerignorePatternMatcher, err := fileutils.NewPatternMatcher(dockerignorePatterns)
+	if err != nil {
+		return nil, err
+	}
+
+	return path_matcher.NewDockerfileIgnorePathMatcher(imageFromDockerfileConfig.Context, dockerignorePatternMatcher, false), nil
+}
+
+func getDockerfileData(ctx context.Context, imageFromDockerfileConfig *config.ImageFromDockerfile, projectDir string, localGitRepo *git_repo.Local, headCommit string) ([]byte, error) {
 	var dockerfileData []byte
 	relDockerfilePath := filepath.Join(imageFromDockerfileConfig.Context, imageFromDockerfileConfig.Dockerfile)
 	if isAccepted, err := giterminism_inspector.IsUncommittedDockerfileAccepted(relDockerfilePath); err != nil {
 		return nil, err
 	} else if isAccepted {
-		absDockerfilePath := filepath.Join(c.projectDir, relDockerfilePath)
+		absDockerfilePath := filepath.Join(projectDir, relDockerfilePath)
 
 		exist, err := util.FileExists(absDockerfilePath)
 		if err != nil {
@@ -1253,12 +1490,12 @@ func getDockerfileData(ctx context.Context, imageFromDockerfileConfig *config.Im
 	} else {
 		exists, err := localGitRepo.IsCommitFileExists(ctx, headCommit, relDockerfilePath)
 		if err != nil {
-			return nil, fmt.Errorf("unable to check file %s existence in the local git repo commit %s %s: %s", relDockerfilePath, headCommit, err)
+			return nil, fmt.Errorf("unable to check file %s existence in the local git repo commit %s: %s", relDockerfilePath, headCommit, err)
 		} else if !exists {
 			return nil, fmt.Errorf("dockerfile '%s' was not found in the local git repo commit %s", relDockerfilePath, headCommit)
 		}
 
-		dockerfileData, err = getFileDataFromGitAndCompareWithLocal(ctx, c.projectDir, localGitRepo, headCommit, relDockerfilePath)
+		dockerfileData, err = getFileDataFromGitAndCompareWithLocal(ctx, projectDir, localGitRepo, headCommit, relDockerfilePath)
 		if err != nil {
 			return nil, err
 		}
@@ -1267,13 +1504,13 @@ func getDockerfileData(ctx context.Context, imageFromDockerfileConfig *config.Im
 	return dockerfileData, nil
 }
 
-func getDockerignorePatterns(ctx context.Context, imageFromDockerfileConfig *config.ImageFromDockerfile, c *Conveyor, localGitRepo *git_repo.Local, headCommit string) ([]string, error) {
+func getDockerignorePatterns(ctx context.Context, imageFromDockerfileConfig *config.ImageFromDockerfile, projectDir string, localGitRepo *git_repo.Local, headCommit string) ([]string, error) {
 	var dockerignorePatterns []string
 	relDockerignorePath := filepath.Join(imageFromDockerfileConfig.Context, ".dockerignore")
 	if isAccepted, err := giterminism_inspector.IsUncommittedDockerignoreAccepted(relDockerignorePath); err != nil {
 		return nil, err
 	} else if isAccepted {
-		absDockerfileignorePath := filepath.Join(c.projectDir, relDockerignorePath)
+		absDockerfileignorePath := filepath.Join(projectDir, relDockerignorePath)
 
 		exist, err := util.FileExists(absDockerfileignorePath)
 		if err != nil {
@@ -1297,7 +1534,7 @@ func getDockerignorePatterns(ctx context.Context, imageFromDockerfileConfig *con
 		if err != nil {
 			return nil, fmt.Errorf("unable to check file .dockerignore existence in the local git repo commit %s: %s", headCommit, err)
 		} else if exist {
-			data, err := getFileDataFromGitAndCompareWithLocal(ctx, c.projectDir, localGitRepo, headCommit, relDockerignorePath)
+			data, err := getFileDataFromGitAndCompareWithLocal(ctx, projectDir, localGitRepo, headCommit, relDockerignorePath)
 			if err != nil {
 				return nil, err
 			}
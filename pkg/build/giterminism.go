@@ -0,0 +1,65 @@
+package build
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+
+	"github.com/werf/werf/pkg/config"
+	"github.com/werf/werf/pkg/git_repo"
+	"github.com/werf/werf/pkg/git_repo/status"
+	"github.com/werf/werf/pkg/giterminism_inspector"
+	"github.com/werf/werf/pkg/util"
+)
+
+// CheckImageFromDockerfileGiterminism reads the dockerfile and .dockerignore of the image the same way as the build does
+// and reports contextAddFile directives and uncommitted files of the context to the giterminism inspector.
+func CheckImageFromDockerfileGiterminism(ctx context.Context, imageFromDockerfileConfig *config.ImageFromDockerfile, projectDir string, localGitRepo *git_repo.Local) error {
+	headCommit, err := localGitRepo.HeadCommit(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to get head commit: %s", err)
+	}
+
+	if err := checkContextAddFiles(ctx, imageFromDockerfileConfig, projectDir); err != nil {
+		return err
+	}
+
+	if _, err := getDockerfileData(ctx, imageFromDockerfileConfig, projectDir, localGitRepo, headCommit); err != nil {
+		return err
+	}
+
+	relDockerfilePath := filepath.Join(imageFromDockerfileConfig.Context, imageFromDockerfileConfig.Dockerfile)
+	if v := giterminism_inspector.GetViolation(giterminism_inspector.ViolationUncommittedFile, relDockerfilePath); v != nil {
+		v.SetAllowConfig([]string{"config", "dockerfile", "allowUncommitted"}, []string{relDockerfilePath})
+	}
+
+	dockerignorePathMatcher, err := getDockerignorePathMatcher(ctx, imageFromDockerfileConfig, projectDir, localGitRepo, headCommit)
+	if err != nil {
+		return err
+	}
+
+	relDockerignorePath := filepath.Join(imageFromDockerfileConfig.Context, ".dockerignore")
+	if v := giterminism_inspector.GetViolation(giterminism_inspector.ViolationUncommittedFile, relDockerignorePath); v != nil {
+		v.SetAllowConfig([]string{"config", "dockerfile", "allowUncommittedDockerignoreFiles"}, []string{relDockerignorePath})
+	}
+
+	statusResult, err := localGitRepo.Status(ctx, dockerignorePathMatcher)
+	if err != nil {
+		return err
+	}
+
+	// the dockerfile and .dockerignore are checked above
+	checkedPaths := []string{relDockerfilePath, relDockerignorePath}
+	for _, contextAddFile := range imageFromDockerfileConfig.ContextAddFile {
+		checkedPaths = append(checkedPaths, filepath.Join(imageFromDockerfileConfig.Context, contextAddFile))
+	}
+
+	list := statusResult.FilePathList(status.FilterOptions{WorktreeOnly: giterminism_inspector.DevMode})
+	for _, path := range util.ExcludeFromStringArray(list, checkedPaths...) {
+		if err := giterminism_inspector.ReportUncommittedDockerfileContextFile(ctx, imageFromDockerfileConfig.Context, path); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
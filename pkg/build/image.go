@@ -128,6 +128,10 @@ func (i *Image) GetName() string {
 	return i.name
 }
 
+func (i *Image) IsArtifact() bool {
+	return i.isArtifact
+}
+
 func (i *Image) GetLogName() string {
 	return i.LogName()
 }
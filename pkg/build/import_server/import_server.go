@@ -6,6 +6,16 @@ import (
 	"github.com/werf/werf/pkg/config"
 )
 
+const (
+	RsyncImportServerType = "rsync"
+	TarImportServerType   = "tar"
+
+	DefaultImportServerType = RsyncImportServerType
+)
+
 type ImportServer interface {
-	GetCopyCommand(ctx context.Context, importConfig *config.Import) string
+	GetCopyCommand(ctx context.Context, importConfig *config.Import) (string, error)
+	// GetVolumes returns volumes which should be mounted into the build container to run copy commands.
+	GetVolumes() []string
+	Shutdown(ctx context.Context) error
 }
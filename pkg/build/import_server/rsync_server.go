@@ -113,7 +113,11 @@ func (srv *RsyncServer) Shutdown(ctx context.Context) error {
 	return nil
 }
 
-func (srv *RsyncServer) GetCopyCommand(ctx context.Context, importConfig *config.Import) string {
+func (srv *RsyncServer) GetVolumes() []string {
+	return nil
+}
+
+func (srv *RsyncServer) GetCopyCommand(ctx context.Context, importConfig *config.Import) (string, error) {
 	var args []string
 
 	rsyncImportPathSpec := fmt.Sprintf("rsync://%s@%s:%s/import/%s", srv.AuthUser, srv.IPAddress, srv.Port, importConfig.Add)
@@ -188,7 +192,7 @@ func (srv *RsyncServer) GetCopyCommand(ctx context.Context, importConfig *config
 
 	logboek.Context(ctx).Debug().LogF("Rsync server copy commands for import: artifact=%q image=%q add=%s to=%s includePaths=%v excludePaths=%v: %q\n", importConfig.ArtifactName, importConfig.ImageName, importConfig.Add, importConfig.To, importConfig.IncludePaths, importConfig.ExcludePaths, command)
 
-	return command
+	return command, nil
 }
 
 func descentPath(filePath string) []string {
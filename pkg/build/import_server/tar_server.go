@@ -0,0 +1,215 @@
+package import_server
+
+import (
+	"archive/tar"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/werf/logboek"
+
+	"github.com/werf/werf/pkg/config"
+	"github.com/werf/werf/pkg/docker"
+	"github.com/werf/werf/pkg/path_matcher"
+	"github.com/werf/werf/pkg/stapel"
+)
+
+// TarServer streams the import paths from the created (not running) container of the source image
+// with the docker copy API, filters files on the fly and extracts prepared archives in the build container.
+type TarServer struct {
+	DockerContainerName string
+	DockerImageName     string
+	TmpDir              string
+	ContainerTmpDir     string
+}
+
+func RunTarServer(ctx context.Context, dockerImageName string, tmpDir string) (*TarServer, error) {
+	logboek.Context(ctx).Debug().LogF("RunTarServer for docker image %q\n", dockerImageName)
+
+	srv := &TarServer{
+		DockerContainerName: fmt.Sprintf("import-server-%s", uuid.New().String()),
+		DockerImageName:     dockerImageName,
+		TmpDir:              tmpDir,
+		ContainerTmpDir:     path.Join("/.werf/import-server", filepath.Base(tmpDir)),
+	}
+
+	createArgs := []string{
+		fmt.Sprintf("--name=%s", srv.DockerContainerName),
+		fmt.Sprintf("--entrypoint=%s", stapel.TrueBinPath()),
+		dockerImageName,
+	}
+	if err := docker.CliCreate(ctx, createArgs...); err != nil {
+		return nil, fmt.Errorf("unable to create container %s: %s", srv.DockerContainerName, err)
+	}
+
+	return srv, nil
+}
+
+func (srv *TarServer) Shutdown(ctx context.Context) error {
+	if output, err := docker.CliRm_RecordedOutput(ctx, "--force", srv.DockerContainerName); err != nil {
+		logboek.Context(ctx).Error().LogF("%s", output)
+		return fmt.Errorf("unable to remove container %s: %s", srv.DockerContainerName, err)
+	}
+	return nil
+}
+
+func (srv *TarServer) GetVolumes() []string {
+	return []string{fmt.Sprintf("%s:%s:ro", srv.TmpDir, srv.ContainerTmpDir)}
+}
+
+func (srv *TarServer) GetCopyCommand(ctx context.Context, importConfig *config.Import) (string, error) {
+	sourcePath, sourceMode, err := srv.resolveSourcePath(ctx, importConfig.Add)
+	if err != nil {
+		return "", err
+	}
+
+	archiveName := fmt.Sprintf("%s.tar", uuid.New().String())
+	archivePath := filepath.Join(srv.TmpDir, archiveName)
+	if err := srv.writeImportArchive(ctx, sourcePath, sourceMode.IsDir(), archivePath, importConfig); err != nil {
+		return "", fmt.Errorf("unable to prepare import archive for %s: %s", importConfig.Add, err)
+	}
+
+	var extractDir string
+	if sourceMode.IsDir() {
+		extractDir = importConfig.To
+	} else {
+		extractDir = path.Dir(importConfig.To)
+	}
+
+	var args []string
+	args = append(args, fmt.Sprintf("%s -p %s", stapel.MkdirBinPath(), extractDir))
+	args = append(args, fmt.Sprintf("%s --extract --file=%s --directory=%s", stapel.TarBinPath(), path.Join(srv.ContainerTmpDir, archiveName), extractDir))
+	command := strings.Join(args, " && ")
+
+	logboek.Context(ctx).Debug().LogF("Tar server copy commands for import: artifact=%q image=%q from=%q add=%s to=%s includePaths=%v excludePaths=%v: %q\n", importConfig.ArtifactName, importConfig.ImageName, importConfig.From, importConfig.Add, importConfig.To, importConfig.IncludePaths, importConfig.ExcludePaths, command)
+
+	return command, nil
+}
+
+// resolveSourcePath follows symlinks of the import path as rsync -L does.
+func (srv *TarServer) resolveSourcePath(ctx context.Context, sourcePath string) (string, os.FileMode, error) {
+	for i := 0; i < 255; i++ {
+		stat, err := docker.ContainerStatPath(ctx, srv.DockerContainerName, sourcePath)
+		if err != nil {
+			return "", 0, fmt.Errorf("unable to stat %s in the image %s: %s", sourcePath, srv.DockerImageName, err)
+		}
+
+		if stat.Mode&os.ModeSymlink == 0 {
+			return sourcePath, stat.Mode, nil
+		}
+
+		if path.IsAbs(stat.LinkTarget) {
+			sourcePath = stat.LinkTarget
+		} else {
+			sourcePath = path.Join(path.Dir(sourcePath), stat.LinkTarget)
+		}
+	}
+
+	return "", 0, fmt.Errorf("unable to resolve %s in the image %s: too many levels of symbolic links", sourcePath, srv.DockerImageName)
+}
+
+func (srv *TarServer) writeImportArchive(ctx context.Context, sourcePath string, isDir bool, archivePath string, importConfig *config.Import) error {
+	rc, _, err := docker.CopyFromContainer(ctx, srv.DockerContainerName, sourcePath)
+	if err != nil {
+		return fmt.Errorf("unable to copy %s from the image %s: %s", sourcePath, srv.DockerImageName, err)
+	}
+	defer rc.Close()
+
+	f, err := os.Create(archivePath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	pathMatcher := path_matcher.NewGitMappingPathMatcher(importConfig.Add, importConfig.IncludePaths, importConfig.ExcludePaths, false)
+
+	tr := tar.NewReader(rc)
+	tw := tar.NewWriter(f)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("unable to read archive: %s", err)
+		}
+
+		// docker rebases archive entries onto the base name of the requested path
+		relPath := ""
+		if parts := strings.SplitN(strings.TrimPrefix(header.Name, "./"), "/", 2); len(parts) == 2 {
+			relPath = strings.Trim(parts[1], "/")
+		}
+
+		if relPath != "" {
+			fullPath := path.Join(importConfig.Add, relPath)
+			if header.Typeflag == tar.TypeDir {
+				if isMatched, shouldGoThrough := pathMatcher.ProcessDirOrSubmodulePath(fullPath); !isMatched && !shouldGoThrough {
+					continue
+				}
+			} else if !pathMatcher.MatchPath(fullPath) {
+				continue
+			}
+		}
+
+		switch {
+		case !isDir:
+			header.Name = path.Base(importConfig.To)
+		case relPath == "":
+			header.Name = "./"
+		default:
+			header.Name = path.Join(".", relPath)
+			if header.Typeflag == tar.TypeDir {
+				header.Name += "/"
+			}
+		}
+
+		if header.Typeflag == tar.TypeLink {
+			linkRelPath := ""
+			if parts := strings.SplitN(strings.TrimPrefix(header.Linkname, "./"), "/", 2); len(parts) == 2 {
+				linkRelPath = parts[1]
+			}
+			header.Linkname = path.Join(".", linkRelPath)
+		}
+
+		setArchiveHeaderOwner(header, importConfig.Owner, importConfig.Group)
+
+		if err := tw.WriteHeader(header); err != nil {
+			return fmt.Errorf("unable to write archive: %s", err)
+		}
+
+		if _, err := io.Copy(tw, tr); err != nil {
+			return fmt.Errorf("unable to write archive: %s", err)
+		}
+	}
+
+	return tw.Close()
+}
+
+// setArchiveHeaderOwner sets the owner and the group the same way as rsync --chown=OWNER:GROUP does.
+// Names are resolved by the tar in the build container.
+func setArchiveHeaderOwner(header *tar.Header, owner, group string) {
+	if owner != "" {
+		if uid, err := strconv.Atoi(owner); err == nil {
+			header.Uid = uid
+			header.Uname = ""
+		} else {
+			header.Uname = owner
+		}
+	}
+
+	if group != "" {
+		if gid, err := strconv.Atoi(group); err == nil {
+			header.Gid = gid
+			header.Gname = ""
+		} else {
+			header.Gname = group
+		}
+	}
+}
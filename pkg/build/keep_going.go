@@ -0,0 +1,161 @@
+package build
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/werf/logboek"
+	"github.com/werf/logboek/pkg/style"
+	"github.com/werf/logboek/pkg/types"
+
+	"github.com/werf/werf/pkg/storage/manager"
+	"github.com/werf/werf/pkg/util/parallel"
+)
+
+type ImageBuildStatus string
+
+const (
+	ImageBuildSucceeded ImageBuildStatus = "succeeded"
+	ImageBuildFailed    ImageBuildStatus = "failed"
+	ImageBuildSkipped   ImageBuildStatus = "skipped"
+)
+
+type ImageBuildResult struct {
+	Image  *Image
+	Status ImageBuildStatus
+	Err    error
+}
+
+// doImagesKeepGoing processes images set by set and does not stop on the image error:
+// images which depend on the failed or skipped images are skipped, other images are processed as usual.
+func (c *Conveyor) doImagesKeepGoing(ctx context.Context, phases []Phase, logImages bool) error {
+	for setId := range c.imageSets {
+		var setImages []*Image
+		for _, img := range c.imageSets[setId] {
+			if failedDep := c.getFailedImageDependency(img); failedDep != "" {
+				c.setImageBuildResult(&ImageBuildResult{
+					Image:  img,
+					Status: ImageBuildSkipped,
+					Err:    fmt.Errorf("dependency %s was not built", failedDep),
+				})
+				continue
+			}
+
+			setImages = append(setImages, img)
+		}
+
+		doImage := func(ctx context.Context, img *Image, phases []Phase) {
+			res := &ImageBuildResult{Image: img, Status: ImageBuildSucceeded}
+			if err := c.doImage(ctx, img, phases, logImages); err != nil {
+				res.Status = ImageBuildFailed
+				res.Err = err
+			}
+
+			c.setImageBuildResult(res)
+		}
+
+		if c.Parallel && len(setImages) > 1 {
+			logboek.Context(ctx).LogLn()
+
+			if err := parallel.DoTasks(ctx, len(setImages), parallel.DoTasksOptions{
+				InitDockerCLIForEachWorker: true,
+				MaxNumberOfWorkers:         int(c.ParallelTasksLimit),
+				IsLiveOutputOn:             true,
+			}, func(ctx context.Context, taskId int) error {
+				var taskPhases []Phase
+				for _, phase := range phases {
+					taskPhases = append(taskPhases, phase.Clone())
+				}
+
+				doImage(ctx, setImages[taskId], taskPhases)
+
+				return nil
+			}); err != nil {
+				return err
+			}
+		} else {
+			for _, img := range setImages {
+				doImage(ctx, img, phases)
+			}
+		}
+
+		// conveyor should be restarted with the reset stages storage cache
+		for _, img := range setImages {
+			if res := c.GetImageBuildResult(img.GetName()); res.Status == ImageBuildFailed && manager.ShouldResetStagesStorageCache(res.Err) {
+				return res.Err
+			}
+		}
+	}
+
+	return c.processImagesBuildResults(ctx)
+}
+
+func (c *Conveyor) getFailedImageDependency(img *Image) string {
+	for _, depName := range c.werfConfig.GetImageDependenciesNames(img.GetName()) {
+		if res := c.GetImageBuildResult(depName); res != nil && res.Status != ImageBuildSucceeded {
+			return depName
+		}
+	}
+
+	return ""
+}
+
+func (c *Conveyor) setImageBuildResult(res *ImageBuildResult) {
+	c.getServiceRWMutex("ImagesBuildResults").Lock()
+	defer c.getServiceRWMutex("ImagesBuildResults").Unlock()
+
+	c.imagesBuildResults[res.Image.GetName()] = res
+}
+
+// GetImageBuildResult returns nil if the image has not been processed in the keep-going mode.
+func (c *Conveyor) GetImageBuildResult(imageName string) *ImageBuildResult {
+	c.getServiceRWMutex("ImagesBuildResults").RLock()
+	defer c.getServiceRWMutex("ImagesBuildResults").RUnlock()
+
+	return c.imagesBuildResults[imageName]
+}
+
+func (c *Conveyor) isImageBuildSucceeded(imageName string) bool {
+	res := c.GetImageBuildResult(imageName)
+	return res == nil || res.Status == ImageBuildSucceeded
+}
+
+func (c *Conveyor) processImagesBuildResults(ctx context.Context) error {
+	var failed, skipped []string
+
+	logboek.Context(ctx).LogBlock("Build report").
+		Options(func(options types.LogBlockOptionsInterface) {
+			options.Style(style.Highlight())
+		}).
+		Do(func() {
+			for _, img := range c.images {
+				res := c.GetImageBuildResult(img.GetName())
+				if res == nil {
+					continue
+				}
+
+				switch res.Status {
+				case ImageBuildSucceeded:
+					logboek.Context(ctx).LogF("%s: %s\n", img.LogDetailedName(), res.Status)
+				case ImageBuildFailed:
+					failed = append(failed, img.LogName())
+					logboek.Context(ctx).Error().LogF("%s: %s: %s\n", img.LogDetailedName(), res.Status, res.Err)
+				case ImageBuildSkipped:
+					skipped = append(skipped, img.LogName())
+					logboek.Context(ctx).Warn().LogF("%s: %s: %s\n", img.LogDetailedName(), res.Status, res.Err)
+				}
+			}
+		})
+
+	if len(failed) == 0 && len(skipped) == 0 {
+		return nil
+	}
+
+	errMsg := fmt.Sprintf("%d of %d images failed to build: %s", len(failed), len(c.images), strings.Join(failed, ", "))
+	if len(skipped) != 0 {
+		errMsg += fmt.Sprintf("; %d skipped: %s", len(skipped), strings.Join(skipped, ", "))
+	}
+
+	return fmt.Errorf("%s", errMsg)
+}
@@ -0,0 +1,205 @@
+package build
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/werf/logboek"
+	"github.com/werf/logboek/pkg/style"
+	"github.com/werf/logboek/pkg/types"
+
+	"github.com/werf/werf/pkg/config"
+	"github.com/werf/werf/pkg/logging"
+)
+
+type ShardOptions struct {
+	// Index is the number of the shard starting from 1
+	Index int
+	Count int
+}
+
+func (opts ShardOptions) String() string {
+	return fmt.Sprintf("%d/%d", opts.Index, opts.Count)
+}
+
+// ParseShard parses the shard in the N/M format.
+func ParseShard(shard string) (*ShardOptions, error) {
+	parts := strings.Split(shard, "/")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("bad shard %q: expected format N/M", shard)
+	}
+
+	index, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return nil, fmt.Errorf("bad shard %q: unable to parse shard number %q: %s", shard, parts[0], err)
+	}
+
+	count, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return nil, fmt.Errorf("bad shard %q: unable to parse shards count %q: %s", shard, parts[1], err)
+	}
+
+	if count < 1 || index < 1 || index > count {
+		return nil, fmt.Errorf("bad shard %q: expected 1 <= N <= M", shard)
+	}
+
+	return &ShardOptions{Index: index, Count: count}, nil
+}
+
+type shardImagesGroup struct {
+	imageNames          []string
+	imageNamesToProcess []string
+}
+
+// SelectShardImages returns images to process which should be built by the shard.
+//
+// Images to process with their dependencies are split into groups of images connected by dependencies,
+// so images of different groups are independent and every dependency is built by the only shard.
+// Groups are distributed between shards by the number of images in the group, the biggest groups first.
+// The distribution depends only on werf.yaml and images to process, so all shards calculate the same distribution.
+func SelectShardImages(ctx context.Context, werfConfig *config.WerfConfig, imageNamesToProcess []string, opts ShardOptions) ([]string, error) {
+	var imagesToProcess []config.ImageInterface
+	if len(imageNamesToProcess) == 0 {
+		imagesToProcess = werfConfig.GetAllImages()
+	} else {
+		for _, imageName := range imageNamesToProcess {
+			if img := werfConfig.GetImage(imageName); img != nil {
+				imagesToProcess = append(imagesToProcess, img)
+			} else if artifact := werfConfig.GetArtifact(imageName); artifact != nil {
+				imagesToProcess = append(imagesToProcess, artifact)
+			} else {
+				return nil, fmt.Errorf("specified image %s is not defined in werf.yaml", logging.ImageLogName(imageName, false))
+			}
+		}
+	}
+
+	groups := getShardImagesGroups(werfConfig, imagesToProcess)
+	shards := distributeShardImagesGroups(groups, opts.Count)
+
+	logboek.Context(ctx).Default().LogBlock("Shards of images").
+		Options(func(options types.LogBlockOptionsInterface) {
+			options.Style(style.Highlight())
+		}).
+		Do(func() {
+			for ind, shardGroups := range shards {
+				var shardImageNames []string
+				for _, group := range shardGroups {
+					shardImageNames = append(shardImageNames, group.imageNames...)
+				}
+				sort.Strings(shardImageNames)
+
+				var shardImageLogNames []string
+				for _, imageName := range shardImageNames {
+					shardImageLogNames = append(shardImageLogNames, logging.ImageLogName(imageName, werfConfig.GetArtifact(imageName) != nil))
+				}
+
+				current := ""
+				if ind+1 == opts.Index {
+					current = " (current)"
+				}
+
+				if len(shardImageLogNames) == 0 {
+					logboek.Context(ctx).Default().LogF("Shard %d/%d%s: no images\n", ind+1, opts.Count, current)
+				} else {
+					logboek.Context(ctx).Default().LogF("Shard %d/%d%s: %s\n", ind+1, opts.Count, current, strings.Join(shardImageLogNames, ", "))
+				}
+			}
+		})
+
+	var res []string
+	for _, group := range shards[opts.Index-1] {
+		res = append(res, group.imageNamesToProcess...)
+	}
+	sort.Strings(res)
+
+	return res, nil
+}
+
+func getShardImagesGroups(werfConfig *config.WerfConfig, imagesToProcess []config.ImageInterface) []*shardImagesGroup {
+	parent := map[string]string{}
+	var find func(name string) string
+	find = func(name string) string {
+		if parent[name] == name {
+			return name
+		}
+
+		root := find(parent[name])
+		parent[name] = root
+		return root
+	}
+
+	union := func(a, b string) {
+		rootA, rootB := find(a), find(b)
+		// the least name is the root to get the same groups regardless of the order of union operations
+		if rootA < rootB {
+			parent[rootB] = rootA
+		} else if rootB < rootA {
+			parent[rootA] = rootB
+		}
+	}
+
+	for _, set := range werfConfig.ImagesWithDependenciesBySets(imagesToProcess) {
+		for _, img := range set {
+			parent[img.GetName()] = img.GetName()
+		}
+	}
+
+	for imageName := range parent {
+		for _, depName := range werfConfig.GetImageDependenciesNames(imageName) {
+			if _, hasKey := parent[depName]; hasKey {
+				union(imageName, depName)
+			}
+		}
+	}
+
+	groupByRoot := map[string]*shardImagesGroup{}
+	for imageName := range parent {
+		root := find(imageName)
+		if _, hasKey := groupByRoot[root]; !hasKey {
+			groupByRoot[root] = &shardImagesGroup{}
+		}
+		groupByRoot[root].imageNames = append(groupByRoot[root].imageNames, imageName)
+	}
+
+	for _, img := range imagesToProcess {
+		group := groupByRoot[find(img.GetName())]
+		group.imageNamesToProcess = append(group.imageNamesToProcess, img.GetName())
+	}
+
+	var groups []*shardImagesGroup
+	for _, group := range groupByRoot {
+		sort.Strings(group.imageNames)
+		groups = append(groups, group)
+	}
+
+	sort.Slice(groups, func(i, j int) bool {
+		if len(groups[i].imageNames) != len(groups[j].imageNames) {
+			return len(groups[i].imageNames) > len(groups[j].imageNames)
+		}
+		return groups[i].imageNames[0] < groups[j].imageNames[0]
+	})
+
+	return groups
+}
+
+func distributeShardImagesGroups(groups []*shardImagesGroup, count int) [][]*shardImagesGroup {
+	shards := make([][]*shardImagesGroup, count)
+	shardsLoad := make([]int, count)
+
+	for _, group := range groups {
+		leastLoadedInd := 0
+		for ind := range shards {
+			if shardsLoad[ind] < shardsLoad[leastLoadedInd] {
+				leastLoadedInd = ind
+			}
+		}
+
+		shards[leastLoadedInd] = append(shards[leastLoadedInd], group)
+		shardsLoad[leastLoadedInd] += len(group.imageNames)
+	}
+
+	return shards
+}
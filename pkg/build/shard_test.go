@@ -0,0 +1,204 @@
+package build
+
+import (
+	"context"
+	"io/ioutil"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/werf/logboek"
+
+	"github.com/werf/werf/pkg/config"
+)
+
+func TestParseShard(t *testing.T) {
+	tests := []struct {
+		shard  string
+		result *ShardOptions
+	}{
+		{shard: "1/1", result: &ShardOptions{Index: 1, Count: 1}},
+		{shard: "2/3", result: &ShardOptions{Index: 2, Count: 3}},
+		{shard: "3/3", result: &ShardOptions{Index: 3, Count: 3}},
+		{shard: "0/3"},
+		{shard: "4/3"},
+		{shard: "1/0"},
+		{shard: "-1/3"},
+		{shard: "1"},
+		{shard: "1/2/3"},
+		{shard: "a/b"},
+		{shard: ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.shard, func(t *testing.T) {
+			result, err := ParseShard(test.shard)
+			if test.result == nil {
+				if err == nil {
+					t.Errorf("expected error, got %v", result)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(result, test.result) {
+				t.Errorf("\n[EXPECTED]: %v\n[GOT]: %v", test.result, result)
+			}
+		})
+	}
+}
+
+// newShardTestWerfConfig returns the config with images which depend on each other:
+//   - groups connected by dependencies: {a, a-tests, artifact, b}, {c, c-tests}, {d}, {e}, {f};
+//   - artifact is imported into a and b, so they must be built by the same shard;
+//   - artifact is not selected itself, it is built as the dependency of the selected images.
+func newShardTestWerfConfig() *config.WerfConfig {
+	newImage := func(name, fromImageName string, imports ...*config.Import) *config.StapelImage {
+		return &config.StapelImage{StapelImageBase: &config.StapelImageBase{Name: name, FromImageName: fromImageName, Import: imports}}
+	}
+
+	return &config.WerfConfig{
+		StapelImages: []*config.StapelImage{
+			newImage("f", ""),
+			newImage("e", ""),
+			newImage("d", ""),
+			newImage("c-tests", "c"),
+			newImage("c", ""),
+			newImage("b", "", &config.Import{ArtifactName: "artifact"}),
+			newImage("a-tests", "a"),
+			newImage("a", "", &config.Import{ArtifactName: "artifact"}),
+		},
+		Artifacts: []*config.StapelImageArtifact{
+			{StapelImageBase: &config.StapelImageBase{Name: "artifact"}},
+		},
+	}
+}
+
+func TestGetShardImagesGroups(t *testing.T) {
+	werfConfig := newShardTestWerfConfig()
+
+	groups := getShardImagesGroups(werfConfig, werfConfig.GetAllImages())
+
+	var groupsImageNames [][]string
+	for _, group := range groups {
+		groupsImageNames = append(groupsImageNames, group.imageNames)
+	}
+
+	expected := [][]string{{"a", "a-tests", "artifact", "b"}, {"c", "c-tests"}, {"d"}, {"e"}, {"f"}}
+	if !reflect.DeepEqual(groupsImageNames, expected) {
+		t.Errorf("\n[EXPECTED]: %v\n[GOT]: %v", expected, groupsImageNames)
+	}
+}
+
+func TestDistributeShardImagesGroups(t *testing.T) {
+	newGroups := func(sizes ...int) []*shardImagesGroup {
+		var res []*shardImagesGroup
+		for _, size := range sizes {
+			res = append(res, &shardImagesGroup{imageNames: make([]string, size)})
+		}
+		return res
+	}
+
+	tests := []struct {
+		name        string
+		groupsSizes []int
+		count       int
+		shardsLoad  []int
+	}{
+		{name: "singleShard", groupsSizes: []int{4, 2, 1}, count: 1, shardsLoad: []int{7}},
+		{name: "balanced", groupsSizes: []int{4, 2, 1, 1}, count: 2, shardsLoad: []int{4, 4}},
+		{name: "biggestGroupFirst", groupsSizes: []int{5, 3, 2, 1, 1}, count: 3, shardsLoad: []int{5, 4, 3}},
+		{name: "moreShardsThanGroups", groupsSizes: []int{2, 1}, count: 4, shardsLoad: []int{2, 1, 0, 0}},
+		{name: "noGroups", count: 2, shardsLoad: []int{0, 0}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			shards := distributeShardImagesGroups(newGroups(test.groupsSizes...), test.count)
+
+			var shardsLoad []int
+			for _, shardGroups := range shards {
+				load := 0
+				for _, group := range shardGroups {
+					load += len(group.imageNames)
+				}
+				shardsLoad = append(shardsLoad, load)
+			}
+
+			if !reflect.DeepEqual(shardsLoad, test.shardsLoad) {
+				t.Errorf("\n[EXPECTED]: %v\n[GOT]: %v", test.shardsLoad, shardsLoad)
+			}
+		})
+	}
+}
+
+func TestSelectShardImages(t *testing.T) {
+	ctx := logboek.NewContext(context.Background(), logboek.NewLogger(ioutil.Discard, ioutil.Discard))
+	werfConfig := newShardTestWerfConfig()
+
+	tests := []struct {
+		name                string
+		imageNamesToProcess []string
+		count               int
+		shardsImageNames    [][]string
+	}{
+		{
+			name:             "allImages",
+			count:            3,
+			shardsImageNames: [][]string{{"a", "a-tests", "b"}, {"c", "c-tests", "f"}, {"d", "e"}},
+		},
+		{
+			name:                "specifiedImages",
+			imageNamesToProcess: []string{"a-tests", "b", "e"},
+			count:               2,
+			shardsImageNames:    [][]string{{"a-tests", "b"}, {"e"}},
+		},
+		{
+			name:                "emptyShard",
+			imageNamesToProcess: []string{"c-tests"},
+			count:               2,
+			shardsImageNames:    [][]string{{"c-tests"}, nil},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var shardsImageNames [][]string
+			var allImageNames []string
+			for index := 1; index <= test.count; index++ {
+				imageNames, err := SelectShardImages(ctx, werfConfig, test.imageNamesToProcess, ShardOptions{Index: index, Count: test.count})
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				shardsImageNames = append(shardsImageNames, imageNames)
+				allImageNames = append(allImageNames, imageNames...)
+			}
+
+			if !reflect.DeepEqual(shardsImageNames, test.shardsImageNames) {
+				t.Errorf("\n[EXPECTED]: %v\n[GOT]: %v", test.shardsImageNames, shardsImageNames)
+			}
+
+			// every image to process is built by the only shard
+			expectedAllImageNames := test.imageNamesToProcess
+			if len(expectedAllImageNames) == 0 {
+				for _, img := range werfConfig.GetAllImages() {
+					expectedAllImageNames = append(expectedAllImageNames, img.GetName())
+				}
+			}
+			sort.Strings(expectedAllImageNames)
+			sort.Strings(allImageNames)
+
+			if !reflect.DeepEqual(allImageNames, expectedAllImageNames) {
+				t.Errorf("\n[EXPECTED ALL]: %v\n[GOT ALL]: %v", expectedAllImageNames, allImageNames)
+			}
+		})
+	}
+
+	if _, err := SelectShardImages(ctx, werfConfig, []string{"unknown"}, ShardOptions{Index: 1, Count: 1}); err == nil {
+		t.Error("expected error for unknown image")
+	}
+}
@@ -22,6 +22,13 @@ type Conveyor interface {
 	GetImageIDForImageStage(imageName, stageName string) string
 
 	GetImportServer(ctx context.Context, imageName, stageName string) (import_server.ImportServer, error)
+
+	ResolveExternalImportImage(ctx context.Context, reference string) (string, error)
+	FetchExternalImportImage(ctx context.Context, reference string) error
+	IsImportSourceInRegistry(ctx context.Context, dockerImageName string, isExternal bool) (bool, error)
+	GetExternalImportImageName(reference string) string
+	GetExternalImportServer(ctx context.Context, reference string) (import_server.ImportServer, error)
+
 	GetLocalGitRepoVirtualMergeOptions() VirtualMergeOptions
 
 	GetProjectRepoCommit(ctx context.Context) (string, error)
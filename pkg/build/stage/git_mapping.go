@@ -50,6 +50,7 @@ type GitMapping struct {
 	IncludePaths       []string
 	ExcludePaths       []string
 	StagesDependencies map[StageName][]string
+	LFS                *git_repo.LFSOptions
 
 	ContainerPatchesDir  string
 	ContainerArchivesDir string
@@ -447,6 +448,7 @@ func (gm *GitMapping) baseApplyPatchCommand(ctx context.Context, fromCommit, toC
 		FilterOptions: gm.getRepoFilterOptions(),
 		FromCommit:    fromCommit,
 		ToCommit:      toCommit,
+		LFS:           gm.LFS,
 	}
 
 	patch, err := gm.getOrCreatePatch(ctx, patchOpts)
@@ -523,6 +525,7 @@ func (gm *GitMapping) baseApplyPatchCommand(ctx context.Context, fromCommit, toC
 		archiveOpts := git_repo.ArchiveOptions{
 			FilterOptions: gm.getRepoFilterOptions(),
 			Commit:        toCommit,
+			LFS:           gm.LFS,
 		}
 
 		archive, err := gm.getOrCreateArchive(ctx, archiveOpts)
@@ -644,6 +647,7 @@ func (gm *GitMapping) baseApplyArchiveCommand(ctx context.Context, commit string
 	archiveOpts := git_repo.ArchiveOptions{
 		FilterOptions: gm.getRepoFilterOptions(),
 		Commit:        commit,
+		LFS:           gm.LFS,
 	}
 
 	archive, err := gm.getOrCreateArchive(ctx, archiveOpts)
@@ -687,6 +691,7 @@ func (gm *GitMapping) StageDependenciesChecksum(ctx context.Context, c Conveyor,
 		FilterOptions: gm.getRepoFilterOptions(),
 		Paths:         depsPaths,
 		Commit:        commitInfo.Commit,
+		LFS:           gm.LFS,
 	}
 
 	checksum, err := gm.getOrCreateChecksum(ctx, checksumOpts)
@@ -768,6 +773,13 @@ func (gm *GitMapping) GetParamshash() string {
 	parts = append(parts, ":::")
 	parts = append(parts, gm.Commit)
 
+	if gm.LFS != nil {
+		parts = append(parts, ":::")
+		parts = append(parts, "lfs")
+		parts = append(parts, ":::")
+		parts = append(parts, gm.LFS.ServerURLWithoutCredentials())
+	}
+
 	for _, part := range parts {
 		_, err = hash.Write([]byte(part))
 		if err != nil {
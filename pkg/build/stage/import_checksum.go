@@ -0,0 +1,308 @@
+package stage
+
+import (
+	"archive/tar"
+	"crypto/md5"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+
+	"github.com/werf/werf/pkg/stapel"
+)
+
+const (
+	// importChecksumVersion is a part of the import source id, it must be changed with any change of the checksum calculation,
+	// so that checksums calculated by the previous versions and stored in the import metadata are not used
+	importChecksumVersion = "2"
+
+	whiteoutPrefix       = ".wh."
+	whiteoutOpaqueMarker = ".wh..wh..opq"
+
+	// md5sum output when no files passed (xargs runs md5sum once with empty stdin)
+	emptyInputMd5sumLine = "d41d8cd98f00b204e9800998ecf8427e  -\n"
+)
+
+var errImportChecksumUnsupportedLayers = errors.New("import source cannot be processed without running the source image")
+
+// The import source checksum is calculated either in the source image container by the command of generateChecksumCommand
+// or from the source image layers by calculateImportChecksumFromLayers, both ways must give the same result:
+//  * paths of regular files found under the add path and matched by include and exclude wholename patterns
+//    are sorted bytewise (C locale) and separated by NUL, so file names with spaces, quotes and newlines are passed as is;
+//  * the checksum is md5 of md5sum utility output for these files, md5sum escapes names with backslashes and newlines;
+//  * when no files are found, md5sum is run once with the empty input.
+
+type checksumCommandBinPaths struct {
+	Find, Sort, Xargs, Md5sum, Cut string
+}
+
+var stapelChecksumCommandBinPaths = checksumCommandBinPaths{
+	Find:   stapel.FindBinPath(),
+	Sort:   stapel.SortBinPath(),
+	Xargs:  stapel.XargsBinPath(),
+	Md5sum: stapel.Md5sumBinPath(),
+	Cut:    stapel.CutBinPath(),
+}
+
+func generateChecksumCommand(from string, includePaths, excludePaths []string, resultChecksumPath string) string {
+	return generateChecksumCommandWithBinPaths(stapelChecksumCommandBinPaths, from, includePaths, excludePaths, resultChecksumPath)
+}
+
+func generateChecksumCommandWithBinPaths(binPaths checksumCommandBinPaths, from string, includePaths, excludePaths []string, resultChecksumPath string) string {
+	findCommandParts := append([]string{}, binPaths.Find, fmt.Sprintf("\"%s\"", from), "-type", "f")
+
+	var nameArgs []string
+	for _, includePath := range includePaths {
+		nameArgs = append(nameArgs, fmt.Sprintf("-wholename \"%s\"", path.Join(from, includePath)))
+	}
+
+	for _, excludePath := range excludePaths {
+		nameArgs = append(nameArgs, fmt.Sprintf("! -wholename \"%s\"", path.Join(from, excludePath)))
+	}
+
+	if len(nameArgs) != 0 {
+		findCommandParts = append(findCommandParts, fmt.Sprintf("\\( %s \\)", strings.Join(nameArgs, " -and ")))
+	}
+
+	findCommandParts = append(findCommandParts, "-print0")
+	findCommand := strings.Join(findCommandParts, " ")
+
+	sortCommand := strings.Join([]string{"LC_ALL=C", binPaths.Sort, "-z"}, " ")
+	xargsCommand := strings.Join([]string{binPaths.Xargs, "-0", binPaths.Md5sum}, " ")
+	md5SumCommand := binPaths.Md5sum
+	cutCommand := strings.Join([]string{binPaths.Cut, "-d", "' '", "-f", "1"}, " ")
+
+	commands := append([]string{}, findCommand, sortCommand, xargsCommand, md5SumCommand, cutCommand)
+	command := fmt.Sprintf("%s > %s", strings.Join(commands, " | "), resultChecksumPath)
+
+	return command
+}
+
+// calculateImportChecksumFromLayers calculates the same checksum as the command generated by generateChecksumCommand,
+// but reads the import source files directly from the image layers.
+// Only contents of the regular files under the add path are read from the layers.
+func calculateImportChecksumFromLayers(layers []v1.Layer, add string, includePaths, excludePaths []string) (string, error) {
+	files := map[string]string{}
+	addPath := path.Clean(add)
+
+	for _, layer := range layers {
+		if err := applyLayerToImportFiles(files, layer, addPath); err != nil {
+			return "", err
+		}
+	}
+
+	includeMatchers, err := wholenamePatternsToRegexps(add, includePaths)
+	if err != nil {
+		return "", err
+	}
+
+	excludeMatchers, err := wholenamePatternsToRegexps(add, excludePaths)
+	if err != nil {
+		return "", err
+	}
+
+	var filePaths []string
+fileLoop:
+	for filePath := range files {
+		findPath := importFindPath(add, addPath, filePath)
+
+		for _, matcher := range includeMatchers {
+			if !matcher.MatchString(findPath) {
+				continue fileLoop
+			}
+		}
+
+		for _, matcher := range excludeMatchers {
+			if matcher.MatchString(findPath) {
+				continue fileLoop
+			}
+		}
+
+		filePaths = append(filePaths, filePath)
+	}
+
+	sort.Slice(filePaths, func(i, j int) bool {
+		return importFindPath(add, addPath, filePaths[i]) < importFindPath(add, addPath, filePaths[j])
+	})
+
+	h := md5.New()
+	if len(filePaths) == 0 {
+		io.WriteString(h, emptyInputMd5sumLine)
+	} else {
+		for _, filePath := range filePaths {
+			io.WriteString(h, md5sumLine(files[filePath], importFindPath(add, addPath, filePath)))
+		}
+	}
+
+	return fmt.Sprintf("%x", h.Sum(nil)), nil
+}
+
+func applyLayerToImportFiles(files map[string]string, layer v1.Layer, addPath string) error {
+	rc, err := layer.Uncompressed()
+	if err != nil {
+		return fmt.Errorf("unable to read layer: %s", err)
+	}
+	defer rc.Close()
+
+	layerFiles := map[string]bool{}
+	tr := tar.NewReader(rc)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("unable to read layer: %s", err)
+		}
+
+		entryPath := path.Join("/", header.Name)
+		entryDir, entryBase := path.Split(entryPath)
+		entryDir = path.Clean(entryDir)
+
+		switch {
+		case entryBase == whiteoutOpaqueMarker:
+			for filePath := range files {
+				if isSubpath(entryDir, filePath) && filePath != entryDir && !layerFiles[filePath] {
+					delete(files, filePath)
+				}
+			}
+			continue
+		case strings.HasPrefix(entryBase, whiteoutPrefix):
+			deleteImportFiles(files, addPath, path.Join(entryDir, strings.TrimPrefix(entryBase, whiteoutPrefix)))
+			continue
+		}
+
+		switch header.Typeflag {
+		case tar.TypeReg, tar.TypeRegA:
+			deleteImportFiles(files, addPath, entryPath)
+			if !isSubpath(addPath, entryPath) {
+				continue
+			}
+
+			h := md5.New()
+			if _, err := io.Copy(h, tr); err != nil {
+				return fmt.Errorf("unable to read layer file %s: %s", entryPath, err)
+			}
+
+			files[entryPath] = fmt.Sprintf("%x", h.Sum(nil))
+			layerFiles[entryPath] = true
+		case tar.TypeLink:
+			deleteImportFiles(files, addPath, entryPath)
+			if !isSubpath(addPath, entryPath) {
+				continue
+			}
+
+			checksum, exist := files[path.Join("/", header.Linkname)]
+			if !exist {
+				return errImportChecksumUnsupportedLayers
+			}
+
+			files[entryPath] = checksum
+			layerFiles[entryPath] = true
+		case tar.TypeDir:
+			delete(files, entryPath)
+		default:
+			deleteImportFiles(files, addPath, entryPath)
+		}
+	}
+
+	return nil
+}
+
+func deleteImportFiles(files map[string]string, addPath, p string) {
+	// all stored files are under the addPath
+	if len(files) == 0 || !(isSubpath(p, addPath) || isSubpath(addPath, p)) {
+		return
+	}
+
+	for filePath := range files {
+		if isSubpath(p, filePath) {
+			delete(files, filePath)
+		}
+	}
+}
+
+func isSubpath(basePath, p string) bool {
+	return basePath == "/" || p == basePath || strings.HasPrefix(p, basePath+"/")
+}
+
+// importFindPath returns the path in the format printed by find command for the add start point.
+func importFindPath(add, addPath, filePath string) string {
+	if filePath == addPath {
+		return add
+	}
+
+	return path.Join(add, strings.TrimPrefix(filePath, addPath))
+}
+
+// md5sumLine returns the line in the format printed by md5sum utility.
+func md5sumLine(checksum, filePath string) string {
+	if strings.ContainsAny(filePath, "\\\n") {
+		escapedPath := strings.NewReplacer("\\", "\\\\", "\n", "\\n").Replace(filePath)
+		return fmt.Sprintf("\\%s  %s\n", checksum, escapedPath)
+	}
+
+	return fmt.Sprintf("%s  %s\n", checksum, filePath)
+}
+
+func wholenamePatternsToRegexps(add string, patterns []string) ([]*regexp.Regexp, error) {
+	var res []*regexp.Regexp
+	for _, pattern := range patterns {
+		re, err := wholenamePatternToRegexp(path.Join(add, pattern))
+		if err != nil {
+			return nil, errImportChecksumUnsupportedLayers
+		}
+
+		res = append(res, re)
+	}
+
+	return res, nil
+}
+
+// wholenamePatternToRegexp converts the shell pattern used by find -wholename to the regexp.
+// Like fnmatch without flags, `*` and `?` also match `/` and leading `.`.
+func wholenamePatternToRegexp(pattern string) (*regexp.Regexp, error) {
+	var res strings.Builder
+	res.WriteString("(?s)^")
+
+	for i := 0; i < len(pattern); i++ {
+		switch ch := pattern[i]; ch {
+		case '*':
+			res.WriteString(".*")
+		case '?':
+			res.WriteString(".")
+		case '\\':
+			if i+1 < len(pattern) {
+				i++
+				res.WriteString(regexp.QuoteMeta(string(pattern[i])))
+			} else {
+				res.WriteString(regexp.QuoteMeta(string(ch)))
+			}
+		case '[':
+			end := strings.IndexByte(pattern[i+1:], ']')
+			if end <= 0 {
+				res.WriteString(regexp.QuoteMeta(string(ch)))
+				continue
+			}
+
+			class := pattern[i+1 : i+1+end]
+			if strings.HasPrefix(class, "!") {
+				class = "^" + class[1:]
+			}
+
+			res.WriteString("[" + strings.Replace(class, "\\", "\\\\", -1) + "]")
+			i += end + 1
+		default:
+			res.WriteString(regexp.QuoteMeta(string(ch)))
+		}
+	}
+
+	res.WriteString("$")
+
+	return regexp.Compile(res.String())
+}
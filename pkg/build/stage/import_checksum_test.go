@@ -0,0 +1,130 @@
+package stage
+
+import (
+	"archive/tar"
+	"bytes"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/tarball"
+)
+
+// TestImportChecksum pins the checksum calculated from the image layers to the checksum calculated by the shell command
+func TestImportChecksum(t *testing.T) {
+	var binPaths checksumCommandBinPaths
+	for bin, binPath := range map[string]*string{"find": &binPaths.Find, "sort": &binPaths.Sort, "xargs": &binPaths.Xargs, "md5sum": &binPaths.Md5sum, "cut": &binPaths.Cut} {
+		p, err := exec.LookPath(bin)
+		if err != nil {
+			t.Skipf("%s is required: %s", bin, err)
+		}
+		*binPath = p
+	}
+
+	files := map[string]string{
+		"a":                    "a",
+		"B":                    "B",
+		"file with spaces.txt": "spaces",
+		"quotes'\"s":           "quotes",
+		"new\nline":            "newline",
+		"back\\slash":          "backslash",
+		"-dash":                "dash",
+		"dir/10.txt":           "10",
+		"dir/9.txt":            "9",
+		"dir/sub dir/x.log":    "x",
+		"Ünicode/ä":            "unicode",
+	}
+
+	tests := []struct {
+		name         string
+		files        map[string]string
+		includePaths []string
+		excludePaths []string
+	}{
+		{name: "allFiles", files: files},
+		{name: "includePaths", files: files, includePaths: []string{"dir/*"}},
+		{name: "excludePaths", files: files, excludePaths: []string{"*.log", "quotes*"}},
+		{name: "noFiles", files: map[string]string{}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			tmpDir, err := ioutil.TempDir("", "werf-import-checksum-")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer os.RemoveAll(tmpDir)
+
+			add := filepath.Join(tmpDir, "app")
+			if err := os.MkdirAll(add, 0755); err != nil {
+				t.Fatal(err)
+			}
+
+			layer := writeImportChecksumTestFiles(t, add, test.files)
+
+			layersChecksum, err := calculateImportChecksumFromLayers([]v1.Layer{layer}, add, test.includePaths, test.excludePaths)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			resultPath := filepath.Join(tmpDir, "checksum")
+			command := generateChecksumCommandWithBinPaths(binPaths, add, test.includePaths, test.excludePaths, resultPath)
+			if output, err := exec.Command("bash", "-c", command).CombinedOutput(); err != nil {
+				t.Fatalf("%s: %s\n%s", command, err, output)
+			}
+
+			data, err := ioutil.ReadFile(resultPath)
+			if err != nil {
+				t.Fatal(err)
+			}
+			commandChecksum := strings.TrimSpace(string(data))
+
+			if layersChecksum != commandChecksum {
+				t.Errorf("\n[COMMAND]: %s\n[EXPECTED]: %s\n[GOT]: %s", command, commandChecksum, layersChecksum)
+			}
+		})
+	}
+}
+
+// writeImportChecksumTestFiles writes files into the dir and returns the layer with the same files
+func writeImportChecksumTestFiles(t *testing.T, dir string, files map[string]string) v1.Layer {
+	buf := new(bytes.Buffer)
+	tw := tar.NewWriter(buf)
+
+	for name, content := range files {
+		p := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := ioutil.WriteFile(p, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := tw.WriteHeader(&tar.Header{Name: strings.TrimPrefix(p, "/"), Mode: 0644, Size: int64(len(content)), Typeflag: tar.TypeReg}); err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err := tw.Write([]byte(content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	layer, err := tarball.LayerFromOpener(func() (io.ReadCloser, error) {
+		return ioutil.NopCloser(bytes.NewReader(buf.Bytes())), nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return layer
+}
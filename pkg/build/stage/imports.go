@@ -11,9 +11,11 @@ import (
 
 	"github.com/werf/logboek"
 
+	"github.com/werf/werf/pkg/build/import_server"
 	"github.com/werf/werf/pkg/config"
 	"github.com/werf/werf/pkg/container_runtime"
 	"github.com/werf/werf/pkg/docker"
+	"github.com/werf/werf/pkg/docker_registry"
 	imagePkg "github.com/werf/werf/pkg/image"
 	"github.com/werf/werf/pkg/stapel"
 	"github.com/werf/werf/pkg/storage"
@@ -55,6 +57,12 @@ func (s *ImportsStage) GetDependencies(ctx context.Context, c Conveyor, _, _ con
 	var args []string
 
 	for ind, elm := range s.imports {
+		if elm.IsExternal() {
+			if _, err := c.ResolveExternalImportImage(ctx, elm.From); err != nil {
+				return "", fmt.Errorf("unable to resolve import %d external image: %s", ind, err)
+			}
+		}
+
 		var sourceChecksum string
 		var err error
 		if err := logboek.Context(ctx).Info().LogProcess("Getting import %d source checksum ...", ind).DoError(func() error {
@@ -73,14 +81,31 @@ func (s *ImportsStage) GetDependencies(ctx context.Context, c Conveyor, _, _ con
 }
 
 func (s *ImportsStage) PrepareImage(ctx context.Context, c Conveyor, _, image container_runtime.ImageInterface) error {
+	addedVolumes := map[string]bool{}
 	for _, elm := range s.imports {
+		var srv import_server.ImportServer
+		var err error
 		sourceImageName := getSourceImageName(elm)
-		srv, err := c.GetImportServer(ctx, sourceImageName, elm.Stage)
+		if elm.IsExternal() {
+			srv, err = c.GetExternalImportServer(ctx, elm.From)
+		} else {
+			srv, err = c.GetImportServer(ctx, sourceImageName, elm.Stage)
+		}
 		if err != nil {
 			return fmt.Errorf("unable to get import server for image %q: %s", sourceImageName, err)
 		}
 
-		command := srv.GetCopyCommand(ctx, elm)
+		command, err := srv.GetCopyCommand(ctx, elm)
+		if err != nil {
+			return fmt.Errorf("unable to get import copy command: %s", err)
+		}
+
+		for _, volume := range srv.GetVolumes() {
+			if !addedVolumes[volume] {
+				image.Container().RunOptions().AddVolume(volume)
+				addedVolumes[volume] = true
+			}
+		}
 		image.Container().AddServiceRunCommands(command)
 
 		imageServiceCommitChangeOptions := image.Container().ServiceCommitChangeOptions()
@@ -132,6 +157,27 @@ func (s *ImportsStage) getImportSourceChecksum(ctx context.Context, c Conveyor,
 
 func (s *ImportsStage) generateImportChecksum(ctx context.Context, c Conveyor, importElm *config.Import) (string, error) {
 	sourceImageDockerImageName := getSourceImageDockerImageName(c, importElm)
+
+	isInRegistry, err := c.IsImportSourceInRegistry(ctx, sourceImageDockerImageName, importElm.IsExternal())
+	if err != nil {
+		return "", err
+	}
+
+	if isInRegistry {
+		checksum, err := s.generateImportChecksumFromRegistry(ctx, sourceImageDockerImageName, importElm)
+		if err != errImportChecksumUnsupportedLayers {
+			return checksum, err
+		}
+
+		logboek.Context(ctx).Info().LogF("Import source checksum will be calculated with the source image container: %s\n", err)
+	}
+
+	if importElm.IsExternal() {
+		if err := c.FetchExternalImportImage(ctx, importElm.From); err != nil {
+			return "", err
+		}
+	}
+
 	importSourceID := getImportSourceID(c, importElm)
 
 	stapelContainerName, err := stapel.GetOrCreateContainer(ctx)
@@ -181,45 +227,28 @@ func (s *ImportsStage) generateImportChecksum(ctx context.Context, c Conveyor, i
 	return checksum, nil
 }
 
-func generateChecksumCommand(from string, includePaths, excludePaths []string, resultChecksumPath string) string {
-	findCommandParts := append([]string{}, stapel.FindBinPath(), from, "-type", "f")
-
-	var nameArgs []string
-	for _, includePath := range includePaths {
-		nameArgs = append(nameArgs, fmt.Sprintf("-wholename \"%s\"", path.Join(from, includePath)))
-	}
-
-	for _, excludePath := range excludePaths {
-		nameArgs = append(nameArgs, fmt.Sprintf("! -wholename \"%s\"", path.Join(from, excludePath)))
-	}
+func (s *ImportsStage) generateImportChecksumFromRegistry(ctx context.Context, sourceImageDockerImageName string, importElm *config.Import) (string, error) {
+	var checksum string
+	if err := logboek.Context(ctx).Info().LogProcess("Calculating import source checksum using image %s layers from registry", sourceImageDockerImageName).DoError(func() error {
+		layers, err := docker_registry.API().GetRepoImageLayers(ctx, sourceImageDockerImageName)
+		if err != nil {
+			return fmt.Errorf("unable to get image %s layers: %s", sourceImageDockerImageName, err)
+		}
 
-	if len(nameArgs) != 0 {
-		findCommandParts = append(findCommandParts, fmt.Sprintf("\\( %s \\)", strings.Join(nameArgs, " -and ")))
+		checksum, err = calculateImportChecksumFromLayers(layers, importElm.Add, importElm.IncludePaths, importElm.ExcludePaths)
+		return err
+	}); err != nil {
+		return "", err
 	}
 
-	findCommand := strings.Join(findCommandParts, " ")
-
-	sortCommandParts := append([]string{}, stapel.SortBinPath(), "-n")
-	sortCommand := strings.Join(sortCommandParts, " ")
-
-	xargsCommandParts := append([]string{}, stapel.XargsBinPath(), stapel.Md5sumBinPath())
-	xargsCommand := strings.Join(xargsCommandParts, " ")
-
-	md5SumCommand := stapel.Md5sumBinPath()
-
-	cutCommandParts := append([]string{}, stapel.CutBinPath(), "-d", "' '", "-f", "1")
-	cutCommand := strings.Join(cutCommandParts, " ")
-
-	commands := append([]string{}, findCommand, sortCommand, xargsCommand, md5SumCommand, cutCommand)
-	command := fmt.Sprintf("%s > %s", strings.Join(commands, " | "), resultChecksumPath)
-
-	return command
+	return checksum, nil
 }
 
 func getImportID(importElm *config.Import) string {
 	return util.Sha256Hash(
 		"ImageName", importElm.ImageName,
 		"ArtifactName", importElm.ArtifactName,
+		"From", importElm.From,
 		"Stage", importElm.Stage,
 		"After", importElm.After,
 		"Before", importElm.Before,
@@ -234,6 +263,7 @@ func getImportID(importElm *config.Import) string {
 
 func getImportSourceID(c Conveyor, importElm *config.Import) string {
 	return util.Sha256Hash(
+		"ChecksumVersion", importChecksumVersion,
 		"SourceImageContentDigest", getSourceImageContentDigest(c, importElm),
 		"Add", importElm.Add,
 		"IncludePaths", strings.Join(importElm.IncludePaths, "///"),
@@ -242,6 +272,10 @@ func getImportSourceID(c Conveyor, importElm *config.Import) string {
 }
 
 func getSourceImageDockerImageName(c Conveyor, importElm *config.Import) string {
+	if importElm.IsExternal() {
+		return c.GetExternalImportImageName(importElm.From)
+	}
+
 	sourceImageName := getSourceImageName(importElm)
 
 	var sourceImageDockerImageName string
@@ -255,6 +289,10 @@ func getSourceImageDockerImageName(c Conveyor, importElm *config.Import) string
 }
 
 func getSourceImageID(c Conveyor, importElm *config.Import) string {
+	if importElm.IsExternal() {
+		return c.GetExternalImportImageName(importElm.From)
+	}
+
 	sourceImageName := getSourceImageName(importElm)
 
 	var sourceImageID string
@@ -268,6 +306,10 @@ func getSourceImageID(c Conveyor, importElm *config.Import) string {
 }
 
 func getSourceImageContentDigest(c Conveyor, importElm *config.Import) string {
+	if importElm.IsExternal() {
+		return c.GetExternalImportImageName(importElm.From)
+	}
+
 	sourceImageName := getSourceImageName(importElm)
 
 	var sourceImageContentDigest string
@@ -284,6 +326,8 @@ func getSourceImageName(importElm *config.Import) string {
 	var sourceImageName string
 	if importElm.ImageName != "" {
 		sourceImageName = importElm.ImageName
+	} else if importElm.From != "" {
+		sourceImageName = importElm.From
 	} else {
 		sourceImageName = importElm.ArtifactName
 	}
@@ -11,6 +11,10 @@ import (
 
 type configError struct {
 	s string
+
+	// message and doc are set for detailed errors
+	message string
+	doc     *doc
 }
 
 func (e *configError) Error() string {
@@ -18,7 +22,7 @@ func (e *configError) Error() string {
 }
 
 func newConfigError(message string) error {
-	return &configError{message}
+	return &configError{s: message}
 }
 
 func newDetailedConfigError(message string, configSection interface{}, configDoc *doc) error {
@@ -28,7 +32,7 @@ func newDetailedConfigError(message string, configSection interface{}, configDoc
 	} else {
 		errorString = fmt.Sprintf("%s\n\n%s", message, dumpConfigDoc(configDoc))
 	}
-	return newConfigError(errorString)
+	return &configError{s: errorString, message: message, doc: configDoc}
 }
 
 func getLines(data []byte) [][]byte {
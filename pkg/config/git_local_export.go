@@ -2,10 +2,16 @@ package config
 
 type GitLocalExport struct {
 	*GitExportBase
+	LFS    bool
+	LFSUrl string
 
 	raw *rawGit
 }
 
 func (c *GitLocalExport) validate() error {
+	if c.LFSUrl != "" && !c.LFS {
+		return newDetailedConfigError("`lfsUrl: URL` can be specified only with `lfs: true`!", c.raw, c.raw.rawStapelImage.doc)
+	}
+
 	return nil
 }
@@ -1,9 +1,22 @@
 package config
 
+import (
+	"fmt"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	BloblessPartialClone = "blobless"
+	TreelessPartialClone = "treeless"
+)
+
 type GitRemote struct {
 	*GitRemoteExport
-	Name string
-	Url  string
+	Name         string
+	Url          string
+	PartialClone string
 
 	raw *rawGit
 }
@@ -13,5 +26,36 @@ func (c *GitRemote) GetRaw() interface{} {
 }
 
 func (c *GitRemote) validate() error {
+	switch c.PartialClone {
+	case "", BloblessPartialClone, TreelessPartialClone:
+	default:
+		return newDetailedConfigError(fmt.Sprintf("invalid `partialClone: %s`: expected %s or %s!", c.PartialClone, BloblessPartialClone, TreelessPartialClone), c.raw, c.raw.rawStapelImage.doc)
+	}
+
 	return nil
 }
+
+// GitMappingSparsePaths returns repository paths required by the git mapping.
+func (c *GitRemote) GitMappingSparsePaths() []string {
+	add := filepath.ToSlash(c.GitMappingAdd())
+
+	includePaths := c.GitMappingIncludePaths()
+	if len(includePaths) == 0 {
+		return []string{add}
+	}
+
+	var res []string
+	for _, includePath := range includePaths {
+		var parts []string
+		for _, part := range strings.Split(filepath.ToSlash(includePath), "/") {
+			if strings.ContainsAny(part, "*?[{") {
+				break
+			}
+			parts = append(parts, part)
+		}
+
+		res = append(res, path.Join(add, path.Join(parts...)))
+	}
+
+	return res
+}
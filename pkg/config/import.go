@@ -8,6 +8,7 @@ type Import struct {
 	*ArtifactExport
 	ImageName    string
 	ArtifactName string
+	From         string
 	Before       string
 	After        string
 	Stage        string
@@ -24,10 +25,19 @@ func (c *Import) validate() error {
 		return err
 	}
 
-	if c.ArtifactName == "" && c.ImageName == "" {
-		return newDetailedConfigError("artifact name `artifact: NAME` or image name `image: NAME` required for import!", c.raw, c.raw.rawStapelImage.doc)
-	} else if c.ArtifactName != "" && c.ImageName != "" {
-		return newDetailedConfigError("specify only one artifact name using `artifact: NAME` or image name using `image: NAME` for import!", c.raw, c.raw.rawStapelImage.doc)
+	var sourcesNumber int
+	for _, source := range []string{c.ArtifactName, c.ImageName, c.From} {
+		if source != "" {
+			sourcesNumber++
+		}
+	}
+
+	if sourcesNumber == 0 {
+		return newDetailedConfigError("artifact name `artifact: NAME`, image name `image: NAME` or external image reference `from: REFERENCE` required for import!", c.raw, c.raw.rawStapelImage.doc)
+	} else if sourcesNumber > 1 {
+		return newDetailedConfigError("specify only one artifact name using `artifact: NAME`, image name using `image: NAME` or external image reference using `from: REFERENCE` for import!", c.raw, c.raw.rawStapelImage.doc)
+	} else if c.From != "" && c.Stage != "" {
+		return newDetailedConfigError("`stage: STAGE` cannot be used with external image reference `from: REFERENCE` for import!", c.raw, c.raw.rawStapelImage.doc)
 	} else if c.Before != "" && c.After != "" {
 		return newDetailedConfigError("specify only one artifact stage using `before: install|setup` or `after: install|setup` for import!", c.raw, c.raw.rawStapelImage.doc)
 	} else if c.Before == "" && c.After == "" {
@@ -42,6 +52,11 @@ func (c *Import) validate() error {
 	return nil
 }
 
+// IsExternal reports whether the import source is an arbitrary image from a container registry rather than an image or an artifact from werf.yaml.
+func (c *Import) IsExternal() bool {
+	return c.From != ""
+}
+
 func checkInvalidRelation(rel string) bool {
 	return !(rel == "install" || rel == "setup")
 }
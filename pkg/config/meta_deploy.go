@@ -6,4 +6,18 @@ type MetaDeploy struct {
 	HelmReleaseSlug *bool
 	Namespace       *string
 	NamespaceSlug   *bool
+
+	MultiCluster *MetaDeployMultiCluster
+}
+
+const (
+	MultiClusterFailurePolicyStop     = "stop"
+	MultiClusterFailurePolicyContinue = "continue"
+)
+
+// MetaDeployMultiCluster describes kube contexts of clusters the project is deployed into by a single converge.
+// Clusters of the wave are deployed one by one as kube clients are shared by the process, the failure policy is applied after each wave.
+type MetaDeployMultiCluster struct {
+	Waves         [][]string
+	FailurePolicy string
 }
@@ -32,6 +32,10 @@ import (
 type WerfConfigOptions struct {
 	LogRenderedFilePath bool
 	Env                 string
+
+	// ConfigValuesFiles and ConfigSetValues are merged with values.yaml of the templates dir into .Values
+	ConfigValuesFiles []string
+	ConfigSetValues   []string
 }
 
 func RenderWerfConfig(ctx context.Context, projectDir, werfConfigPath, werfConfigTemplatesDir string, imagesToProcess []string, localGitRepo *git_repo.Local, opts WerfConfigOptions) error {
@@ -41,12 +45,31 @@ func RenderWerfConfig(ctx context.Context, projectDir, werfConfigPath, werfConfi
 	}
 
 	if len(imagesToProcess) == 0 {
-		werfConfigRenderContent, err := renderWerfConfigYaml(ctx, projectDir, werfConfigPath, werfConfigTemplatesDir, localGitRepo, opts.Env)
+		werfConfigRenderContent, _, err := renderWerfConfigYaml(ctx, projectDir, werfConfigPath, werfConfigTemplatesDir, localGitRepo, opts)
 		if err != nil {
 			return fmt.Errorf("cannot parse config: %s", err)
 		}
 
-		fmt.Print(werfConfigRenderContent)
+		if !werfConfig.hasExtendedImages() {
+			fmt.Print(werfConfigRenderContent)
+			return nil
+		}
+
+		docs, err := splitByDocs(werfConfigRenderContent, "")
+		if err != nil {
+			return err
+		}
+
+		var docsContents []string
+		for _, d := range docs {
+			if content, err := werfConfig.getResolvedDocContent(d); err != nil {
+				return err
+			} else {
+				docsContents = append(docsContents, string(content))
+			}
+		}
+
+		fmt.Print(strings.Join(docsContents, "---\n"))
 	} else {
 		var imageDocs []string
 
@@ -54,13 +77,20 @@ func RenderWerfConfig(ctx context.Context, projectDir, werfConfigPath, werfConfi
 			if !werfConfig.HasImageOrArtifact(imageToProcess) {
 				return fmt.Errorf("specified image %s is not defined in werf.yaml", logging.ImageLogName(imageToProcess, false))
 			} else {
+				var content []byte
 				if i := werfConfig.GetArtifact(imageToProcess); i != nil {
-					imageDocs = append(imageDocs, string(i.raw.doc.Content))
+					content, err = werfConfig.getResolvedDocContent(i.raw.doc)
 				} else if i := werfConfig.GetStapelImage(imageToProcess); i != nil {
-					imageDocs = append(imageDocs, string(i.raw.doc.Content))
+					content, err = werfConfig.getResolvedDocContent(i.raw.doc)
 				} else if i := werfConfig.GetDockerfileImage(imageToProcess); i != nil {
-					imageDocs = append(imageDocs, string(i.raw.doc.Content))
+					content = i.raw.doc.Content
+				}
+
+				if err != nil {
+					return err
 				}
+
+				imageDocs = append(imageDocs, string(content))
 			}
 		}
 
@@ -70,8 +100,65 @@ func RenderWerfConfig(ctx context.Context, projectDir, werfConfigPath, werfConfi
 	return nil
 }
 
+// GetWerfConfigPath returns the path of the custom werf config or the path of werf.yml or werf.yaml in the project dir.
+// The path is relative to the project dir if the config is read from the local git repo commit.
+func GetWerfConfigPath(ctx context.Context, projectDir string, customConfigPath string, required bool, localGitRepo *git_repo.Local) (string, error) {
+	var configPathToCheck []string
+
+	if customConfigPath != "" {
+		configPathToCheck = append(configPathToCheck, customConfigPath)
+	} else {
+		for _, werfDefaultConfigName := range []string{"werf.yml", "werf.yaml"} {
+			configPathToCheck = append(configPathToCheck, filepath.Join(projectDir, werfDefaultConfigName))
+		}
+	}
+
+	var commit string
+	for _, werfConfigPath := range configPathToCheck {
+		if giterminism_inspector.LooseGiterminism || localGitRepo == nil || giterminism_inspector.IsUncommittedConfigAccepted() {
+			if exists, err := util.FileExists(werfConfigPath); err != nil {
+				return "", err
+			} else if exists {
+				return werfConfigPath, nil
+			}
+		} else {
+			if c, err := localGitRepo.HeadCommit(ctx); err != nil {
+				return "", fmt.Errorf("unable to get local repo head commit: %s", err)
+			} else {
+				commit = c
+			}
+
+			relPath := util.GetRelativeToBaseFilepath(projectDir, werfConfigPath)
+			if exists, err := localGitRepo.IsCommitFileExists(ctx, commit, relPath); err != nil {
+				return "", fmt.Errorf("unable to check %q existence in the local git repo commit %s: %s", relPath, commit, err)
+			} else if exists {
+				return relPath, nil
+			}
+		}
+	}
+
+	if required {
+		if giterminism_inspector.LooseGiterminism || localGitRepo == nil {
+			return "", fmt.Errorf("werf configuration file not found (%s)", strings.Join(configPathToCheck, ", "))
+		} else {
+			return "", fmt.Errorf("werf configuration file not found (%s) in the local git repo commit %s", strings.Join(configPathToCheck, ", "), commit)
+		}
+	}
+
+	return "", nil
+}
+
+// GetWerfConfigTemplatesDir returns the custom templates dir or .werf dir of the project relative to the project dir.
+func GetWerfConfigTemplatesDir(projectDir, customConfigTemplatesDir string) string {
+	if customConfigTemplatesDir != "" {
+		return util.GetRelativeToBaseFilepath(projectDir, customConfigTemplatesDir)
+	} else {
+		return util.GetRelativeToBaseFilepath(projectDir, filepath.Join(projectDir, ".werf"))
+	}
+}
+
 func GetWerfConfig(ctx context.Context, projectDir, werfConfigPath, werfConfigTemplatesDir string, localGitRepo *git_repo.Local, opts WerfConfigOptions) (*WerfConfig, error) {
-	werfConfigRenderContent, err := renderWerfConfigYaml(ctx, projectDir, werfConfigPath, werfConfigTemplatesDir, localGitRepo, opts.Env)
+	werfConfigRenderContent, remoteTemplates, err := renderWerfConfigYaml(ctx, projectDir, werfConfigPath, werfConfigTemplatesDir, localGitRepo, opts)
 	if err != nil {
 		return nil, fmt.Errorf("cannot parse config: %s", err)
 	}
@@ -126,6 +213,7 @@ func GetWerfConfig(ctx context.Context, projectDir, werfConfigPath, werfConfigTe
 	if err != nil {
 		return nil, err
 	}
+	werfConfig.RemoteTemplates = remoteTemplates
 
 	return werfConfig, nil
 }
@@ -211,44 +299,36 @@ func splitByDocs(werfConfigRenderContent string, werfConfigRenderPath string) ([
 	return docs, nil
 }
 
-func renderWerfConfigYaml(ctx context.Context, projectDir, werfConfigPath, werfConfigTemplatesDir string, localGitRepo *git_repo.Local, env string) (string, error) {
+// renderWerfConfigYaml renders werf.yaml and returns commits of remote repositories templates are imported from.
+func renderWerfConfigYaml(ctx context.Context, projectDir, werfConfigPath, werfConfigTemplatesDir string, localGitRepo *git_repo.Local, opts WerfConfigOptions) (string, []*RemoteTemplates, error) {
 	var commit string
 	if localGitRepo != nil {
 		if c, err := localGitRepo.HeadCommit(ctx); err != nil {
-			return "", fmt.Errorf("unable to get local repo head commit: %s", err)
+			return "", nil, fmt.Errorf("unable to get local repo head commit: %s", err)
 		} else {
 			commit = c
 		}
 	}
 
-	var data []byte
-	if giterminism_inspector.LooseGiterminism || localGitRepo == nil || giterminism_inspector.IsUncommittedConfigAccepted() {
-		if d, err := ioutil.ReadFile(werfConfigPath); err != nil {
-			return "", fmt.Errorf("error reading %q: %s", werfConfigPath, err)
-		} else {
-			data = d
-		}
-	} else {
-		if d, err := git_repo.ReadCommitFileAndCompareWithProjectFile(ctx, localGitRepo, commit, projectDir, werfConfigPath); err != nil {
-			return "", err
-		} else {
-			data = d
-		}
+	data, err := readWerfConfigFile(ctx, projectDir, werfConfigPath, localGitRepo, commit)
+	if err != nil {
+		return "", nil, err
 	}
 
 	tmpl := template.New("werfConfig")
-	tmpl.Funcs(funcMap(tmpl))
+	remoteTemplatesImporter := newRemoteTemplatesImporter(ctx, tmpl)
+	tmpl.Funcs(funcMap(ctx, tmpl, remoteTemplatesImporter))
 
 	var werfConfigsTemplates []string
 	if giterminism_inspector.LooseGiterminism || localGitRepo == nil {
 		if templates, err := getWerfConfigTemplatesFromFilesystem(werfConfigTemplatesDir); err != nil {
-			return "", err
+			return "", nil, err
 		} else {
 			werfConfigsTemplates = templates
 		}
 	} else {
 		if paths, err := localGitRepo.GetCommitFilePathList(ctx, commit); err != nil {
-			return "", fmt.Errorf("unable to get files list from local git repo: %s", err)
+			return "", nil, fmt.Errorf("unable to get files list from local git repo: %s", err)
 		} else {
 			for _, path := range paths {
 				if util.IsSubpathOfBasePath(werfConfigTemplatesDir, path) {
@@ -262,18 +342,18 @@ func renderWerfConfigYaml(ctx context.Context, projectDir, werfConfigPath, werfC
 		var templateData []byte
 		if giterminism_inspector.LooseGiterminism || localGitRepo == nil {
 			if d, err := ioutil.ReadFile(relTemplatePath); err != nil {
-				return "", err
+				return "", nil, err
 			} else {
 				templateData = d
 			}
 		} else {
 			commit, err := localGitRepo.HeadCommit(ctx)
 			if err != nil {
-				return "", fmt.Errorf("unable to get local repo head commit: %s", err)
+				return "", nil, fmt.Errorf("unable to get local repo head commit: %s", err)
 			}
 
 			if d, err := git_repo.ReadCommitFileAndCompareWithProjectFile(ctx, localGitRepo, commit, projectDir, relTemplatePath); err != nil {
-				return "", err
+				return "", nil, err
 			} else {
 				templateData = d
 			}
@@ -281,25 +361,46 @@ func renderWerfConfigYaml(ctx context.Context, projectDir, werfConfigPath, werfC
 
 		templateName, err := filepath.Rel(werfConfigTemplatesDir, relTemplatePath)
 		if err != nil {
-			return "", err
+			return "", nil, err
 		}
 
 		if err := addTemplate(tmpl, templateName, string(templateData)); err != nil {
-			return "", err
+			return "", nil, err
 		}
 	}
 
 	if _, err := tmpl.Parse(string(data)); err != nil {
-		return "", err
+		return "", nil, err
 	}
 
 	templateData := make(map[string]interface{})
 	templateData["Files"] = files{ctx: ctx, ProjectDir: projectDir, Commit: commit, LocalGitRepo: localGitRepo}
-	templateData["Env"] = env
+	templateData["Env"] = opts.Env
+
+	if values, err := getWerfConfigValues(ctx, projectDir, werfConfigTemplatesDir, localGitRepo, commit, opts); err != nil {
+		return "", nil, err
+	} else {
+		templateData["Values"] = values
+	}
 
 	config, err := executeTemplate(tmpl, "werfConfig", templateData)
+	if err != nil {
+		return "", nil, err
+	}
 
-	return config, err
+	return config, remoteTemplatesImporter.remoteTemplates, nil
+}
+
+func readWerfConfigFile(ctx context.Context, projectDir, werfConfigPath string, localGitRepo *git_repo.Local, commit string) ([]byte, error) {
+	if giterminism_inspector.LooseGiterminism || localGitRepo == nil || giterminism_inspector.IsUncommittedConfigAccepted() {
+		if d, err := ioutil.ReadFile(werfConfigPath); err != nil {
+			return nil, fmt.Errorf("error reading %q: %s", werfConfigPath, err)
+		} else {
+			return d, nil
+		}
+	}
+
+	return git_repo.ReadCommitFileAndCompareWithProjectFile(ctx, localGitRepo, commit, projectDir, werfConfigPath)
 }
 
 func addTemplate(tmpl *template.Template, templateName string, templateContent string) error {
@@ -342,7 +443,7 @@ func getWerfConfigTemplatesFromFilesystem(path string) ([]string, error) {
 	return templates, nil
 }
 
-func funcMap(tmpl *template.Template) template.FuncMap {
+func funcMap(ctx context.Context, tmpl *template.Template, remoteTemplatesImporter *remoteTemplatesImporter) template.FuncMap {
 	funcMap := sprig.TxtFuncMap()
 	delete(funcMap, "expandenv")
 
@@ -357,6 +458,7 @@ func funcMap(tmpl *template.Template) template.FuncMap {
 
 		return executeTemplate(tmpl, templateName, data)
 	}
+	funcMap["importTemplates"] = remoteTemplatesImporter.ImportTemplates
 
 	envFunc := funcMap["env"].(func(string) string)
 	funcMap["env"] = func(value interface{}) (string, error) {
@@ -651,6 +753,11 @@ func prepareWerfConfig(rawImages []*rawStapelImage, rawImagesFromDockerfile []*r
 	var imagesFromDockerfile []*ImageFromDockerfile
 	var artifacts []*StapelImageArtifact
 
+	rawImages, err := resolveRawStapelImagesExtends(rawImages)
+	if err != nil {
+		return nil, err
+	}
+
 	for _, rawImageFromDockerfile := range rawImagesFromDockerfile {
 		if sameImages, err := rawImageFromDockerfile.toImageFromDockerfileDirectives(); err != nil {
 			return nil, err
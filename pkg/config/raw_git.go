@@ -10,7 +10,10 @@ type rawGit struct {
 	Branch               string                `yaml:"branch,omitempty"`
 	Tag                  string                `yaml:"tag,omitempty"`
 	Commit               string                `yaml:"commit,omitempty"`
+	PartialClone         string                `yaml:"partialClone,omitempty"`
 	RawStageDependencies *rawStageDependencies `yaml:"stageDependencies,omitempty"`
+	LFS                  bool                  `yaml:"lfs,omitempty"`
+	LFSUrl               string                `yaml:"lfsUrl,omitempty"`
 
 	rawStapelImage *rawStapelImage `yaml:"-"` // parent
 
@@ -78,6 +81,10 @@ func (c *rawGit) validateGitLocalDirective(gitLocal *GitLocal) (err error) {
 		return newDetailedConfigError("specify `branch: BRANCH`, `tag: TAG` and `commit: COMMIT` only for remote git!", nil, c.rawStapelImage.doc)
 	}
 
+	if c.PartialClone != "" {
+		return newDetailedConfigError("specify `partialClone: blobless|treeless` only for remote git!", nil, c.rawStapelImage.doc)
+	}
+
 	if err := gitLocal.validate(); err != nil {
 		return err
 	}
@@ -103,6 +110,9 @@ func (c *rawGit) toGitLocalExportDirective() (gitLocalExport *GitLocalExport, er
 		}
 	}
 
+	gitLocalExport.LFS = c.LFS
+	gitLocalExport.LFSUrl = c.LFSUrl
+
 	gitLocalExport.raw = c
 
 	if err := c.validateGitLocalExportDirective(gitLocalExport); err != nil {
@@ -131,6 +141,7 @@ func (c *rawGit) toGitRemoteDirective() (gitRemote *GitRemote, err error) {
 
 	gitRemote.Url = c.Url
 	gitRemote.Name = getRepositoryID(c.Url)
+	gitRemote.PartialClone = c.PartialClone
 	gitRemote.raw = c
 
 	if err := c.validateGitRemoteDirective(gitRemote); err != nil {
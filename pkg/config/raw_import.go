@@ -3,6 +3,7 @@ package config
 type rawImport struct {
 	ImageName    string `yaml:"image,omitempty"`
 	ArtifactName string `yaml:"artifact,omitempty"`
+	From         string `yaml:"from,omitempty"`
 	Before       string `yaml:"before,omitempty"`
 	After        string `yaml:"after,omitempty"`
 	Stage        string `yaml:"stage,omitempty"`
@@ -58,6 +59,7 @@ func (c *rawImport) toDirective() (imp *Import, err error) {
 
 	imp.ImageName = c.ImageName
 	imp.ArtifactName = c.ArtifactName
+	imp.From = c.From
 	imp.Before = c.Before
 	imp.After = c.After
 	imp.Stage = c.Stage
@@ -1,5 +1,7 @@
 package config
 
+import "fmt"
+
 type rawMetaDeploy struct {
 	HelmChartDir    *string `yaml:"helmChartDir,omitempty"`
 	HelmRelease     *string `yaml:"helmRelease,omitempty"`
@@ -7,6 +9,8 @@ type rawMetaDeploy struct {
 	Namespace       *string `yaml:"namespace,omitempty"`
 	NamespaceSlug   *bool   `yaml:"namespaceSlug,omitempty"`
 
+	MultiCluster *rawMetaDeployMultiCluster `yaml:"multiCluster,omitempty"`
+
 	rawMeta *rawMeta
 
 	UnsupportedAttributes map[string]interface{} `yaml:",inline"`
@@ -51,5 +55,81 @@ func (c *rawMetaDeploy) toMetaDeploy() MetaDeploy {
 	metaDeploy.HelmReleaseSlug = c.HelmReleaseSlug
 	metaDeploy.Namespace = c.Namespace
 	metaDeploy.NamespaceSlug = c.NamespaceSlug
+
+	if c.MultiCluster != nil {
+		metaDeploy.MultiCluster = c.MultiCluster.toMetaDeployMultiCluster()
+	}
+
 	return metaDeploy
 }
+
+type rawMetaDeployMultiCluster struct {
+	Waves         [][]string `yaml:"waves,omitempty"`
+	FailurePolicy *string    `yaml:"failurePolicy,omitempty"`
+
+	rawMetaDeploy *rawMetaDeploy
+
+	UnsupportedAttributes map[string]interface{} `yaml:",inline"`
+}
+
+func (c *rawMetaDeployMultiCluster) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if parent, ok := parentStack.Peek().(*rawMetaDeploy); ok {
+		c.rawMetaDeploy = parent
+	}
+
+	parentStack.Push(c)
+	type plain rawMetaDeployMultiCluster
+	err := unmarshal((*plain)(c))
+	parentStack.Pop()
+	if err != nil {
+		return err
+	}
+
+	doc := c.rawMetaDeploy.rawMeta.doc
+
+	if err := checkOverflow(c.UnsupportedAttributes, nil, doc); err != nil {
+		return err
+	}
+
+	if len(c.Waves) == 0 {
+		return newDetailedConfigError("multiCluster.waves field cannot be empty!", nil, doc)
+	}
+
+	kubeContexts := map[string]bool{}
+	for _, wave := range c.Waves {
+		if len(wave) == 0 {
+			return newDetailedConfigError("multiCluster.waves cannot contain empty wave!", nil, doc)
+		}
+
+		for _, kubeContext := range wave {
+			if kubeContext == "" {
+				return newDetailedConfigError("multiCluster.waves cannot contain empty kube context!", nil, doc)
+			}
+
+			if kubeContexts[kubeContext] {
+				return newDetailedConfigError(fmt.Sprintf("multiCluster.waves cannot contain duplicated kube context %q!", kubeContext), nil, doc)
+			}
+			kubeContexts[kubeContext] = true
+		}
+	}
+
+	if c.FailurePolicy != nil {
+		switch *c.FailurePolicy {
+		case MultiClusterFailurePolicyStop, MultiClusterFailurePolicyContinue:
+		default:
+			return newDetailedConfigError(fmt.Sprintf("multiCluster.failurePolicy field should be %q or %q!", MultiClusterFailurePolicyStop, MultiClusterFailurePolicyContinue), nil, doc)
+		}
+	}
+
+	return nil
+}
+
+func (c *rawMetaDeployMultiCluster) toMetaDeployMultiCluster() *MetaDeployMultiCluster {
+	multiCluster := &MetaDeployMultiCluster{}
+	multiCluster.Waves = c.Waves
+	multiCluster.FailurePolicy = MultiClusterFailurePolicyStop
+	if c.FailurePolicy != nil {
+		multiCluster.FailurePolicy = *c.FailurePolicy
+	}
+	return multiCluster
+}
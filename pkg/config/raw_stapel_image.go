@@ -2,11 +2,14 @@ package config
 
 import (
 	"fmt"
+
+	"gopkg.in/yaml.v2"
 )
 
 type rawStapelImage struct {
 	Images           []string     `yaml:"-"`
 	Artifact         string       `yaml:"artifact,omitempty"`
+	Extends          string       `yaml:"extends,omitempty"`
 	From             string       `yaml:"from,omitempty"`
 	FromLatest       bool         `yaml:"fromLatest,omitempty"`
 	FromCacheVersion string       `yaml:"fromCacheVersion,omitempty"`
@@ -284,3 +287,235 @@ func (c *rawStapelImage) toBaseStapelImageBaseDirective(name string) (imageBase
 
 	return imageBase, nil
 }
+
+func (c *rawStapelImage) hasName(name string) bool {
+	if c.Artifact != "" {
+		return c.Artifact == name
+	}
+
+	for _, imageName := range c.Images {
+		if imageName == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// resolveRawStapelImagesExtends returns images and artifacts with directives inherited from images specified by `extends: NAME`:
+//   - from, fromImage, fromArtifact and fromLatest are inherited only if none of from, fromImage and fromArtifact is specified,
+//     other scalar directives are overridden;
+//   - git, mount and import are appended to the inherited ones;
+//   - shell, ansible and docker are deep-merged: specified stages and directives override the inherited ones,
+//     docker ENV and LABEL are merged by keys, docker is not inherited by artifacts.
+func resolveRawStapelImagesExtends(rawImages []*rawStapelImage) ([]*rawStapelImage, error) {
+	resolved := map[*rawStapelImage]*rawStapelImage{}
+	resolving := map[*rawStapelImage]bool{}
+
+	var resolve func(c *rawStapelImage) (*rawStapelImage, error)
+	resolve = func(c *rawStapelImage) (*rawStapelImage, error) {
+		if c.Extends == "" {
+			return c, nil
+		}
+
+		if r, hasKey := resolved[c]; hasKey {
+			return r, nil
+		}
+
+		if resolving[c] {
+			return nil, newDetailedConfigError(fmt.Sprintf("infinite loop detected while resolving `extends: %s`!", c.Extends), nil, c.doc)
+		}
+		resolving[c] = true
+		defer delete(resolving, c)
+
+		var base *rawStapelImage
+		for _, rawImage := range rawImages {
+			if rawImage.hasName(c.Extends) {
+				base = rawImage
+				break
+			}
+		}
+
+		if base == nil {
+			return nil, newDetailedConfigError(fmt.Sprintf("no such image or artifact `%s` to extend!", c.Extends), nil, c.doc)
+		}
+
+		resolvedBase, err := resolve(base)
+		if err != nil {
+			return nil, err
+		}
+
+		r := c.extend(resolvedBase)
+		resolved[c] = r
+
+		return r, nil
+	}
+
+	var res []*rawStapelImage
+	for _, rawImage := range rawImages {
+		if r, err := resolve(rawImage); err != nil {
+			return nil, err
+		} else {
+			res = append(res, r)
+		}
+	}
+
+	return res, nil
+}
+
+// extend returns the copy of the image with directives inherited from the base image.
+func (c *rawStapelImage) extend(base *rawStapelImage) *rawStapelImage {
+	r := *c
+
+	if c.From == "" && c.FromImage == "" && c.FromArtifact == "" {
+		r.From = base.From
+		r.FromImage = base.FromImage
+		r.FromArtifact = base.FromArtifact
+		r.FromLatest = c.FromLatest || base.FromLatest
+	}
+
+	if r.FromCacheVersion == "" {
+		r.FromCacheVersion = base.FromCacheVersion
+	}
+
+	r.RawGit = append(append([]*rawGit{}, base.RawGit...), c.RawGit...)
+	r.RawMount = append(append([]*rawMount{}, base.RawMount...), c.RawMount...)
+	r.RawImport = append(append([]*rawImport{}, base.RawImport...), c.RawImport...)
+
+	r.RawShell = mergeRawShell(base.RawShell, c.RawShell)
+	r.RawAnsible = mergeRawAnsible(base.RawAnsible, c.RawAnsible)
+	if c.Artifact == "" {
+		r.RawDocker = mergeRawDocker(base.RawDocker, c.RawDocker)
+	}
+
+	return &r
+}
+
+func mergeRawShell(base, c *rawShell) *rawShell {
+	if base == nil || c == nil {
+		if c != nil {
+			return c
+		}
+		return base
+	}
+
+	r := *c
+	r.BeforeInstall = mergeInterfaceValue(base.BeforeInstall, c.BeforeInstall)
+	r.Install = mergeInterfaceValue(base.Install, c.Install)
+	r.BeforeSetup = mergeInterfaceValue(base.BeforeSetup, c.BeforeSetup)
+	r.Setup = mergeInterfaceValue(base.Setup, c.Setup)
+	r.CacheVersion = mergeStringValue(base.CacheVersion, c.CacheVersion)
+	r.BeforeInstallCacheVersion = mergeStringValue(base.BeforeInstallCacheVersion, c.BeforeInstallCacheVersion)
+	r.InstallCacheVersion = mergeStringValue(base.InstallCacheVersion, c.InstallCacheVersion)
+	r.BeforeSetupCacheVersion = mergeStringValue(base.BeforeSetupCacheVersion, c.BeforeSetupCacheVersion)
+	r.SetupCacheVersion = mergeStringValue(base.SetupCacheVersion, c.SetupCacheVersion)
+
+	return &r
+}
+
+func mergeRawAnsible(base, c *rawAnsible) *rawAnsible {
+	if base == nil || c == nil {
+		if c != nil {
+			return c
+		}
+		return base
+	}
+
+	mergeTasks := func(baseTasks, tasks []rawAnsibleTask) []rawAnsibleTask {
+		if len(tasks) != 0 {
+			return tasks
+		}
+		return baseTasks
+	}
+
+	r := *c
+	r.BeforeInstall = mergeTasks(base.BeforeInstall, c.BeforeInstall)
+	r.Install = mergeTasks(base.Install, c.Install)
+	r.BeforeSetup = mergeTasks(base.BeforeSetup, c.BeforeSetup)
+	r.Setup = mergeTasks(base.Setup, c.Setup)
+	r.CacheVersion = mergeStringValue(base.CacheVersion, c.CacheVersion)
+	r.BeforeInstallCacheVersion = mergeStringValue(base.BeforeInstallCacheVersion, c.BeforeInstallCacheVersion)
+	r.InstallCacheVersion = mergeStringValue(base.InstallCacheVersion, c.InstallCacheVersion)
+	r.BeforeSetupCacheVersion = mergeStringValue(base.BeforeSetupCacheVersion, c.BeforeSetupCacheVersion)
+	r.SetupCacheVersion = mergeStringValue(base.SetupCacheVersion, c.SetupCacheVersion)
+
+	return &r
+}
+
+func mergeRawDocker(base, c *rawDocker) *rawDocker {
+	if base == nil || c == nil {
+		if c != nil {
+			return c
+		}
+		return base
+	}
+
+	mergeMaps := func(baseMap, m map[string]string) map[string]string {
+		if len(baseMap) == 0 {
+			return m
+		}
+
+		res := map[string]string{}
+		for k, v := range baseMap {
+			res[k] = v
+		}
+		for k, v := range m {
+			res[k] = v
+		}
+		return res
+	}
+
+	r := *c
+	r.Volume = mergeInterfaceValue(base.Volume, c.Volume)
+	r.Expose = mergeInterfaceValue(base.Expose, c.Expose)
+	r.Env = mergeMaps(base.Env, c.Env)
+	r.Label = mergeMaps(base.Label, c.Label)
+	r.Cmd = mergeInterfaceValue(base.Cmd, c.Cmd)
+	r.Workdir = mergeStringValue(base.Workdir, c.Workdir)
+	r.User = mergeStringValue(base.User, c.User)
+	r.Entrypoint = mergeInterfaceValue(base.Entrypoint, c.Entrypoint)
+	r.HealthCheck = mergeStringValue(base.HealthCheck, c.HealthCheck)
+
+	return &r
+}
+
+func mergeInterfaceValue(baseValue, value interface{}) interface{} {
+	if value != nil {
+		return value
+	}
+	return baseValue
+}
+
+func mergeStringValue(baseValue, value string) string {
+	if value != "" {
+		return value
+	}
+	return baseValue
+}
+
+// resolvedContent returns the YAML section of the image with inherited directives.
+func (c *rawStapelImage) resolvedContent() ([]byte, error) {
+	r := *c
+	r.Extends = ""
+
+	var res []byte
+	if c.Artifact == "" {
+		var imageValue interface{} = c.Images
+		if len(c.Images) == 1 {
+			imageValue = c.Images[0]
+		}
+
+		d, err := yaml.Marshal(map[string]interface{}{"image": imageValue})
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, d...)
+	}
+
+	d, err := yaml.Marshal(&r)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(res, d...), nil
+}
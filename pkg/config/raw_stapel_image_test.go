@@ -0,0 +1,212 @@
+package config
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("resolving stapel images extends", func() {
+	newRawImage := func(name string, rawImage *rawStapelImage) *rawStapelImage {
+		rawImage.Images = []string{name}
+		rawImage.doc = &doc{Content: []byte("image: " + name)}
+		return rawImage
+	}
+
+	newRawArtifact := func(name string, rawImage *rawStapelImage) *rawStapelImage {
+		rawImage.Artifact = name
+		rawImage.doc = &doc{Content: []byte("artifact: " + name)}
+		return rawImage
+	}
+
+	resolveImage := func(name string, rawImages ...*rawStapelImage) *rawStapelImage {
+		resolvedRawImages, err := resolveRawStapelImagesExtends(rawImages)
+		Ω(err).ShouldNot(HaveOccurred())
+		Ω(resolvedRawImages).Should(HaveLen(len(rawImages)))
+
+		for _, rawImage := range resolvedRawImages {
+			if rawImage.hasName(name) {
+				return rawImage
+			}
+		}
+
+		Fail("image " + name + " not found")
+		return nil
+	}
+
+	It("should not change images without extends", func() {
+		base := newRawImage("base", &rawStapelImage{From: "alpine"})
+		app := newRawImage("app", &rawStapelImage{From: "ubuntu"})
+
+		resolvedRawImages, err := resolveRawStapelImagesExtends([]*rawStapelImage{base, app})
+		Ω(err).ShouldNot(HaveOccurred())
+		Ω(resolvedRawImages).Should(Equal([]*rawStapelImage{base, app}))
+	})
+
+	Context("base image", func() {
+		It("should be inherited if none of from directives is specified", func() {
+			app := resolveImage("app",
+				newRawImage("base", &rawStapelImage{FromImage: "builder", FromLatest: true, FromCacheVersion: "1"}),
+				newRawImage("app", &rawStapelImage{Extends: "base"}),
+			)
+
+			Ω(app.From).Should(BeEmpty())
+			Ω(app.FromImage).Should(Equal("builder"))
+			Ω(app.FromArtifact).Should(BeEmpty())
+			Ω(app.FromLatest).Should(BeTrue())
+			Ω(app.FromCacheVersion).Should(Equal("1"))
+		})
+
+		It("should be overridden by any of from directives", func() {
+			app := resolveImage("app",
+				newRawImage("base", &rawStapelImage{From: "alpine", FromLatest: true, FromCacheVersion: "1"}),
+				newRawImage("app", &rawStapelImage{Extends: "base", FromArtifact: "builder", FromCacheVersion: "2"}),
+			)
+
+			Ω(app.From).Should(BeEmpty())
+			Ω(app.FromImage).Should(BeEmpty())
+			Ω(app.FromArtifact).Should(Equal("builder"))
+			Ω(app.FromLatest).Should(BeFalse())
+			Ω(app.FromCacheVersion).Should(Equal("2"))
+		})
+	})
+
+	It("should append git, mount and import directives to the inherited ones", func() {
+		baseGit, appGit := &rawGit{Url: "https://github.com/company/base.git"}, &rawGit{Url: "https://github.com/company/app.git"}
+		baseMount, appMount := &rawMount{To: "/base"}, &rawMount{To: "/app"}
+		baseImport, appImport := &rawImport{ImageName: "base-artifact"}, &rawImport{ImageName: "app-artifact"}
+
+		base := newRawImage("base", &rawStapelImage{From: "alpine", RawGit: []*rawGit{baseGit}, RawMount: []*rawMount{baseMount}, RawImport: []*rawImport{baseImport}})
+		app := resolveImage("app", base, newRawImage("app", &rawStapelImage{Extends: "base", RawGit: []*rawGit{appGit}, RawMount: []*rawMount{appMount}, RawImport: []*rawImport{appImport}}))
+
+		Ω(app.RawGit).Should(Equal([]*rawGit{baseGit, appGit}))
+		Ω(app.RawMount).Should(Equal([]*rawMount{baseMount, appMount}))
+		Ω(app.RawImport).Should(Equal([]*rawImport{baseImport, appImport}))
+
+		// the base image is not changed
+		Ω(base.RawGit).Should(Equal([]*rawGit{baseGit}))
+	})
+
+	It("should merge shell stages", func() {
+		app := resolveImage("app",
+			newRawImage("base", &rawStapelImage{RawShell: &rawShell{BeforeInstall: "apt update", Install: "base install", CacheVersion: "1", SetupCacheVersion: "1"}}),
+			newRawImage("app", &rawStapelImage{Extends: "base", RawShell: &rawShell{Install: []interface{}{"app install"}, Setup: "app setup", CacheVersion: "2"}}),
+		)
+
+		Ω(app.RawShell.BeforeInstall).Should(Equal("apt update"))
+		Ω(app.RawShell.Install).Should(Equal([]interface{}{"app install"}))
+		Ω(app.RawShell.BeforeSetup).Should(BeNil())
+		Ω(app.RawShell.Setup).Should(Equal("app setup"))
+		Ω(app.RawShell.CacheVersion).Should(Equal("2"))
+		Ω(app.RawShell.SetupCacheVersion).Should(Equal("1"))
+	})
+
+	It("should merge ansible stages", func() {
+		baseTasks := []rawAnsibleTask{{Block: []rawAnsibleTask{}}}
+		appTasks := []rawAnsibleTask{{}, {}}
+
+		app := resolveImage("app",
+			newRawImage("base", &rawStapelImage{RawAnsible: &rawAnsible{BeforeInstall: baseTasks, Install: baseTasks, InstallCacheVersion: "1"}}),
+			newRawImage("app", &rawStapelImage{Extends: "base", RawAnsible: &rawAnsible{Install: appTasks}}),
+		)
+
+		Ω(app.RawAnsible.BeforeInstall).Should(Equal(baseTasks))
+		Ω(app.RawAnsible.Install).Should(Equal(appTasks))
+		Ω(app.RawAnsible.Setup).Should(BeEmpty())
+		Ω(app.RawAnsible.InstallCacheVersion).Should(Equal("1"))
+	})
+
+	It("should inherit builder if it is not specified", func() {
+		baseShell := &rawShell{Install: "base install"}
+
+		app := resolveImage("app",
+			newRawImage("base", &rawStapelImage{RawShell: baseShell}),
+			newRawImage("app", &rawStapelImage{Extends: "base"}),
+		)
+
+		Ω(app.RawShell).Should(Equal(baseShell))
+		Ω(app.RawAnsible).Should(BeNil())
+	})
+
+	It("should merge docker directives, ENV and LABEL by keys", func() {
+		app := resolveImage("app",
+			newRawImage("base", &rawStapelImage{RawDocker: &rawDocker{
+				Env:     map[string]string{"A": "base", "B": "base"},
+				Label:   map[string]string{"team": "base"},
+				Workdir: "/base",
+				User:    "base",
+				Cmd:     "base",
+			}}),
+			newRawImage("app", &rawStapelImage{Extends: "base", RawDocker: &rawDocker{
+				Env:     map[string]string{"B": "app", "C": "app"},
+				Workdir: "/app",
+				Cmd:     []interface{}{"app"},
+			}}),
+		)
+
+		Ω(app.RawDocker.Env).Should(Equal(map[string]string{"A": "base", "B": "app", "C": "app"}))
+		Ω(app.RawDocker.Label).Should(Equal(map[string]string{"team": "base"}))
+		Ω(app.RawDocker.Workdir).Should(Equal("/app"))
+		Ω(app.RawDocker.User).Should(Equal("base"))
+		Ω(app.RawDocker.Cmd).Should(Equal([]interface{}{"app"}))
+	})
+
+	It("should not inherit docker directives by artifact", func() {
+		artifact := resolveImage("artifact",
+			newRawImage("base", &rawStapelImage{From: "alpine", RawDocker: &rawDocker{Workdir: "/base"}}),
+			newRawArtifact("artifact", &rawStapelImage{Extends: "base"}),
+		)
+
+		Ω(artifact.From).Should(Equal("alpine"))
+		Ω(artifact.RawDocker).Should(BeNil())
+	})
+
+	It("should resolve the chain of extends regardless of the order of sections", func() {
+		app := resolveImage("app",
+			newRawImage("app", &rawStapelImage{Extends: "middle", RawShell: &rawShell{Setup: "app setup"}}),
+			newRawImage("middle", &rawStapelImage{Extends: "base", RawShell: &rawShell{Install: "middle install"}}),
+			newRawImage("base", &rawStapelImage{From: "alpine", RawShell: &rawShell{BeforeInstall: "base beforeInstall", Install: "base install"}}),
+		)
+
+		Ω(app.From).Should(Equal("alpine"))
+		Ω(app.RawShell.BeforeInstall).Should(Equal("base beforeInstall"))
+		Ω(app.RawShell.Install).Should(Equal("middle install"))
+		Ω(app.RawShell.Setup).Should(Equal("app setup"))
+	})
+
+	It("should extend one of the names of the multi-image section", func() {
+		base := &rawStapelImage{From: "alpine", doc: &doc{}}
+		base.Images = []string{"base-1", "base-2"}
+
+		app := resolveImage("app", base, newRawImage("app", &rawStapelImage{Extends: "base-2"}))
+		Ω(app.From).Should(Equal("alpine"))
+	})
+
+	Context("errors", func() {
+		It("should fail if the image to extend does not exist", func() {
+			_, err := resolveRawStapelImagesExtends([]*rawStapelImage{
+				newRawImage("app", &rawStapelImage{Extends: "unknown"}),
+			})
+			Ω(err).Should(HaveOccurred())
+			Ω(err.Error()).Should(ContainSubstring("no such image or artifact `unknown` to extend!"))
+		})
+
+		It("should detect the image extending itself", func() {
+			_, err := resolveRawStapelImagesExtends([]*rawStapelImage{
+				newRawImage("app", &rawStapelImage{Extends: "app"}),
+			})
+			Ω(err).Should(HaveOccurred())
+			Ω(err.Error()).Should(ContainSubstring("infinite loop detected while resolving `extends: app`!"))
+		})
+
+		It("should detect the loop of extends", func() {
+			_, err := resolveRawStapelImagesExtends([]*rawStapelImage{
+				newRawImage("base", &rawStapelImage{From: "alpine"}),
+				newRawImage("a", &rawStapelImage{Extends: "b"}),
+				newRawImage("b", &rawStapelImage{Extends: "c"}),
+				newRawImage("c", &rawStapelImage{Extends: "a"}),
+			})
+			Ω(err).Should(HaveOccurred())
+			Ω(err.Error()).Should(ContainSubstring("infinite loop detected"))
+		})
+	})
+})
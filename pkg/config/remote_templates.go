@@ -0,0 +1,172 @@
+package config
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+	"text/template"
+
+	"github.com/werf/logboek"
+
+	"github.com/werf/werf/pkg/git_repo"
+	"github.com/werf/werf/pkg/giterminism_inspector"
+)
+
+const defaultRemoteTemplatesDir = ".werf"
+
+var commitRegexp = regexp.MustCompile(`^[0-9a-f]{40}$`)
+
+// RemoteTemplates is the commit of the remote git repository werf config templates are imported from.
+type RemoteTemplates struct {
+	Url    string `json:"url"`
+	Commit string `json:"commit"`
+}
+
+// remoteTemplatesImporter implements the importTemplates function of werf.yaml:
+//
+//	{{ importTemplates "https://github.com/company/werf-snippets.git" "<commit>" }}
+//	{{ include "base/apt.tmpl" . }}
+//
+// *.tmpl files of the dir (.werf by default) of the remote repository at the pinned commit are added
+// with names relative to the dir, the same way as templates of the project.
+// The importer is created for each render of the config, so the same repository cannot be imported at different commits within the config.
+type remoteTemplatesImporter struct {
+	ctx             context.Context
+	tmpl            *template.Template
+	imported        map[string]bool
+	remoteTemplates []*RemoteTemplates
+}
+
+func newRemoteTemplatesImporter(ctx context.Context, tmpl *template.Template) *remoteTemplatesImporter {
+	return &remoteTemplatesImporter{ctx: ctx, tmpl: tmpl, imported: map[string]bool{}}
+}
+
+func (i *remoteTemplatesImporter) ImportTemplates(url, commit string, dirs ...string) (string, error) {
+	dir := defaultRemoteTemplatesDir
+	switch len(dirs) {
+	case 0:
+	case 1:
+		dir = dirs[0]
+	default:
+		return "", fmt.Errorf("config {{ importTemplates }}: only one templates dir can be specified")
+	}
+	dir = strings.Trim(path.Clean("/"+dir), "/")
+
+	if !commitRegexp.MatchString(commit) {
+		return "", fmt.Errorf("config {{ importTemplates %q %q }}: full commit hash should be specified to pin the remote templates", url, commit)
+	}
+
+	if err := i.addRemoteTemplates(url, commit); err != nil {
+		return "", fmt.Errorf("config {{ importTemplates %q %q }}: %s", url, commit, err)
+	}
+
+	importID := fmt.Sprintf("%s %s %s", url, commit, dir)
+	if i.imported[importID] {
+		return "", nil
+	}
+
+	if err := i.importTemplates(url, commit, dir); err != nil {
+		return "", fmt.Errorf("config {{ importTemplates %q %q }}: %s", url, commit, err)
+	}
+	i.imported[importID] = true
+
+	return "", nil
+}
+
+// addRemoteTemplates records the commit of the remote repository, the repository cannot be imported at different commits.
+func (i *remoteTemplatesImporter) addRemoteTemplates(url, commit string) error {
+	for _, r := range i.remoteTemplates {
+		if r.Url != url {
+			continue
+		}
+
+		if r.Commit != commit {
+			return fmt.Errorf("templates of the remote repository %s cannot be imported at different commits %s and %s", url, r.Commit, commit)
+		}
+
+		return nil
+	}
+	i.remoteTemplates = append(i.remoteTemplates, &RemoteTemplates{Url: url, Commit: commit})
+
+	giterminism_inspector.ReportConfigGoTemplateRenderingRemoteTemplates(i.ctx, url, commit)
+
+	return nil
+}
+
+func (i *remoteTemplatesImporter) importTemplates(url, commit, dir string) error {
+	remoteGitRepo, err := git_repo.OpenRemoteRepo(getRepositoryID(url), url)
+	if err != nil {
+		return err
+	}
+
+	if err := prepareRemoteTemplatesCommit(i.ctx, remoteGitRepo, commit); err != nil {
+		return err
+	}
+
+	paths, err := remoteGitRepo.GetCommitFilePathList(i.ctx, commit)
+	if err != nil {
+		return fmt.Errorf("unable to get files list from the remote repository: %s", err)
+	}
+
+	for _, p := range paths {
+		if dir != "" && !strings.HasPrefix(p, dir+"/") {
+			continue
+		}
+
+		if matched, err := path.Match("*.tmpl", path.Base(p)); err != nil {
+			return err
+		} else if !matched {
+			continue
+		}
+
+		templateName := strings.TrimPrefix(p, dir+"/")
+		if dir == "" {
+			templateName = p
+		}
+
+		if i.tmpl.Lookup(templateName) != nil {
+			return fmt.Errorf("template %s is already defined", templateName)
+		}
+
+		data, err := remoteGitRepo.ReadCommitFile(i.ctx, commit, p)
+		if err != nil {
+			return fmt.Errorf("unable to read %s: %s", p, err)
+		}
+
+		if err := addTemplate(i.tmpl, templateName, string(data)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// prepareRemoteTemplatesCommit clones the remote repository into the git repos cache,
+// the repository is fetched only if the cached clone does not contain the commit.
+func prepareRemoteTemplatesCommit(ctx context.Context, remoteGitRepo *git_repo.Remote, commit string) error {
+	return logboek.Context(ctx).Info().LogProcess(fmt.Sprintf("Refreshing %s repository", remoteGitRepo.GetName())).DoError(func() error {
+		if isCloned, err := remoteGitRepo.Clone(ctx); err != nil {
+			return err
+		} else if !isCloned {
+			if exists, err := remoteGitRepo.IsCommitExists(ctx, commit); err != nil {
+				return err
+			} else if exists {
+				return nil
+			}
+
+			if err := remoteGitRepo.Fetch(ctx); err != nil {
+				return err
+			}
+		}
+
+		if exists, err := remoteGitRepo.IsCommitExists(ctx, commit); err != nil {
+			return err
+		} else if !exists {
+			return fmt.Errorf("commit %s not found in the remote repository", commit)
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,167 @@
+package config
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"text/template"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/werf/werf/pkg/werf"
+)
+
+var _ = Describe("importTemplates", func() {
+	const commit = "f2c3d4b5a6978877665544332211aabbccddeeff"
+
+	var importer *remoteTemplatesImporter
+
+	BeforeEach(func() {
+		importer = newRemoteTemplatesImporter(context.Background(), template.New("werfConfig"))
+	})
+
+	It("should fail if more than one templates dir is specified", func() {
+		_, err := importer.ImportTemplates("https://github.com/company/werf-snippets.git", commit, "a", "b")
+		Ω(err).Should(MatchError(ContainSubstring("only one templates dir can be specified")))
+	})
+
+	It("should fail if the commit is not a full hash", func() {
+		for _, c := range []string{"master", "v1.0.0", "f2c3d4b", strings.ToUpper(commit)} {
+			_, err := importer.ImportTemplates("https://github.com/company/werf-snippets.git", c)
+			Ω(err).Should(MatchError(ContainSubstring("full commit hash should be specified")), c)
+		}
+
+		Ω(importer.remoteTemplates).Should(BeEmpty())
+	})
+
+	It("should record the commit of the repository once", func() {
+		Ω(importer.addRemoteTemplates("https://github.com/company/a.git", commit)).Should(Succeed())
+		Ω(importer.addRemoteTemplates("https://github.com/company/b.git", strings.Repeat("0", 40))).Should(Succeed())
+		Ω(importer.addRemoteTemplates("https://github.com/company/a.git", commit)).Should(Succeed())
+
+		Ω(importer.remoteTemplates).Should(Equal([]*RemoteTemplates{
+			{Url: "https://github.com/company/a.git", Commit: commit},
+			{Url: "https://github.com/company/b.git", Commit: strings.Repeat("0", 40)},
+		}))
+	})
+
+	It("should not allow to import the repository at different commits within the config", func() {
+		Ω(importer.addRemoteTemplates("https://github.com/company/a.git", commit)).Should(Succeed())
+
+		err := importer.addRemoteTemplates("https://github.com/company/a.git", strings.Repeat("0", 40))
+		Ω(err).Should(MatchError(ContainSubstring("cannot be imported at different commits")))
+
+		// the importer is created for each render of the config
+		otherImporter := newRemoteTemplatesImporter(context.Background(), template.New("werfConfig"))
+		Ω(otherImporter.addRemoteTemplates("https://github.com/company/a.git", strings.Repeat("0", 40))).Should(Succeed())
+	})
+
+	Context("with the remote repository", func() {
+		var tmpDir, remoteRepoDir, remoteRepoUrl string
+
+		git := func(args ...string) string {
+			cmd := exec.Command("git", append([]string{"-c", "user.name=werf", "-c", "user.email=werf@werf.io"}, args...)...)
+			cmd.Dir = remoteRepoDir
+			output, err := cmd.CombinedOutput()
+			Ω(err).ShouldNot(HaveOccurred(), string(output))
+			return strings.TrimSpace(string(output))
+		}
+
+		commitFiles := func(files map[string]string) string {
+			for relPath, content := range files {
+				path := filepath.Join(remoteRepoDir, relPath)
+				Ω(os.MkdirAll(filepath.Dir(path), 0755)).Should(Succeed())
+				Ω(ioutil.WriteFile(path, []byte(content), 0644)).Should(Succeed())
+			}
+
+			git("add", "-A")
+			git("commit", "-m", "+")
+			return git("rev-parse", "HEAD")
+		}
+
+		BeforeEach(func() {
+			var err error
+			tmpDir, err = ioutil.TempDir("", "werf-config-remote-templates-")
+			Ω(err).ShouldNot(HaveOccurred())
+
+			Ω(werf.Init(filepath.Join(tmpDir, "tmp"), filepath.Join(tmpDir, "home"))).Should(Succeed())
+
+			remoteRepoDir = filepath.Join(tmpDir, "snippets")
+			Ω(os.MkdirAll(remoteRepoDir, 0755)).Should(Succeed())
+			git("init")
+			remoteRepoUrl = "file://" + remoteRepoDir
+		})
+
+		AfterEach(func() {
+			Ω(os.RemoveAll(tmpDir)).Should(Succeed())
+		})
+
+		It("should add *.tmpl files of the templates dir at the commit", func() {
+			firstCommit := commitFiles(map[string]string{
+				".werf/shell/apk.tmpl": `{{ define "shell/apk.tmpl" }}apk add {{ . }}{{ end }}`,
+				".werf/README.md":      "not a template",
+				"other/other.tmpl":     "other",
+			})
+			commitFiles(map[string]string{".werf/shell/apt.tmpl": "apt"})
+
+			_, err := importer.ImportTemplates(remoteRepoUrl, firstCommit)
+			Ω(err).ShouldNot(HaveOccurred())
+
+			Ω(importer.tmpl.Lookup("shell/apk.tmpl")).ShouldNot(BeNil())
+			Ω(importer.tmpl.Lookup("shell/apt.tmpl")).Should(BeNil())
+			Ω(importer.tmpl.Lookup("README.md")).Should(BeNil())
+			Ω(importer.tmpl.Lookup("other/other.tmpl")).Should(BeNil())
+
+			res, err := executeTemplate(importer.tmpl, "shell/apk.tmpl", "curl")
+			Ω(err).ShouldNot(HaveOccurred())
+			Ω(res).Should(Equal("apk add curl"))
+
+			Ω(importer.remoteTemplates).Should(Equal([]*RemoteTemplates{{Url: remoteRepoUrl, Commit: firstCommit}}))
+
+			By("importing the same templates again")
+			_, err = importer.ImportTemplates(remoteRepoUrl, firstCommit)
+			Ω(err).ShouldNot(HaveOccurred())
+		})
+
+		It("should add templates of the custom dir with names relative to the dir", func() {
+			c := commitFiles(map[string]string{"snippets/base.tmpl": "base"})
+
+			_, err := importer.ImportTemplates(remoteRepoUrl, c, "/snippets/")
+			Ω(err).ShouldNot(HaveOccurred())
+			Ω(importer.tmpl.Lookup("base.tmpl")).ShouldNot(BeNil())
+		})
+
+		It("should fetch the cached clone if it does not contain the commit", func() {
+			firstCommit := commitFiles(map[string]string{".werf/a.tmpl": "a"})
+
+			_, err := importer.ImportTemplates(remoteRepoUrl, firstCommit)
+			Ω(err).ShouldNot(HaveOccurred())
+
+			secondCommit := commitFiles(map[string]string{".werf/b.tmpl": "b"})
+
+			otherImporter := newRemoteTemplatesImporter(context.Background(), template.New("werfConfig"))
+			_, err = otherImporter.ImportTemplates(remoteRepoUrl, secondCommit)
+			Ω(err).ShouldNot(HaveOccurred())
+			Ω(otherImporter.tmpl.Lookup("b.tmpl")).ShouldNot(BeNil())
+		})
+
+		It("should fail if the commit does not exist", func() {
+			commitFiles(map[string]string{".werf/a.tmpl": "a"})
+
+			_, err := importer.ImportTemplates(remoteRepoUrl, commit)
+			Ω(err).Should(MatchError(ContainSubstring("commit %s not found in the remote repository", commit)))
+		})
+
+		It("should fail if the template is already defined", func() {
+			c := commitFiles(map[string]string{".werf/a.tmpl": "a"})
+			Ω(addTemplate(importer.tmpl, "a.tmpl", "project")).Should(Succeed())
+
+			_, err := importer.ImportTemplates(remoteRepoUrl, c)
+			Ω(err).Should(MatchError(ContainSubstring("template a.tmpl is already defined")))
+		})
+	})
+})
@@ -0,0 +1,198 @@
+package config
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"time"
+)
+
+const (
+	SchemaMetaDefinition            = "meta"
+	SchemaImageDefinition           = "image"
+	SchemaArtifactDefinition        = "artifact"
+	SchemaDockerfileImageDefinition = "dockerfileImage"
+)
+
+// JSONSchema is the subset of JSON Schema draft-07 which is used to describe werf.yaml.
+type JSONSchema struct {
+	Schema               string                 `json:"$schema,omitempty"`
+	Ref                  string                 `json:"$ref,omitempty"`
+	Title                string                 `json:"title,omitempty"`
+	Description          string                 `json:"description,omitempty"`
+	Type                 interface{}            `json:"type,omitempty"`
+	Enum                 []interface{}          `json:"enum,omitempty"`
+	Properties           map[string]*JSONSchema `json:"properties,omitempty"`
+	Required             []string               `json:"required,omitempty"`
+	AdditionalProperties interface{}            `json:"additionalProperties,omitempty"`
+	Items                *JSONSchema            `json:"items,omitempty"`
+	OneOf                []*JSONSchema          `json:"oneOf,omitempty"`
+	Definitions          map[string]*JSONSchema `json:"definitions,omitempty"`
+}
+
+// GetWerfConfigJSONSchema returns the schema of werf.yaml sections (YAML documents):
+// every section should be the meta, stapel image, artifact or Dockerfile image section.
+// The schema is generated from the raw config types, so it describes the structure of sections only,
+// semantic checks (e.g. mutually exclusive directives or references between images) are performed by werf.
+func GetWerfConfigJSONSchema() *JSONSchema {
+	schema := getWerfConfigDefinitionsSchema()
+	schema.Schema = "http://json-schema.org/draft-07/schema#"
+	schema.Title = "werf.yaml section"
+	schema.Description = "werf.yaml is a YAML stream of sections: the meta section and image, artifact and Dockerfile image sections, https://werf.io/documentation/reference/werf_yaml.html"
+
+	for _, definition := range []string{SchemaMetaDefinition, SchemaImageDefinition, SchemaArtifactDefinition, SchemaDockerfileImageDefinition} {
+		schema.OneOf = append(schema.OneOf, &JSONSchema{Ref: "#/definitions/" + definition})
+	}
+
+	return schema
+}
+
+// GetWerfConfigSectionJSONSchema returns the schema of the section with the definition name.
+func GetWerfConfigSectionJSONSchema(definition string) *JSONSchema {
+	schema := getWerfConfigDefinitionsSchema()
+	schema.Ref = "#/definitions/" + definition
+	return schema
+}
+
+func (s *JSONSchema) MarshalIndent() ([]byte, error) {
+	return json.MarshalIndent(s, "", "  ")
+}
+
+func getWerfConfigDefinitionsSchema() *JSONSchema {
+	g := &schemaGenerator{definitions: map[string]*JSONSchema{}}
+
+	meta := g.generateDefinition(reflect.TypeOf(rawMeta{}))
+	meta.Title = "Meta section"
+	meta.Required = []string{"configVersion", "project"}
+	meta.Properties["configVersion"] = &JSONSchema{Type: "integer", Enum: []interface{}{1}}
+	g.definitions[SchemaMetaDefinition] = meta
+
+	imageNameSchema := &JSONSchema{
+		Type:  []string{"string", "array", "null"},
+		Items: &JSONSchema{Type: "string"},
+	}
+
+	image := g.generateDefinition(reflect.TypeOf(rawStapelImage{}))
+	image.Title = "Stapel image section"
+	delete(image.Properties, "artifact")
+	image.Properties["image"] = imageNameSchema
+	image.Required = []string{"image"}
+	g.definitions[SchemaImageDefinition] = image
+
+	artifact := g.generateDefinition(reflect.TypeOf(rawStapelImage{}))
+	artifact.Title = "Stapel artifact section"
+	artifact.Properties["artifact"] = &JSONSchema{Type: "string"}
+	artifact.Required = []string{"artifact"}
+	g.definitions[SchemaArtifactDefinition] = artifact
+
+	dockerfileImage := g.generateDefinition(reflect.TypeOf(rawImageFromDockerfile{}))
+	dockerfileImage.Title = "Dockerfile image section"
+	dockerfileImage.Properties["image"] = imageNameSchema
+	dockerfileImage.Required = []string{"image", "dockerfile"}
+	g.definitions[SchemaDockerfileImageDefinition] = dockerfileImage
+
+	return &JSONSchema{Definitions: g.definitions}
+}
+
+type schemaGenerator struct {
+	definitions map[string]*JSONSchema
+}
+
+// generateDefinition returns the object schema of the raw config type, nested raw types are added to definitions.
+func (g *schemaGenerator) generateDefinition(t reflect.Type) *JSONSchema {
+	schema := &JSONSchema{
+		Type:       "object",
+		Properties: map[string]*JSONSchema{},
+	}
+
+	g.addStructProperties(schema, t)
+
+	if schema.AdditionalProperties == nil {
+		schema.AdditionalProperties = true
+	}
+
+	return schema
+}
+
+func (g *schemaGenerator) addStructProperties(schema *JSONSchema, t reflect.Type) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+
+		tag := field.Tag.Get("yaml")
+		if tag == "-" || (tag == "" && !field.Anonymous) {
+			continue
+		}
+
+		tagParts := strings.Split(tag, ",")
+		name := tagParts[0]
+		isInline := false
+		for _, option := range tagParts[1:] {
+			if option == "inline" {
+				isInline = true
+			}
+		}
+
+		if isInline {
+			switch field.Type.Kind() {
+			case reflect.Struct:
+				g.addStructProperties(schema, field.Type)
+			case reflect.Map:
+				// UnsupportedAttributes are reported as unknown fields, other inline maps accept arbitrary fields
+				schema.AdditionalProperties = field.Name != "UnsupportedAttributes"
+			}
+			continue
+		}
+
+		if field.PkgPath != "" || name == "" {
+			continue
+		}
+
+		schema.Properties[name] = g.generateTypeSchema(field.Type)
+	}
+}
+
+func (g *schemaGenerator) generateTypeSchema(t reflect.Type) *JSONSchema {
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	if t == reflect.TypeOf(time.Duration(0)) {
+		return &JSONSchema{Type: "string", Description: "Duration, e.g. 12h or 168h"}
+	}
+
+	switch t.Kind() {
+	case reflect.String:
+		// yaml scalars of other types are unmarshalled into strings as is
+		return &JSONSchema{Type: []string{"string", "number", "boolean"}}
+	case reflect.Bool:
+		return &JSONSchema{Type: "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64, reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return &JSONSchema{Type: "integer"}
+	case reflect.Slice:
+		return &JSONSchema{Type: "array", Items: g.generateTypeSchema(t.Elem())}
+	case reflect.Map:
+		if t.Elem().Kind() == reflect.Interface {
+			return &JSONSchema{Type: "object"}
+		}
+		return &JSONSchema{Type: "object", AdditionalProperties: g.generateTypeSchema(t.Elem())}
+	case reflect.Interface:
+		// directives without the fixed type are parsed with InterfaceToStringArray
+		return &JSONSchema{Type: []string{"string", "array"}, Items: &JSONSchema{Type: "string"}}
+	case reflect.Struct:
+		name := schemaDefinitionName(t)
+		if _, hasKey := g.definitions[name]; !hasKey {
+			// the placeholder breaks the recursion of ansible task blocks
+			g.definitions[name] = &JSONSchema{}
+			*g.definitions[name] = *g.generateDefinition(t)
+		}
+		return &JSONSchema{Ref: "#/definitions/" + name}
+	}
+
+	return &JSONSchema{}
+}
+
+// schemaDefinitionName returns the name of the raw type without the raw prefix, e.g. git for rawGit.
+func schemaDefinitionName(t reflect.Type) string {
+	name := strings.TrimPrefix(t.Name(), "raw")
+	return strings.ToLower(name[:1]) + name[1:]
+}
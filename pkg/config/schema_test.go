@@ -0,0 +1,170 @@
+package config
+
+import (
+	"encoding/json"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("werf.yaml JSON schema", func() {
+	var schema *JSONSchema
+
+	BeforeEach(func() {
+		schema = GetWerfConfigJSONSchema()
+	})
+
+	It("should be one of the sections", func() {
+		var refs []string
+		for _, s := range schema.OneOf {
+			refs = append(refs, s.Ref)
+		}
+
+		Ω(refs).Should(Equal([]string{
+			"#/definitions/" + SchemaMetaDefinition,
+			"#/definitions/" + SchemaImageDefinition,
+			"#/definitions/" + SchemaArtifactDefinition,
+			"#/definitions/" + SchemaDockerfileImageDefinition,
+		}))
+	})
+
+	It("should refer only to existing definitions", func() {
+		var refs []string
+		var collectRefs func(s *JSONSchema)
+		collectRefs = func(s *JSONSchema) {
+			if s == nil {
+				return
+			}
+
+			if s.Ref != "" {
+				refs = append(refs, s.Ref)
+			}
+
+			for _, property := range s.Properties {
+				collectRefs(property)
+			}
+
+			for _, oneOf := range s.OneOf {
+				collectRefs(oneOf)
+			}
+
+			collectRefs(s.Items)
+
+			if additionalProperties, ok := s.AdditionalProperties.(*JSONSchema); ok {
+				collectRefs(additionalProperties)
+			}
+		}
+
+		collectRefs(schema)
+		for _, definition := range schema.Definitions {
+			collectRefs(definition)
+		}
+
+		Ω(refs).ShouldNot(BeEmpty())
+		for _, ref := range refs {
+			Ω(ref).Should(HavePrefix("#/definitions/"))
+			Ω(schema.Definitions).Should(HaveKey(strings.TrimPrefix(ref, "#/definitions/")), ref)
+		}
+	})
+
+	It("should be marshalled into JSON Schema draft-07 document", func() {
+		data, err := schema.MarshalIndent()
+		Ω(err).ShouldNot(HaveOccurred())
+
+		var document map[string]interface{}
+		Ω(json.Unmarshal(data, &document)).Should(Succeed())
+		Ω(document).Should(HaveKeyWithValue("$schema", "http://json-schema.org/draft-07/schema#"))
+		Ω(document).Should(HaveKey("definitions"))
+		Ω(document).Should(HaveKey("oneOf"))
+	})
+
+	It("should refer to the section definition", func() {
+		sectionSchema := GetWerfConfigSectionJSONSchema(SchemaImageDefinition)
+		Ω(sectionSchema.Ref).Should(Equal("#/definitions/" + SchemaImageDefinition))
+		Ω(sectionSchema.Definitions).Should(HaveKey(SchemaImageDefinition))
+	})
+
+	type sectionEntry struct {
+		definition          string
+		required            []string
+		properties          []string
+		forbiddenProperties []string
+	}
+
+	DescribeTable("section definition", func(e sectionEntry) {
+		definition := schema.Definitions[e.definition]
+		Ω(definition).ShouldNot(BeNil())
+		Ω(definition.Type).Should(Equal("object"))
+		Ω(definition.Required).Should(Equal(e.required))
+
+		for _, property := range e.properties {
+			Ω(definition.Properties).Should(HaveKey(property))
+		}
+
+		for _, property := range e.forbiddenProperties {
+			Ω(definition.Properties).ShouldNot(HaveKey(property))
+		}
+
+		// unknown directives are reported by werf, so they are not allowed by the schema
+		Ω(definition.AdditionalProperties).Should(Equal(false))
+	},
+		Entry("meta", sectionEntry{
+			definition: SchemaMetaDefinition,
+			required:   []string{"configVersion", "project"},
+			properties: []string{"configVersion", "project", "deploy", "cleanup", "gitWorktree"},
+		}),
+		Entry("stapel image", sectionEntry{
+			definition:          SchemaImageDefinition,
+			required:            []string{"image"},
+			properties:          []string{"image", "from", "fromImage", "fromArtifact", "git", "shell", "ansible", "mount", "import", "docker"},
+			forbiddenProperties: []string{"artifact"},
+		}),
+		Entry("stapel artifact", sectionEntry{
+			definition: SchemaArtifactDefinition,
+			required:   []string{"artifact"},
+			properties: []string{"artifact", "from", "git", "shell", "ansible", "import"},
+		}),
+		Entry("Dockerfile image", sectionEntry{
+			definition:          SchemaDockerfileImageDefinition,
+			required:            []string{"image", "dockerfile"},
+			properties:          []string{"image", "dockerfile", "context", "contextAddFile", "target", "args", "addHost", "network", "ssh"},
+			forbiddenProperties: []string{"from", "git", "shell"},
+		}),
+	)
+
+	It("should allow the single image name or the list of names", func() {
+		imageSchema := schema.Definitions[SchemaImageDefinition].Properties["image"]
+		Ω(imageSchema.Type).Should(Equal([]string{"string", "array", "null"}))
+		Ω(imageSchema.Items).Should(Equal(&JSONSchema{Type: "string"}))
+	})
+
+	It("should allow only configVersion 1", func() {
+		configVersionSchema := schema.Definitions[SchemaMetaDefinition].Properties["configVersion"]
+		Ω(configVersionSchema.Type).Should(Equal("integer"))
+		Ω(configVersionSchema.Enum).Should(Equal([]interface{}{1}))
+	})
+
+	It("should describe nested directives with definitions", func() {
+		gitSchema := schema.Definitions[SchemaImageDefinition].Properties["git"]
+		Ω(gitSchema.Type).Should(Equal("array"))
+		Ω(gitSchema.Items.Ref).Should(Equal("#/definitions/git"))
+
+		git := schema.Definitions["git"]
+		Ω(git.Properties).Should(HaveKey("stageDependencies"))
+		Ω(git.Properties["stageDependencies"].Ref).Should(Equal("#/definitions/stageDependencies"))
+		// the single path or the list of paths
+		Ω(git.Properties["includePaths"].Type).Should(Equal([]string{"string", "array"}))
+	})
+
+	It("should describe recursive ansible task blocks", func() {
+		ansibleTask := schema.Definitions["ansibleTask"]
+		Ω(ansibleTask).ShouldNot(BeNil())
+		Ω(ansibleTask.Properties).Should(HaveKey("block"))
+		Ω(ansibleTask.Properties["block"].Items.Ref).Should(Equal("#/definitions/ansibleTask"))
+
+		// ansible modules are arbitrary task fields
+		Ω(ansibleTask.AdditionalProperties).Should(Equal(true))
+	})
+})
@@ -0,0 +1,541 @@
+package config
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/xeipuuv/gojsonschema"
+	"gopkg.in/yaml.v2"
+
+	"github.com/werf/logboek"
+
+	"github.com/werf/werf/pkg/git_repo"
+	"github.com/werf/werf/pkg/tmp_manager"
+	"github.com/werf/werf/pkg/util"
+)
+
+type ValidationError struct {
+	Message string `json:"message"`
+	// Path is the path of the field in the config section, e.g. git.0.add
+	Path string `json:"path,omitempty"`
+
+	RenderedFilePath string `json:"renderedFilePath"`
+	// RenderedLine is 0 if the error is not related to the particular line
+	RenderedLine int `json:"renderedLine,omitempty"`
+
+	// ConfigPath and ConfigLine point to werf.yaml template line if the rendered line is found in werf.yaml as is
+	ConfigPath string `json:"configPath,omitempty"`
+	ConfigLine int    `json:"configLine,omitempty"`
+}
+
+func (e *ValidationError) String() string {
+	var position string
+	if e.ConfigLine != 0 {
+		position = fmt.Sprintf("%s:%d (%s:%d)", e.ConfigPath, e.ConfigLine, e.RenderedFilePath, e.RenderedLine)
+	} else if e.RenderedLine != 0 {
+		position = fmt.Sprintf("%s:%d", e.RenderedFilePath, e.RenderedLine)
+	} else {
+		position = e.RenderedFilePath
+	}
+
+	if e.Path != "" {
+		return fmt.Sprintf("%s: %s: %s", position, e.Path, e.Message)
+	}
+
+	return fmt.Sprintf("%s: %s", position, e.Message)
+}
+
+// ValidateWerfConfig renders werf.yaml and returns all schema and semantic errors of config sections.
+// Semantic checks of the section are performed if the section matches the schema,
+// checks of references between sections are performed if all sections are valid.
+// The error is returned if werf.yaml cannot be rendered.
+func ValidateWerfConfig(ctx context.Context, projectDir, werfConfigPath, werfConfigTemplatesDir string, localGitRepo *git_repo.Local, opts WerfConfigOptions) ([]*ValidationError, error) {
+	var commit string
+	if localGitRepo != nil {
+		if c, err := localGitRepo.HeadCommit(ctx); err != nil {
+			return nil, fmt.Errorf("unable to get local repo head commit: %s", err)
+		} else {
+			commit = c
+		}
+	}
+
+	werfConfigData, err := readWerfConfigFile(ctx, projectDir, werfConfigPath, localGitRepo, commit)
+	if err != nil {
+		return nil, err
+	}
+
+	werfConfigRenderContent, _, err := renderWerfConfigYaml(ctx, projectDir, werfConfigPath, werfConfigTemplatesDir, localGitRepo, opts)
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse config: %s", err)
+	}
+
+	werfConfigRenderPath, err := tmp_manager.CreateWerfConfigRender(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.LogRenderedFilePath {
+		logboek.Context(ctx).LogF("Using werf config render file: %s\n", werfConfigRenderPath)
+	}
+
+	if err := writeWerfConfigRender(werfConfigRenderContent, werfConfigRenderPath); err != nil {
+		return nil, fmt.Errorf("unable to write rendered config to %s: %s", werfConfigRenderPath, err)
+	}
+
+	docs, err := splitByDocs(werfConfigRenderContent, werfConfigRenderPath)
+	if err != nil {
+		return nil, err
+	}
+
+	configPath := werfConfigPath
+	if filepath.IsAbs(configPath) {
+		configPath = util.GetRelativeToBaseFilepath(projectDir, configPath)
+	}
+
+	v := &configValidator{
+		renderedFilePath: werfConfigRenderPath,
+		configPath:       configPath,
+		configLines:      mapRenderedLinesToTemplateLines(string(werfConfigData), werfConfigRenderContent),
+	}
+
+	for _, d := range docs {
+		v.validateDoc(d)
+	}
+
+	if len(v.errors) == 0 {
+		v.validateDocs(docs)
+	}
+
+	return v.errors, nil
+}
+
+type configValidator struct {
+	renderedFilePath string
+	configPath       string
+	configLines      map[int]int
+
+	errors []*ValidationError
+}
+
+func (v *configValidator) addError(message, path string, renderedLine int) {
+	err := &ValidationError{
+		Message:          message,
+		Path:             path,
+		RenderedFilePath: v.renderedFilePath,
+		RenderedLine:     renderedLine,
+	}
+
+	if configLine, hasKey := v.configLines[renderedLine]; hasKey {
+		err.ConfigPath = v.configPath
+		err.ConfigLine = configLine
+	}
+
+	v.errors = append(v.errors, err)
+}
+
+var yamlErrorLineRegexp = regexp.MustCompile(`line ([0-9]+)`)
+
+// addDocError adds the semantic error of the section, the error is related to the first line of the section
+// unless the line is specified in the message.
+func (v *configValidator) addDocError(d *doc, err error) {
+	message := err.Error()
+	line := d.Line + 1 + findYamlPathLine(getYamlPathsLines(d.Content), nil)
+
+	var configErr *configError
+	if errors.As(err, &configErr) && configErr.message != "" {
+		message = configErr.message
+	}
+
+	if res := yamlErrorLineRegexp.FindStringSubmatch(message); len(res) == 2 {
+		if l, err := strconv.Atoi(res[1]); err == nil {
+			line = l
+		}
+	}
+
+	v.addError(strings.TrimSpace(message), "", line)
+}
+
+func (v *configValidator) validateDoc(d *doc) {
+	var raw map[string]interface{}
+	if err := yaml.UnmarshalStrict(d.Content, &raw); err != nil {
+		v.addDocError(d, newYamlUnmarshalError(err, d))
+		return
+	}
+
+	var definition string
+	switch {
+	case isMetaDoc(raw):
+		definition = SchemaMetaDefinition
+	case isImageFromDockerfileDoc(raw):
+		definition = SchemaDockerfileImageDefinition
+	case hasKey(raw, "image"):
+		definition = SchemaImageDefinition
+	case hasKey(raw, "artifact"):
+		definition = SchemaArtifactDefinition
+	default:
+		v.addError("cannot recognize type of config section: 'configVersion' required for meta config section, 'image' required for the image config sections, 'artifact' required for the artifact config sections", "", d.Line+1)
+		return
+	}
+
+	schemaErrors, err := validateDocBySchema(definition, raw)
+	if err != nil {
+		v.addDocError(d, err)
+		return
+	}
+
+	if len(schemaErrors) != 0 {
+		positions := getYamlPathsLines(d.Content)
+		for _, schemaErr := range schemaErrors {
+			path := getSchemaErrorPath(schemaErr)
+			v.addError(schemaErr.Description(), strings.Join(path, "."), d.Line+1+findYamlPathLine(positions, path))
+		}
+		return
+	}
+
+	if err := validateDocSemantics(d); err != nil {
+		v.addDocError(d, err)
+	}
+}
+
+func validateDocSemantics(d *doc) error {
+	_, rawStapelImages, rawImagesFromDockerfile, err := splitByMetaAndRawImages([]*doc{d})
+	if err != nil {
+		return err
+	}
+
+	for _, rawImage := range rawStapelImages {
+		// images with inherited directives are checked with the whole config
+		if rawImage.Extends != "" {
+			continue
+		}
+
+		if rawImage.stapelImageType() == "images" {
+			if _, err := rawImage.toStapelImageDirectives(); err != nil {
+				return err
+			}
+		} else if _, err := rawImage.toStapelImageArtifactDirectives(); err != nil {
+			return err
+		}
+	}
+
+	for _, rawImageFromDockerfile := range rawImagesFromDockerfile {
+		if _, err := rawImageFromDockerfile.toImageFromDockerfileDirectives(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateDocs performs checks of the whole config: the meta section and references between images.
+func (v *configValidator) validateDocs(docs []*doc) {
+	meta, rawStapelImages, rawImagesFromDockerfile, err := splitByMetaAndRawImages(docs)
+	if err != nil {
+		v.addConfigError(err)
+		return
+	}
+
+	if meta == nil {
+		v.addError("meta config section is not defined: configVersion and project fields required", "", 0)
+		return
+	}
+
+	if _, err := prepareWerfConfig(rawStapelImages, rawImagesFromDockerfile, meta); err != nil {
+		v.addConfigError(err)
+	}
+}
+
+func (v *configValidator) addConfigError(err error) {
+	var configErr *configError
+	if errors.As(err, &configErr) && configErr.doc != nil {
+		v.addDocError(configErr.doc, err)
+	} else {
+		v.addError(err.Error(), "", 0)
+	}
+}
+
+var (
+	werfConfigSchemas    = map[string]*gojsonschema.Schema{}
+	werfConfigSchemasMux sync.Mutex
+)
+
+// getWerfConfigSectionSchema returns the compiled schema of the config section, schemas are compiled once and cached.
+func getWerfConfigSectionSchema(definition string) (*gojsonschema.Schema, error) {
+	werfConfigSchemasMux.Lock()
+	defer werfConfigSchemasMux.Unlock()
+
+	if schema, hasKey := werfConfigSchemas[definition]; hasKey {
+		return schema, nil
+	}
+
+	schema, err := gojsonschema.NewSchema(gojsonschema.NewGoLoader(GetWerfConfigSectionJSONSchema(definition)))
+	if err != nil {
+		return nil, fmt.Errorf("unable to load werf config schema: %s", err)
+	}
+	werfConfigSchemas[definition] = schema
+
+	return schema, nil
+}
+
+func validateDocBySchema(definition string, raw map[string]interface{}) ([]gojsonschema.ResultError, error) {
+	schema, err := getWerfConfigSectionSchema(definition)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := schema.Validate(gojsonschema.NewGoLoader(convertYamlValue(raw)))
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Errors(), nil
+}
+
+// convertYamlValue converts maps with interface{} keys to be marshalled as JSON objects.
+func convertYamlValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[interface{}]interface{}:
+		res := map[string]interface{}{}
+		for key, val := range v {
+			res[fmt.Sprint(key)] = convertYamlValue(val)
+		}
+		return res
+	case map[string]interface{}:
+		res := map[string]interface{}{}
+		for key, val := range v {
+			res[key] = convertYamlValue(val)
+		}
+		return res
+	case []interface{}:
+		var res []interface{}
+		for _, val := range v {
+			res = append(res, convertYamlValue(val))
+		}
+		return res
+	default:
+		return v
+	}
+}
+
+func getSchemaErrorPath(err gojsonschema.ResultError) []string {
+	const delimiter = "\x00"
+
+	var path []string
+	for _, part := range strings.Split(err.Context().String(delimiter), delimiter)[1:] {
+		path = append(path, part)
+	}
+
+	if err.Type() == "additional_property_not_allowed" {
+		if property, ok := err.Details()["property"].(string); ok {
+			path = append(path, property)
+		}
+	}
+
+	return path
+}
+
+func hasKey(m map[string]interface{}, key string) bool {
+	_, ok := m[key]
+	return ok
+}
+
+type yamlPathLinesFrame struct {
+	indent     int
+	path       []string
+	isSeqItem  bool
+	itemsCount int
+}
+
+var yamlKeyRegexp = regexp.MustCompile(`^("[^"]*"|'[^']*'|[^\s#'"{\[][^:#]*?)\s*:(\s+(.*))?$`)
+
+// getYamlPathsLines returns 0-based lines of mapping keys and sequence items of the YAML document in the block style,
+// paths are joined with dots, e.g. git.0.add, the empty path is the first line with the content.
+func getYamlPathsLines(content []byte) map[string]int {
+	pathsLines := map[string]int{}
+	stack := []*yamlPathLinesFrame{{indent: -1}}
+	blockScalarIndent := -1
+
+	for lineNumber, line := range strings.Split(string(content), "\n") {
+		text := strings.TrimLeft(line, " ")
+		indent := len(line) - len(text)
+
+		if strings.TrimSpace(text) == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+
+		if _, hasKey := pathsLines[""]; !hasKey {
+			pathsLines[""] = lineNumber
+		}
+
+		if blockScalarIndent != -1 {
+			if indent > blockScalarIndent {
+				continue
+			}
+			blockScalarIndent = -1
+		}
+
+		for text == "-" || strings.HasPrefix(text, "- ") {
+			for len(stack) > 1 && (stack[len(stack)-1].indent > indent || (stack[len(stack)-1].indent == indent && stack[len(stack)-1].isSeqItem)) {
+				stack = stack[:len(stack)-1]
+			}
+
+			parent := stack[len(stack)-1]
+			path := append(append([]string{}, parent.path...), strconv.Itoa(parent.itemsCount))
+			parent.itemsCount++
+
+			stack = append(stack, &yamlPathLinesFrame{indent: indent, path: path, isSeqItem: true})
+			pathsLines[strings.Join(path, ".")] = lineNumber
+
+			rest := strings.TrimLeft(strings.TrimPrefix(text, "-"), " ")
+			indent += len(text) - len(rest)
+			text = rest
+		}
+
+		match := yamlKeyRegexp.FindStringSubmatch(text)
+		if match == nil {
+			continue
+		}
+
+		for len(stack) > 1 && stack[len(stack)-1].indent >= indent {
+			stack = stack[:len(stack)-1]
+		}
+
+		key := strings.Trim(match[1], `"'`)
+		path := append(append([]string{}, stack[len(stack)-1].path...), key)
+		stack = append(stack, &yamlPathLinesFrame{indent: indent, path: path})
+		pathsLines[strings.Join(path, ".")] = lineNumber
+
+		if value := strings.TrimSpace(match[3]); strings.HasPrefix(value, "|") || strings.HasPrefix(value, ">") {
+			blockScalarIndent = indent
+		}
+	}
+
+	return pathsLines
+}
+
+// findYamlPathLine returns the line of the path or the closest parent path.
+func findYamlPathLine(pathsLines map[string]int, path []string) int {
+	for i := len(path); i >= 0; i-- {
+		if line, hasKey := pathsLines[strings.Join(path[:i], ".")]; hasKey {
+			return line
+		}
+	}
+
+	return 0
+}
+
+// mapRenderedLinesToTemplateLines matches lines of the rendered config with the same lines of the template
+// and returns 1-based template lines by 1-based rendered lines. Lines produced by template actions are not matched.
+//
+// Lines which occur once in both texts are matched first (the longest sequence of such lines in the same order is used),
+// then lines between them are matched in order.
+func mapRenderedLinesToTemplateLines(template, rendered string) map[int]int {
+	templateLines := normalizeLines(template)
+	renderedLines := normalizeLines(rendered)
+
+	templateCount := map[string]int{}
+	templateIndex := map[string]int{}
+	for ind, line := range templateLines {
+		templateCount[line]++
+		templateIndex[line] = ind
+	}
+
+	renderedCount := map[string]int{}
+	for _, line := range renderedLines {
+		renderedCount[line]++
+	}
+
+	var uniquePairs [][2]int
+	for ind, line := range renderedLines {
+		if line != "" && renderedCount[line] == 1 && templateCount[line] == 1 {
+			uniquePairs = append(uniquePairs, [2]int{ind, templateIndex[line]})
+		}
+	}
+
+	anchors := longestIncreasingPairs(uniquePairs)
+	anchors = append(anchors, [2]int{len(renderedLines), len(templateLines)})
+
+	res := map[int]int{}
+	renderedFrom, templateFrom := 0, 0
+	for _, anchor := range anchors {
+		cursor := templateFrom
+		for renderedInd := renderedFrom; renderedInd < anchor[0]; renderedInd++ {
+			if renderedLines[renderedInd] == "" {
+				continue
+			}
+
+			for templateInd := cursor; templateInd < anchor[1]; templateInd++ {
+				if templateLines[templateInd] == renderedLines[renderedInd] {
+					res[renderedInd+1] = templateInd + 1
+					cursor = templateInd + 1
+					break
+				}
+			}
+		}
+
+		if anchor[0] < len(renderedLines) {
+			res[anchor[0]+1] = anchor[1] + 1
+		}
+
+		renderedFrom, templateFrom = anchor[0]+1, anchor[1]+1
+	}
+
+	return res
+}
+
+// normalizeLines splits the text by lines, trailing spaces are trimmed and separators of YAML documents are omitted.
+func normalizeLines(text string) []string {
+	lines := strings.Split(text, "\n")
+	for ind, line := range lines {
+		line = strings.TrimRight(line, " \r\t")
+		if strings.TrimSpace(line) == "---" {
+			line = ""
+		}
+		lines[ind] = line
+	}
+
+	return lines
+}
+
+// longestIncreasingPairs returns the longest subsequence of pairs sorted by the first element
+// in which the second elements are increasing.
+func longestIncreasingPairs(pairs [][2]int) [][2]int {
+	if len(pairs) == 0 {
+		return nil
+	}
+
+	// tails[k] is the index of the pair ending the increasing subsequence of length k+1 with the least second element
+	var tails []int
+	prev := make([]int, len(pairs))
+	for ind, pair := range pairs {
+		k := sort.Search(len(tails), func(i int) bool {
+			return pairs[tails[i]][1] >= pair[1]
+		})
+
+		if k > 0 {
+			prev[ind] = tails[k-1]
+		} else {
+			prev[ind] = -1
+		}
+
+		if k == len(tails) {
+			tails = append(tails, ind)
+		} else {
+			tails[k] = ind
+		}
+	}
+
+	res := make([][2]int, len(tails))
+	for ind, k := tails[len(tails)-1], len(tails)-1; k >= 0; ind, k = prev[ind], k-1 {
+		res[k] = pairs[ind]
+	}
+
+	return res
+}
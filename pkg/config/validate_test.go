@@ -0,0 +1,237 @@
+package config
+
+import (
+	"errors"
+	"strings"
+	"sync"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"gopkg.in/yaml.v2"
+)
+
+var _ = Describe("werf.yaml validation", func() {
+	DescribeTable("longestIncreasingPairs",
+		func(pairs, expected [][2]int) {
+			Ω(longestIncreasingPairs(pairs)).Should(Equal(expected))
+		},
+		Entry("empty", nil, nil),
+		Entry("single pair", [][2]int{{0, 5}}, [][2]int{{0, 5}}),
+		Entry("increasing", [][2]int{{0, 1}, {1, 2}, {2, 3}}, [][2]int{{0, 1}, {1, 2}, {2, 3}}),
+		Entry("decreasing", [][2]int{{0, 3}, {1, 2}, {2, 1}}, [][2]int{{2, 1}}),
+		Entry("moved line", [][2]int{{0, 0}, {1, 4}, {2, 1}, {3, 2}, {4, 3}}, [][2]int{{0, 0}, {2, 1}, {3, 2}, {4, 3}}),
+		Entry("swapped blocks", [][2]int{{0, 3}, {1, 4}, {2, 5}, {3, 0}, {4, 1}}, [][2]int{{0, 3}, {1, 4}, {2, 5}}),
+	)
+
+	DescribeTable("mapRenderedLinesToTemplateLines",
+		func(template, rendered string, expected map[int]int) {
+			Ω(mapRenderedLinesToTemplateLines(template, rendered)).Should(Equal(expected))
+		},
+		Entry("same text",
+			"project: app\nconfigVersion: 1\n",
+			"project: app\nconfigVersion: 1\n",
+			map[int]int{1: 1, 2: 2},
+		),
+		Entry("lines of template actions and repeated lines of loops are not matched",
+			`project: app
+configVersion: 1
+---
+{{ range $name := list "a" "b" }}
+image: {{ $name }}
+from: alpine
+---
+{{ end }}
+image: c
+from: ubuntu
+`,
+			`project: app
+configVersion: 1
+---
+
+image: a
+from: alpine
+---
+
+image: b
+from: alpine
+---
+
+image: c
+from: ubuntu
+`,
+			map[int]int{1: 1, 2: 2, 6: 6, 13: 9, 14: 10},
+		),
+		Entry("repeated lines are matched in order between unique lines",
+			`image: a
+shell:
+  install:
+  - apt-get update
+---
+image: b
+shell:
+  install:
+  - apt-get update
+`,
+			`image: a
+shell:
+  install:
+  - apt-get update
+---
+image: b
+shell:
+  install:
+  - apt-get update
+`,
+			map[int]int{1: 1, 2: 2, 3: 3, 4: 4, 6: 6, 7: 7, 8: 8, 9: 9},
+		),
+		Entry("trailing spaces and CRLF are ignored",
+			"project: app  \r\nconfigVersion: 1\r\n",
+			"project: app\nconfigVersion: 1\n",
+			map[int]int{1: 1, 2: 2},
+		),
+		Entry("included lines are not matched",
+			"project: app\n{{ include \"meta\" . }}\n",
+			"project: app\nconfigVersion: 1\n",
+			map[int]int{1: 1},
+		),
+	)
+
+	It("getYamlPathsLines should return lines of mapping keys and sequence items", func() {
+		content := `# comment
+image: app
+from: alpine
+git:
+- add: /app
+  to: /app
+  stageDependencies:
+    install:
+    - package.json
+    - "*.lock"
+- url: https://github.com/company/repo.git
+  add: /
+shell:
+  install: |
+    key: not a key
+    - not an item
+  setup:
+  -   - nested
+"quoted key": value
+'single': value
+empty:
+`
+
+		Ω(getYamlPathsLines([]byte(content))).Should(Equal(map[string]int{
+			"":                                  1,
+			"image":                             1,
+			"from":                              2,
+			"git":                               3,
+			"git.0":                             4,
+			"git.0.add":                         4,
+			"git.0.to":                          5,
+			"git.0.stageDependencies":           6,
+			"git.0.stageDependencies.install":   7,
+			"git.0.stageDependencies.install.0": 8,
+			"git.0.stageDependencies.install.1": 9,
+			"git.1":                             10,
+			"git.1.url":                         10,
+			"git.1.add":                         11,
+			"shell":                             12,
+			"shell.install":                     13,
+			"shell.setup":                       16,
+			"shell.setup.0":                     17,
+			"shell.setup.0.0":                   17,
+			"quoted key":                        18,
+			"single":                            19,
+			"empty":                             20,
+		}))
+	})
+
+	It("findYamlPathLine should return the line of the closest parent path", func() {
+		pathsLines := map[string]int{"": 1, "git": 3, "git.0": 4}
+
+		Ω(findYamlPathLine(pathsLines, []string{"git", "0"})).Should(Equal(4))
+		Ω(findYamlPathLine(pathsLines, []string{"git", "0", "to"})).Should(Equal(4))
+		Ω(findYamlPathLine(pathsLines, []string{"shell", "install"})).Should(Equal(1))
+		Ω(findYamlPathLine(map[string]int{}, []string{"git"})).Should(Equal(0))
+	})
+
+	Context("addDocError", func() {
+		var v *configValidator
+		var d *doc
+
+		BeforeEach(func() {
+			v = &configValidator{
+				renderedFilePath: "werf.render.yaml",
+				configPath:       "werf.yaml",
+				configLines:      map[int]int{6: 4},
+			}
+			d = &doc{Content: []byte("\n# comment\nimage: app\nfrom: alpine\n"), Line: 3}
+		})
+
+		It("should relate the error to the first line of the section", func() {
+			v.addDocError(d, errors.New("  error message\n"))
+
+			Ω(v.errors).Should(HaveLen(1))
+			Ω(*v.errors[0]).Should(Equal(ValidationError{
+				Message:          "error message",
+				RenderedFilePath: "werf.render.yaml",
+				RenderedLine:     6,
+				ConfigPath:       "werf.yaml",
+				ConfigLine:       4,
+			}))
+			Ω(v.errors[0].String()).Should(Equal("werf.yaml:4 (werf.render.yaml:6): error message"))
+		})
+
+		It("should use the message of the detailed config error without the dump of the section", func() {
+			v.addDocError(d, newDetailedConfigError("invalid directive", map[string]string{"image": "app"}, d))
+
+			Ω(v.errors).Should(HaveLen(1))
+			Ω(v.errors[0].Message).Should(Equal("invalid directive"))
+			Ω(v.errors[0].RenderedLine).Should(Equal(6))
+		})
+
+		It("should use the line specified in the message", func() {
+			var raw map[string]interface{}
+			err := yaml.UnmarshalStrict([]byte("image: app\nimage: app\n"), &raw)
+			Ω(err).Should(HaveOccurred())
+
+			v.addDocError(d, newYamlUnmarshalError(err, d))
+
+			Ω(v.errors).Should(HaveLen(1))
+			Ω(v.errors[0].Message).Should(ContainSubstring("line 5"))
+			Ω(v.errors[0].RenderedLine).Should(Equal(5))
+			Ω(v.errors[0].ConfigLine).Should(Equal(0))
+			Ω(v.errors[0].String()).Should(HavePrefix("werf.render.yaml:5: "))
+		})
+	})
+
+	It("validateDocBySchema should be safe for concurrent use", func() {
+		var wg sync.WaitGroup
+		errorsCh := make(chan error, 20)
+		for i := 0; i < cap(errorsCh); i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				definition := []string{SchemaImageDefinition, SchemaArtifactDefinition, SchemaMetaDefinition, SchemaDockerfileImageDefinition}[i%4]
+				_, err := validateDocBySchema(definition, map[string]interface{}{"unknown": true})
+				errorsCh <- err
+			}(i)
+		}
+		wg.Wait()
+		close(errorsCh)
+
+		for err := range errorsCh {
+			Ω(err).ShouldNot(HaveOccurred())
+		}
+
+		schemaErrors, err := validateDocBySchema(SchemaImageDefinition, map[string]interface{}{"image": "app", "from": "alpine", "unknown": true})
+		Ω(err).ShouldNot(HaveOccurred())
+
+		var messages []string
+		for _, schemaErr := range schemaErrors {
+			messages = append(messages, strings.Join(getSchemaErrorPath(schemaErr), ".")+": "+schemaErr.Description())
+		}
+		Ω(messages).Should(ContainElement(ContainSubstring("unknown")))
+	})
+})
@@ -0,0 +1,166 @@
+package config
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	"helm.sh/helm/v3/pkg/strvals"
+	"sigs.k8s.io/yaml"
+
+	"github.com/werf/werf/pkg/git_repo"
+	"github.com/werf/werf/pkg/giterminism_inspector"
+	"github.com/werf/werf/pkg/util"
+)
+
+const werfConfigValuesFileName = "values.yaml"
+
+// getWerfConfigValues returns .Values of werf.yaml templates: values.yaml of the templates dir is merged with
+// values files and then with set values in the specified order, the latter values take precedence.
+func getWerfConfigValues(ctx context.Context, projectDir, werfConfigTemplatesDir string, localGitRepo *git_repo.Local, commit string, opts WerfConfigOptions) (map[string]interface{}, error) {
+	values := map[string]interface{}{}
+
+	if data, err := readWerfConfigValuesFile(ctx, projectDir, filepath.Join(werfConfigTemplatesDir, werfConfigValuesFileName), localGitRepo, commit); err != nil {
+		return nil, err
+	} else if data != nil {
+		if err := mergeWerfConfigValuesData(values, data); err != nil {
+			return nil, fmt.Errorf("unable to parse %s: %s", filepath.Join(werfConfigTemplatesDir, werfConfigValuesFileName), err)
+		}
+	}
+
+	for _, path := range opts.ConfigValuesFiles {
+		data, err := readWerfConfigValuesOptionFile(ctx, projectDir, path, localGitRepo, commit)
+		if err != nil {
+			return nil, err
+		} else if data == nil {
+			continue
+		}
+
+		if err := mergeWerfConfigValuesData(values, data); err != nil {
+			return nil, fmt.Errorf("unable to parse config values file %s: %s", path, err)
+		}
+	}
+
+	for _, value := range opts.ConfigSetValues {
+		if !giterminism_inspector.LooseGiterminism {
+			setValues := map[string]interface{}{}
+			if err := strvals.ParseInto(value, setValues); err != nil {
+				return nil, fmt.Errorf("unable to parse config set value %q: %s", value, err)
+			}
+
+			for _, key := range util.GetValuesKeys(setValues) {
+				if err := giterminism_inspector.ReportConfigGoTemplateRenderingSetValue(ctx, key); err != nil {
+					return nil, err
+				}
+			}
+		}
+
+		if err := strvals.ParseInto(value, values); err != nil {
+			return nil, fmt.Errorf("unable to parse config set value %q: %s", value, err)
+		}
+	}
+
+	return values, nil
+}
+
+// readWerfConfigValuesFile returns nil if the values file does not exist.
+func readWerfConfigValuesFile(ctx context.Context, projectDir, relPath string, localGitRepo *git_repo.Local, commit string) ([]byte, error) {
+	if giterminism_inspector.LooseGiterminism || localGitRepo == nil {
+		path := filepath.Join(projectDir, relPath)
+		if exists, err := util.RegularFileExists(path); err != nil {
+			return nil, fmt.Errorf("unable to check existence of %s: %s", path, err)
+		} else if !exists {
+			return nil, nil
+		}
+
+		return ioutil.ReadFile(path)
+	}
+
+	if exists, err := localGitRepo.IsCommitFileExists(ctx, commit, filepath.ToSlash(relPath)); err != nil {
+		return nil, fmt.Errorf("unable to check existence of %s in the local git repo commit %s: %s", relPath, commit, err)
+	} else if !exists {
+		if exists, err := util.RegularFileExists(filepath.Join(projectDir, relPath)); err != nil {
+			return nil, fmt.Errorf("unable to check existence of %s: %s", relPath, err)
+		} else if exists {
+			if err := giterminism_inspector.ReportUntrackedFile(ctx, relPath); err != nil {
+				return nil, err
+			}
+		}
+
+		return nil, nil
+	}
+
+	return git_repo.ReadCommitFileAndCompareWithProjectFile(ctx, localGitRepo, commit, projectDir, relPath)
+}
+
+// readWerfConfigValuesOptionFile reads the values file specified with --config-values, the relative path is resolved against the project dir.
+// The file allowed by the giterminism config is read from the filesystem, otherwise the file should be committed.
+// Nil is returned for the uncommitted file which has been reported in the giterminism check mode.
+func readWerfConfigValuesOptionFile(ctx context.Context, projectDir, path string, localGitRepo *git_repo.Local, commit string) ([]byte, error) {
+	absPath := path
+	if !filepath.IsAbs(absPath) {
+		absPath = filepath.Join(projectDir, path)
+	}
+	relPath := util.GetRelativeToBaseFilepath(projectDir, absPath)
+
+	isAccepted := giterminism_inspector.LooseGiterminism || localGitRepo == nil
+	if !isAccepted {
+		var err error
+		if isAccepted, err = giterminism_inspector.IsConfigGoTemplateRenderingValuesFileAccepted(relPath); err != nil {
+			return nil, err
+		}
+	}
+
+	if isAccepted {
+		data, err := ioutil.ReadFile(absPath)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read config values file %s: %s", path, err)
+		}
+
+		return data, nil
+	}
+
+	isExternal := filepath.ToSlash(relPath) == ".." || strings.HasPrefix(filepath.ToSlash(relPath), "../")
+	if !isExternal {
+		if exists, err := localGitRepo.IsCommitFileExists(ctx, commit, filepath.ToSlash(relPath)); err != nil {
+			return nil, fmt.Errorf("unable to check existence of %s in the local git repo commit %s: %s", relPath, commit, err)
+		} else if exists {
+			return git_repo.ReadCommitFileAndCompareWithProjectFile(ctx, localGitRepo, commit, projectDir, relPath)
+		}
+	}
+
+	if exists, err := util.RegularFileExists(absPath); err != nil {
+		return nil, fmt.Errorf("unable to check existence of %s: %s", absPath, err)
+	} else if !exists {
+		return nil, fmt.Errorf("config values file %s not found", path)
+	}
+
+	return nil, giterminism_inspector.ReportConfigGoTemplateRenderingValuesFile(ctx, relPath)
+}
+
+func mergeWerfConfigValuesData(values map[string]interface{}, data []byte) error {
+	fileValues := map[string]interface{}{}
+	if err := yaml.Unmarshal(data, &fileValues); err != nil {
+		return err
+	}
+
+	mergeWerfConfigValues(values, fileValues)
+
+	return nil
+}
+
+// mergeWerfConfigValues merges maps recursively, other values of src override values of dst.
+func mergeWerfConfigValues(dst, src map[string]interface{}) {
+	for key, value := range src {
+		if valueMap, ok := value.(map[string]interface{}); ok {
+			if dstValueMap, ok := dst[key].(map[string]interface{}); ok {
+				mergeWerfConfigValues(dstValueMap, valueMap)
+				continue
+			}
+		}
+
+		dst[key] = value
+	}
+}
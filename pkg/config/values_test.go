@@ -0,0 +1,267 @@
+package config
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/werf/werf/pkg/git_repo"
+	"github.com/werf/werf/pkg/giterminism_inspector"
+	"github.com/werf/werf/pkg/true_git"
+	"github.com/werf/werf/pkg/werf"
+)
+
+var _ = Describe("werf.yaml templates .Values", func() {
+	It("should merge maps recursively, other values should be overridden", func() {
+		dst := map[string]interface{}{
+			"image": map[string]interface{}{"repo": "alpine", "tag": "3.12"},
+			"list":  []interface{}{"a", "b"},
+			"map":   map[string]interface{}{"key": "value"},
+			"keep":  "value",
+		}
+
+		mergeWerfConfigValues(dst, map[string]interface{}{
+			"image": map[string]interface{}{"tag": "3.13", "pullPolicy": "Always"},
+			"list":  []interface{}{"c"},
+			"map":   "scalar",
+			"new":   map[string]interface{}{"key": "value"},
+		})
+
+		Ω(dst).Should(Equal(map[string]interface{}{
+			"image": map[string]interface{}{"repo": "alpine", "tag": "3.13", "pullPolicy": "Always"},
+			"list":  []interface{}{"c"},
+			"map":   "scalar",
+			"keep":  "value",
+			"new":   map[string]interface{}{"key": "value"},
+		}))
+	})
+
+	Context("getWerfConfigValues", func() {
+		var projectDir string
+		var looseGiterminism bool
+
+		writeFile := func(relPath, content string) {
+			path := filepath.Join(projectDir, relPath)
+			Ω(os.MkdirAll(filepath.Dir(path), 0755)).Should(Succeed())
+			Ω(ioutil.WriteFile(path, []byte(content), 0644)).Should(Succeed())
+		}
+
+		BeforeEach(func() {
+			var err error
+			projectDir, err = ioutil.TempDir("", "werf-config-values-")
+			Ω(err).ShouldNot(HaveOccurred())
+
+			looseGiterminism = giterminism_inspector.LooseGiterminism
+			giterminism_inspector.LooseGiterminism = true
+		})
+
+		AfterEach(func() {
+			giterminism_inspector.LooseGiterminism = looseGiterminism
+			Ω(os.RemoveAll(projectDir)).Should(Succeed())
+		})
+
+		It("should merge values.yaml of the templates dir, values files and set values in order", func() {
+			writeFile(".werf/values.yaml", "replicas: 1\nimage:\n  repo: alpine\n  tag: \"3.12\"\nenv: default\n")
+			writeFile("ci/values.yaml", "replicas: 2\nimage:\n  tag: \"3.13\"\n")
+			writeFile("ci/production.yaml", "env: production\nreplicas: 3\n")
+
+			values, err := getWerfConfigValues(context.Background(), projectDir, ".werf", nil, "", WerfConfigOptions{
+				ConfigValuesFiles: []string{"ci/values.yaml", filepath.Join(projectDir, "ci/production.yaml")},
+				ConfigSetValues:   []string{"image.tag=3.14", "replicas=4"},
+			})
+			Ω(err).ShouldNot(HaveOccurred())
+
+			Ω(values).Should(Equal(map[string]interface{}{
+				"replicas": int64(4),
+				"image":    map[string]interface{}{"repo": "alpine", "tag": "3.14"},
+				"env":      "production",
+			}))
+		})
+
+		It("should resolve values files paths against the project dir", func() {
+			writeFile("ci/values.yaml", "env: ci\n")
+
+			values, err := getWerfConfigValues(context.Background(), projectDir, ".werf", nil, "", WerfConfigOptions{
+				ConfigValuesFiles: []string{"ci/values.yaml"},
+			})
+			Ω(err).ShouldNot(HaveOccurred())
+			Ω(values).Should(Equal(map[string]interface{}{"env": "ci"}))
+		})
+
+		It("should return empty values without values.yaml of the templates dir", func() {
+			values, err := getWerfConfigValues(context.Background(), projectDir, ".werf", nil, "", WerfConfigOptions{})
+			Ω(err).ShouldNot(HaveOccurred())
+			Ω(values).Should(BeEmpty())
+		})
+
+		It("should fail if the values file does not exist", func() {
+			_, err := getWerfConfigValues(context.Background(), projectDir, ".werf", nil, "", WerfConfigOptions{
+				ConfigValuesFiles: []string{"ci/values.yaml"},
+			})
+			Ω(err).Should(HaveOccurred())
+		})
+	})
+
+	Context("getWerfConfigValues in the giterminism mode", func() {
+		var tmpDir, projectDir string
+		var localGitRepo *git_repo.Local
+		var commit string
+		var looseGiterminism bool
+
+		writeFile := func(relPath, content string) {
+			path := filepath.Join(projectDir, relPath)
+			Ω(os.MkdirAll(filepath.Dir(path), 0755)).Should(Succeed())
+			Ω(ioutil.WriteFile(path, []byte(content), 0644)).Should(Succeed())
+		}
+
+		git := func(args ...string) string {
+			cmd := exec.Command("git", append([]string{"-c", "user.name=werf", "-c", "user.email=werf@werf.io"}, args...)...)
+			cmd.Dir = projectDir
+			output, err := cmd.CombinedOutput()
+			Ω(err).ShouldNot(HaveOccurred(), string(output))
+			return strings.TrimSpace(string(output))
+		}
+
+		commitFiles := func() {
+			git("add", "-A")
+			git("commit", "--allow-empty", "-m", "+")
+
+			var err error
+			localGitRepo, err = git_repo.OpenLocalRepo("own", projectDir, false)
+			Ω(err).ShouldNot(HaveOccurred())
+
+			commit, err = localGitRepo.HeadCommit(context.Background())
+			Ω(err).ShouldNot(HaveOccurred())
+		}
+
+		initGiterminism := func(giterminismConfig string) {
+			if giterminismConfig != "" {
+				writeFile("werf-giterminism.yaml", "giterminismConfigVersion: \"1\"\n"+giterminismConfig)
+			}
+
+			Ω(giterminism_inspector.Init(projectDir, giterminism_inspector.InspectionOptions{})).Should(Succeed())
+		}
+
+		getValues := func(opts WerfConfigOptions) (map[string]interface{}, error) {
+			return getWerfConfigValues(context.Background(), projectDir, ".werf", localGitRepo, commit, opts)
+		}
+
+		BeforeEach(func() {
+			var err error
+			tmpDir, err = ioutil.TempDir("", "werf-config-values-giterminism-")
+			Ω(err).ShouldNot(HaveOccurred())
+
+			Ω(werf.Init(filepath.Join(tmpDir, "tmp"), filepath.Join(tmpDir, "home"))).Should(Succeed())
+			Ω(true_git.Init(true_git.Options{})).Should(Succeed())
+
+			projectDir = filepath.Join(tmpDir, "project")
+			Ω(os.MkdirAll(projectDir, 0755)).Should(Succeed())
+			git("init")
+
+			looseGiterminism = giterminism_inspector.LooseGiterminism
+			giterminism_inspector.LooseGiterminism = false
+			giterminism_inspector.ReportedUncommittedPaths = nil
+			giterminism_inspector.ReportedUntrackedPaths = nil
+		})
+
+		AfterEach(func() {
+			giterminism_inspector.CheckMode = false
+			giterminism_inspector.Violations = nil
+			// reset the giterminism config of the project
+			Ω(giterminism_inspector.Init(tmpDir, giterminism_inspector.InspectionOptions{LooseGiterminism: looseGiterminism})).Should(Succeed())
+			Ω(os.RemoveAll(tmpDir)).Should(Succeed())
+		})
+
+		It("should read committed values files from the commit", func() {
+			writeFile(".werf/values.yaml", "replicas: 1\nenv: default\n")
+			writeFile("ci/values.yaml", "replicas: 2\n")
+			commitFiles()
+			initGiterminism("")
+
+			values, err := getValues(WerfConfigOptions{ConfigValuesFiles: []string{"ci/values.yaml"}})
+			Ω(err).ShouldNot(HaveOccurred())
+			Ω(values).Should(Equal(map[string]interface{}{"replicas": float64(2), "env": "default"}))
+		})
+
+		It("should fail if the committed values file has uncommitted changes", func() {
+			writeFile("ci/values.yaml", "replicas: 2\n")
+			commitFiles()
+			initGiterminism("")
+			writeFile("ci/values.yaml", "replicas: 3\n")
+
+			_, err := getValues(WerfConfigOptions{ConfigValuesFiles: []string{"ci/values.yaml"}})
+			Ω(err).Should(MatchError(ContainSubstring("ci/values.yaml")))
+		})
+
+		It("should fail if values.yaml of the templates dir is not committed", func() {
+			commitFiles()
+			initGiterminism("")
+			writeFile(".werf/values.yaml", "replicas: 1\n")
+
+			_, err := getValues(WerfConfigOptions{})
+			Ω(err).Should(MatchError(ContainSubstring("restricted usage of untracked file .werf/values.yaml")))
+		})
+
+		It("should fail if the uncommitted values file is not allowed", func() {
+			commitFiles()
+			initGiterminism("")
+			writeFile("ci/values.yaml", "replicas: 2\n")
+
+			_, err := getValues(WerfConfigOptions{ConfigValuesFiles: []string{"ci/values.yaml"}})
+			Ω(err).Should(MatchError(ContainSubstring("config values file ci/values.yaml is not committed and forbidden")))
+		})
+
+		It("should read uncommitted values files allowed by allowValuesFiles from the filesystem", func() {
+			writeFile("ci/committed.yaml", "env: committed\n")
+			commitFiles()
+			initGiterminism("config:\n  goTemplateRendering:\n    allowValuesFiles:\n    - ci/committed.yaml\n    - /ci/local/*.yaml/\n")
+			writeFile("ci/committed.yaml", "env: changed\n")
+			writeFile("ci/local/values.yaml", "replicas: 2\n")
+
+			values, err := getValues(WerfConfigOptions{ConfigValuesFiles: []string{"ci/committed.yaml", filepath.Join(projectDir, "ci/local/values.yaml")}})
+			Ω(err).ShouldNot(HaveOccurred())
+			Ω(values).Should(Equal(map[string]interface{}{"replicas": float64(2), "env": "changed"}))
+		})
+
+		It("should allow all set values unless allowSetValues is specified", func() {
+			commitFiles()
+			initGiterminism("")
+
+			values, err := getValues(WerfConfigOptions{ConfigSetValues: []string{"image.tag=3.14"}})
+			Ω(err).ShouldNot(HaveOccurred())
+			Ω(values).Should(Equal(map[string]interface{}{"image": map[string]interface{}{"tag": "3.14"}}))
+		})
+
+		It("should allow only set values matched by allowSetValues", func() {
+			commitFiles()
+			initGiterminism("config:\n  goTemplateRendering:\n    allowSetValues:\n    - replicas\n    - /^image\\./\n")
+
+			values, err := getValues(WerfConfigOptions{ConfigSetValues: []string{"replicas=2", "image.tag=3.14"}})
+			Ω(err).ShouldNot(HaveOccurred())
+			Ω(values).Should(Equal(map[string]interface{}{"replicas": int64(2), "image": map[string]interface{}{"tag": "3.14"}}))
+
+			_, err = getValues(WerfConfigOptions{ConfigSetValues: []string{"env=production"}})
+			Ω(err).Should(MatchError(ContainSubstring("config set value env is forbidden")))
+		})
+
+		It("should collect violations in the check mode", func() {
+			commitFiles()
+			initGiterminism("config:\n  goTemplateRendering:\n    allowSetValues: []\n")
+			writeFile("ci/values.yaml", "replicas: 2\n")
+			giterminism_inspector.CheckMode = true
+
+			values, err := getValues(WerfConfigOptions{ConfigValuesFiles: []string{"ci/values.yaml"}, ConfigSetValues: []string{"env=production"}})
+			Ω(err).ShouldNot(HaveOccurred())
+			Ω(values).Should(Equal(map[string]interface{}{"env": "production"}))
+
+			Ω(giterminism_inspector.GetViolation(giterminism_inspector.ViolationConfigValuesFile, "ci/values.yaml")).ShouldNot(BeNil())
+			Ω(giterminism_inspector.GetViolation(giterminism_inspector.ViolationConfigSetValue, "env")).ShouldNot(BeNil())
+		})
+	})
+})
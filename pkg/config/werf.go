@@ -11,12 +11,50 @@ type WerfConfig struct {
 	StapelImages         []*StapelImage
 	ImagesFromDockerfile []*ImageFromDockerfile
 	Artifacts            []*StapelImageArtifact
+
+	// RemoteTemplates are commits of remote repositories werf config templates are imported from with importTemplates
+	RemoteTemplates []*RemoteTemplates
 }
 
 func (c *WerfConfig) HasImageOrArtifact(imageName string) bool {
 	return c.HasImage(imageName) || c.GetArtifact(imageName) != nil
 }
 
+func (c *WerfConfig) hasExtendedImages() bool {
+	for _, image := range c.StapelImages {
+		if image.raw.Extends != "" {
+			return true
+		}
+	}
+
+	for _, image := range c.Artifacts {
+		if image.raw.Extends != "" {
+			return true
+		}
+	}
+
+	return false
+}
+
+// getResolvedDocContent returns the content of the config section, sections of images with `extends` are returned with inherited directives.
+func (c *WerfConfig) getResolvedDocContent(d *doc) ([]byte, error) {
+	var rawImages []*rawStapelImage
+	for _, image := range c.StapelImages {
+		rawImages = append(rawImages, image.raw)
+	}
+	for _, image := range c.Artifacts {
+		rawImages = append(rawImages, image.raw)
+	}
+
+	for _, rawImage := range rawImages {
+		if rawImage.Extends != "" && rawImage.doc.Line == d.Line {
+			return rawImage.resolvedContent()
+		}
+	}
+
+	return d.Content, nil
+}
+
 func (c *WerfConfig) HasNamelessImage() bool {
 	return c.HasImage("")
 }
@@ -333,6 +371,25 @@ func (c *WerfConfig) imageDependenciesInOrder(images []ImageInterface) (imageDep
 	return imageDeps
 }
 
+// GetImageDependenciesNames returns names of images and artifacts which the image or artifact is based on or imports files from.
+func (c *WerfConfig) GetImageDependenciesNames(imageOrArtifactName string) []string {
+	var interf ImageInterface
+	if i := c.GetImage(imageOrArtifactName); i != nil {
+		interf = i
+	} else if a := c.GetArtifact(imageOrArtifactName); a != nil {
+		interf = a
+	} else {
+		return nil
+	}
+
+	var names []string
+	for _, dep := range c.imageDependencies(interf) {
+		names = append(names, dep.GetName())
+	}
+
+	return names
+}
+
 func (c *WerfConfig) imageDependencies(interf ImageInterface) (deps []ImageInterface) {
 	switch i := interf.(type) {
 	case StapelImageInterface:
@@ -2,6 +2,7 @@ package container_runtime
 
 import (
 	"context"
+	"time"
 
 	"github.com/werf/werf/pkg/image"
 
@@ -11,6 +12,10 @@ import (
 type BuildOptions struct {
 	IntrospectBeforeError bool
 	IntrospectAfterError  bool
+
+	// Reproducible enables normalization of built image layers and config timestamps to the SourceDateEpoch
+	Reproducible    bool
+	SourceDateEpoch time.Time
 }
 
 type ImageInterface interface {
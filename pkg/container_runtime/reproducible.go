@@ -0,0 +1,302 @@
+package container_runtime
+
+import (
+	"archive/tar"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/docker/docker/api/types"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/tarball"
+
+	"github.com/werf/logboek"
+
+	"github.com/werf/werf/pkg/docker"
+	"github.com/werf/werf/pkg/util"
+	"github.com/werf/werf/pkg/werf"
+)
+
+// NormalizeImage rewrites layers which are not inherited from the fromImage so that all files have sourceDateEpoch mtime,
+// resets image config and history timestamps and drops host-dependent config fields.
+// Normalized image is loaded into the local docker server without tags, the id of the new image is returned.
+//
+// Layers are never kept in memory: the saved image and normalized layers are streamed through files of the tmp dir.
+// Inherited layers are neither read nor sent back to the docker server, it already has them.
+func NormalizeImage(ctx context.Context, builtId string, fromImageInspect *types.ImageInspect, sourceDateEpoch time.Time) (string, error) {
+	tmpDir, err := ioutil.TempDir(werf.GetTmpDir(), "werf-reproducible-")
+	if err != nil {
+		return "", fmt.Errorf("unable to create tmp dir: %s", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	savedImagePath := filepath.Join(tmpDir, "image.tar")
+	if err := saveImageToFile(ctx, builtId, savedImagePath); err != nil {
+		return "", fmt.Errorf("unable to save image %s: %s", builtId, err)
+	}
+
+	img, err := tarball.ImageFromPath(savedImagePath, nil)
+	if err != nil {
+		return "", fmt.Errorf("unable to open saved image %s: %s", builtId, err)
+	}
+
+	var inheritedDiffIDs []string
+	if fromImageInspect != nil {
+		inheritedDiffIDs = fromImageInspect.RootFS.Layers
+	}
+
+	normalized, err := normalizeImage(img, inheritedDiffIDs, sourceDateEpoch, tmpDir)
+	if err != nil {
+		return "", fmt.Errorf("unable to normalize image %s: %s", builtId, err)
+	}
+
+	if normalized.ID == builtId {
+		return builtId, nil
+	}
+
+	reader, writer := io.Pipe()
+	go func() {
+		writer.CloseWithError(normalized.writeArchive(writer))
+	}()
+
+	if err := docker.ImageLoad(ctx, reader); err != nil {
+		reader.CloseWithError(err)
+		return "", fmt.Errorf("unable to load normalized image: %s", err)
+	}
+
+	logboek.Context(ctx).Info().LogF("Image %s normalized into %s\n", builtId, normalized.ID)
+
+	return normalized.ID, nil
+}
+
+// ImageContentDigest is the digest of the image filesystem: it depends only on the layers content.
+func ImageContentDigest(inspect *types.ImageInspect) string {
+	return util.Sha256Hash(inspect.RootFS.Layers...)
+}
+
+func saveImageToFile(ctx context.Context, ref, path string) error {
+	rc, err := docker.ImageSave(ctx, ref)
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	if _, err := io.Copy(f, rc); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+type normalizedImage struct {
+	ID     string
+	Config []byte
+	Layers []*normalizedLayer
+}
+
+type normalizedLayer struct {
+	DiffID v1.Hash
+	// Path is the uncompressed layer tar, it is empty for the inherited layer
+	Path string
+}
+
+func normalizeImage(img v1.Image, inheritedDiffIDs []string, sourceDateEpoch time.Time, tmpDir string) (*normalizedImage, error) {
+	cf, err := img.ConfigFile()
+	if err != nil {
+		return nil, fmt.Errorf("unable to get image config: %s", err)
+	}
+
+	layers, err := img.Layers()
+	if err != nil {
+		return nil, fmt.Errorf("unable to get image layers: %s", err)
+	}
+
+	res := &normalizedImage{}
+	var newDiffIDs []v1.Hash
+	isInherited := true
+	for ind, layer := range layers {
+		diffID, err := layer.DiffID()
+		if err != nil {
+			return nil, err
+		}
+
+		// inherited layers are the prefix of the image layers, all layers after the first own layer are normalized
+		isInherited = isInherited && ind < len(inheritedDiffIDs) && inheritedDiffIDs[ind] == diffID.String()
+		if isInherited {
+			res.Layers = append(res.Layers, &normalizedLayer{DiffID: diffID})
+			newDiffIDs = append(newDiffIDs, diffID)
+			continue
+		}
+
+		newLayer, err := normalizeLayer(layer, sourceDateEpoch, filepath.Join(tmpDir, fmt.Sprintf("layer-%d.tar", ind)))
+		if err != nil {
+			return nil, fmt.Errorf("unable to normalize layer %s: %s", diffID, err)
+		}
+
+		res.Layers = append(res.Layers, newLayer)
+		newDiffIDs = append(newDiffIDs, newLayer.DiffID)
+	}
+
+	cfg := cf.DeepCopy()
+	cfg.Created = v1.Time{Time: sourceDateEpoch}
+	cfg.Container = ""
+	cfg.DockerVersion = ""
+	cfg.Config.Hostname = ""
+	cfg.RootFS.DiffIDs = newDiffIDs
+
+	for ind := range cfg.History {
+		cfg.History[ind].Created = v1.Time{Time: sourceDateEpoch}
+	}
+
+	res.Config, err = json.Marshal(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	configHash := sha256.Sum256(res.Config)
+	res.ID = fmt.Sprintf("sha256:%s", hex.EncodeToString(configHash[:]))
+
+	return res, nil
+}
+
+// normalizeLayer writes the layer with normalized timestamps into the uncompressed tar file of the path
+func normalizeLayer(layer v1.Layer, sourceDateEpoch time.Time, path string) (*normalizedLayer, error) {
+	rc, err := layer.Uncompressed()
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+
+	f, err := os.Create(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	hash := sha256.New()
+	tw := tar.NewWriter(io.MultiWriter(f, hash))
+	tr := tar.NewReader(rc)
+
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("unable to read layer: %s", err)
+		}
+
+		header.ModTime = sourceDateEpoch
+		header.AccessTime = time.Time{}
+		header.ChangeTime = time.Time{}
+		header.Format = tar.FormatUnknown
+		for _, key := range []string{"mtime", "atime", "ctime"} {
+			delete(header.PAXRecords, key)
+		}
+
+		if err := tw.WriteHeader(header); err != nil {
+			return nil, fmt.Errorf("unable to write layer header: %s", err)
+		}
+
+		if _, err := io.Copy(tw, tr); err != nil {
+			return nil, fmt.Errorf("unable to write layer file %s: %s", header.Name, err)
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return nil, err
+	}
+
+	return &normalizedLayer{
+		DiffID: v1.Hash{Algorithm: "sha256", Hex: hex.EncodeToString(hash.Sum(nil))},
+		Path:   path,
+	}, nil
+}
+
+// writeArchive writes the image in the docker save format.
+// Files of inherited layers are omitted: docker load skips layers which already exist in the docker server without opening their files.
+func (img *normalizedImage) writeArchive(w io.Writer) error {
+	tw := tar.NewWriter(w)
+
+	configName := fmt.Sprintf("%s.json", img.ID[len("sha256:"):])
+	if err := writeArchiveFile(tw, configName, int64(len(img.Config)), func(w io.Writer) error {
+		_, err := w.Write(img.Config)
+		return err
+	}); err != nil {
+		return err
+	}
+
+	var layersNames []string
+	for _, layer := range img.Layers {
+		layerName := fmt.Sprintf("%s/layer.tar", layer.DiffID.Hex)
+		layersNames = append(layersNames, layerName)
+
+		if layer.Path == "" {
+			continue
+		}
+
+		info, err := os.Stat(layer.Path)
+		if err != nil {
+			return err
+		}
+
+		if err := writeArchiveFile(tw, layerName, info.Size(), func(w io.Writer) error {
+			f, err := os.Open(layer.Path)
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+
+			_, err = io.Copy(w, f)
+			return err
+		}); err != nil {
+			return err
+		}
+	}
+
+	manifest, err := json.Marshal([]map[string]interface{}{
+		{"Config": configName, "RepoTags": nil, "Layers": layersNames},
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := writeArchiveFile(tw, "manifest.json", int64(len(manifest)), func(w io.Writer) error {
+		_, err := w.Write(manifest)
+		return err
+	}); err != nil {
+		return err
+	}
+
+	return tw.Close()
+}
+
+func writeArchiveFile(tw *tar.Writer, name string, size int64, writeFunc func(w io.Writer) error) error {
+	if err := tw.WriteHeader(&tar.Header{
+		Name:     name,
+		Mode:     0644,
+		Size:     size,
+		Typeflag: tar.TypeReg,
+	}); err != nil {
+		return fmt.Errorf("unable to write %s: %s", name, err)
+	}
+
+	if err := writeFunc(tw); err != nil {
+		return fmt.Errorf("unable to write %s: %s", name, err)
+	}
+
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/werf/lockgate"
 	"github.com/werf/werf/pkg/werf"
@@ -120,6 +121,12 @@ func (i *StageImage) Build(ctx context.Context, options BuildOptions) error {
 		}
 	}
 
+	if options.Reproducible {
+		if err := i.normalize(ctx, options.SourceDateEpoch); err != nil {
+			return fmt.Errorf("unable to normalize built image: %s", err)
+		}
+	}
+
 	if inspect, err := i.LocalDockerServerRuntime.GetImageInspect(ctx, i.MustGetBuiltId()); err != nil {
 		return err
 	} else {
@@ -144,6 +151,48 @@ func (i *StageImage) Commit(ctx context.Context) error {
 	return nil
 }
 
+func (i *StageImage) normalize(ctx context.Context, sourceDateEpoch time.Time) error {
+	var fromImageInspect *types.ImageInspect
+	if i.fromImage != nil {
+		fromImageInspect = i.fromImage.GetInspect()
+		if fromImageInspect == nil {
+			if inspect, err := i.LocalDockerServerRuntime.GetImageInspect(ctx, i.fromImage.GetID()); err != nil {
+				return err
+			} else {
+				fromImageInspect = inspect
+			}
+		}
+	}
+
+	builtInspect, err := i.LocalDockerServerRuntime.GetImageInspect(ctx, i.MustGetBuiltId())
+	if err != nil {
+		return err
+	} else if builtInspect == nil {
+		return fmt.Errorf("built image %s not found", i.MustGetBuiltId())
+	}
+
+	normalizedId, err := NormalizeImage(ctx, builtInspect.ID, fromImageInspect, sourceDateEpoch)
+	if err != nil {
+		return err
+	} else if normalizedId == builtInspect.ID {
+		return nil
+	}
+
+	if i.dockerfileImageBuilder != nil {
+		if err := docker.CliTag(ctx, normalizedId, i.dockerfileImageBuilder.GetBuiltId()); err != nil {
+			return err
+		}
+	} else {
+		i.buildImage = newBuildImage(normalizedId, i.LocalDockerServerRuntime)
+	}
+
+	if err := docker.CliRmi(ctx, builtInspect.ID); err != nil {
+		return fmt.Errorf("unable to remove not normalized image %s: %s", builtInspect.ID, err)
+	}
+
+	return nil
+}
+
 func (i *StageImage) Introspect(ctx context.Context) error {
 	if err := i.container.introspect(ctx); err != nil {
 		return err
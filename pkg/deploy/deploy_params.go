@@ -0,0 +1,186 @@
+package deploy
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"text/template"
+
+	"helm.sh/helm/v3/pkg/chart/loader"
+	"helm.sh/helm/v3/pkg/cli"
+
+	"github.com/Masterminds/sprig/v3"
+
+	"github.com/werf/werf/pkg/config"
+	"github.com/werf/werf/pkg/deploy/werf_chart"
+	"github.com/werf/werf/pkg/git_repo"
+	"github.com/werf/werf/pkg/giterminism_inspector"
+	"github.com/werf/werf/pkg/slug"
+)
+
+func GetHelmRelease(releaseOption string, environmentOption string, werfConfig *config.WerfConfig) (string, error) {
+	if releaseOption != "" {
+		err := slug.ValidateHelmRelease(releaseOption)
+		if err != nil {
+			return "", fmt.Errorf("bad Helm release specified '%s': %s", releaseOption, err)
+		}
+		return releaseOption, nil
+	}
+
+	var releaseTemplate string
+	if werfConfig.Meta.Deploy.HelmRelease != nil {
+		releaseTemplate = *werfConfig.Meta.Deploy.HelmRelease
+	} else if environmentOption == "" {
+		releaseTemplate = "[[ project ]]"
+	} else {
+		releaseTemplate = "[[ project ]]-[[ env ]]"
+	}
+
+	renderedRelease, err := renderDeployParamTemplate("release", releaseTemplate, environmentOption, werfConfig)
+	if err != nil {
+		return "", fmt.Errorf("cannot render Helm release name by template '%s': %s", releaseTemplate, err)
+	}
+
+	if renderedRelease == "" {
+		return "", fmt.Errorf("Helm release rendered by template '%s' is empty: release name cannot be empty", releaseTemplate)
+	}
+
+	var helmReleaseSlug bool
+	if werfConfig.Meta.Deploy.HelmReleaseSlug != nil {
+		helmReleaseSlug = *werfConfig.Meta.Deploy.HelmReleaseSlug
+	} else {
+		helmReleaseSlug = true
+	}
+
+	if helmReleaseSlug {
+		return slug.HelmRelease(renderedRelease), nil
+	}
+
+	err = slug.ValidateHelmRelease(renderedRelease)
+	if err != nil {
+		return "", fmt.Errorf("bad Helm release '%s' rendered by template '%s': %s", renderedRelease, releaseTemplate, err)
+	}
+
+	return renderedRelease, nil
+}
+
+func GetKubernetesNamespace(namespaceOption string, environmentOption string, werfConfig *config.WerfConfig) (string, error) {
+	if namespaceOption != "" {
+		err := slug.ValidateKubernetesNamespace(namespaceOption)
+		if err != nil {
+			return "", fmt.Errorf("bad Kubernetes namespace specified '%s': %s", namespaceOption, err)
+		}
+		return namespaceOption, nil
+	}
+
+	var namespaceTemplate string
+	if werfConfig.Meta.Deploy.Namespace != nil {
+		namespaceTemplate = *werfConfig.Meta.Deploy.Namespace
+	} else if environmentOption == "" {
+		namespaceTemplate = "[[ project ]]"
+	} else {
+		namespaceTemplate = "[[ project ]]-[[ env ]]"
+	}
+
+	renderedNamespace, err := renderDeployParamTemplate("namespace", namespaceTemplate, environmentOption, werfConfig)
+	if err != nil {
+		return "", fmt.Errorf("cannot render Kubernetes namespace by template '%s': %s", namespaceTemplate, err)
+	}
+
+	if renderedNamespace == "" {
+		return "", fmt.Errorf("Kubernetes namespace rendered by template '%s' is empty: namespace cannot be empty", namespaceTemplate)
+	}
+
+	var namespaceSlug bool
+	if werfConfig.Meta.Deploy.NamespaceSlug != nil {
+		namespaceSlug = *werfConfig.Meta.Deploy.NamespaceSlug
+	} else {
+		namespaceSlug = true
+	}
+
+	if namespaceSlug {
+		return slug.KubernetesNamespace(renderedNamespace), nil
+	}
+
+	err = slug.ValidateKubernetesNamespace(renderedNamespace)
+	if err != nil {
+		return "", fmt.Errorf("bad Kubernetes namespace '%s' rendered by template '%s': %s", renderedNamespace, namespaceTemplate, err)
+	}
+
+	return renderedNamespace, nil
+}
+
+func renderDeployParamTemplate(templateName, templateText string, environmentOption string, werfConfig *config.WerfConfig) (string, error) {
+	tmpl := template.New(templateName).Delims("[[", "]]")
+
+	funcMap := sprig.TxtFuncMap()
+	delete(funcMap, "env")
+	delete(funcMap, "expandenv")
+
+	funcMap["project"] = func() string {
+		return werfConfig.Meta.Project
+	}
+
+	funcMap["env"] = func() (string, error) {
+		return environmentOption, nil
+	}
+
+	tmpl = tmpl.Funcs(funcMap)
+
+	tmpl, err := tmpl.Parse(templateText)
+	if err != nil {
+		return "", fmt.Errorf("bad template: %s", err)
+	}
+
+	buf := bytes.NewBuffer(nil)
+	if err := tmpl.ExecuteTemplate(buf, templateName, nil); err != nil {
+		return "", err
+	}
+
+	return buf.String(), nil
+}
+
+func MakeChartDirLoadFunc(ctx context.Context, localGitRepo *git_repo.Local, projectDir string) func(dir string) ([]*loader.BufferedFile, error) {
+	if giterminism_inspector.LooseGiterminism || localGitRepo == nil {
+		return nil
+	}
+	return func(dir string) ([]*loader.BufferedFile, error) {
+		return werf_chart.GiterministicFilesLoader(ctx, localGitRepo, projectDir, dir)
+	}
+}
+
+func MakeLocateChartFunc(ctx context.Context, localGitRepo *git_repo.Local, projectDir string) func(name string, settings *cli.EnvSettings) (string, error) {
+	if giterminism_inspector.LooseGiterminism || localGitRepo == nil {
+		return nil
+	}
+
+	return func(name string, settings *cli.EnvSettings) (string, error) {
+		commit, err := localGitRepo.HeadCommit(ctx)
+		if err != nil {
+			return "", fmt.Errorf("unable to get local repo head commit: %s", err)
+		}
+
+		if exists, err := localGitRepo.IsCommitDirectoryExists(ctx, name, commit); err != nil {
+			return "", fmt.Errorf("error checking existence of %q in the local git repo commit %s: %s", name, commit, err)
+		} else if exists {
+			return name, nil
+		} else {
+			return "", fmt.Errorf("chart path %q not found in the local git repo commit %s", name, commit)
+		}
+	}
+}
+
+func MakeHelmReadFileFunc(ctx context.Context, localGitRepo *git_repo.Local, projectDir string) func(filePath string) ([]byte, error) {
+	if giterminism_inspector.LooseGiterminism || localGitRepo == nil {
+		return nil
+	}
+
+	return func(filePath string) ([]byte, error) {
+		commit, err := localGitRepo.HeadCommit(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("unable to get local repo head commit: %s", err)
+		}
+
+		return werf_chart.ReadValuesFile(ctx, localGitRepo, commit, projectDir, filePath)
+	}
+}
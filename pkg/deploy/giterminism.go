@@ -0,0 +1,59 @@
+package deploy
+
+import (
+	"context"
+	"fmt"
+
+	"helm.sh/helm/v3/pkg/cli/values"
+	"helm.sh/helm/v3/pkg/strvals"
+
+	"github.com/werf/werf/pkg/git_repo"
+	"github.com/werf/werf/pkg/giterminism_inspector"
+	"github.com/werf/werf/pkg/util"
+)
+
+// ReportHelmSetValues reports keys of values specified with --set, --set-string and --set-file options to the giterminism inspector.
+func ReportHelmSetValues(ctx context.Context, opts values.Options, localGitRepo *git_repo.Local) error {
+	if giterminism_inspector.LooseGiterminism || localGitRepo == nil {
+		return nil
+	}
+
+	var setValuesList []map[string]interface{}
+	for _, value := range opts.Values {
+		setValues := map[string]interface{}{}
+		if err := strvals.ParseInto(value, setValues); err != nil {
+			return fmt.Errorf("failed parsing --set data: %s", err)
+		}
+		setValuesList = append(setValuesList, setValues)
+	}
+
+	for _, value := range opts.StringValues {
+		setValues := map[string]interface{}{}
+		if err := strvals.ParseIntoString(value, setValues); err != nil {
+			return fmt.Errorf("failed parsing --set-string data: %s", err)
+		}
+		setValuesList = append(setValuesList, setValues)
+	}
+
+	for _, value := range opts.FileValues {
+		setValues := map[string]interface{}{}
+		// files are not read, only keys are checked
+		reader := func(rs []rune) (interface{}, error) {
+			return string(rs), nil
+		}
+		if err := strvals.ParseIntoFile(value, setValues, reader); err != nil {
+			return fmt.Errorf("failed parsing --set-file data: %s", err)
+		}
+		setValuesList = append(setValuesList, setValues)
+	}
+
+	for _, setValues := range setValuesList {
+		for _, key := range util.GetValuesKeys(setValues) {
+			if err := giterminism_inspector.ReportHelmSetValue(ctx, key); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
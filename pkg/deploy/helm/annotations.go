@@ -6,6 +6,9 @@ const (
 	FailModeAnnoName                  = "werf.io/fail-mode"
 	FailuresAllowedPerReplicaAnnoName = "werf.io/failures-allowed-per-replica"
 
+	ReadyConditionAnnoName  = "werf.io/ready-condition"
+	FailedConditionAnnoName = "werf.io/failed-condition"
+
 	LogRegexAnnoName      = "werf.io/log-regex"
 	LogRegexForAnnoPrefix = "werf.io/log-regex-for-"
 
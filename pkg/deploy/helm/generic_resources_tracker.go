@@ -0,0 +1,397 @@
+package helm
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/werf/kubedog/pkg/trackers/rollout/multitrack"
+	"github.com/werf/logboek"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/cli-runtime/pkg/resource"
+	"k8s.io/client-go/dynamic"
+)
+
+const genericResourcePollPeriod = 2 * time.Second
+
+// genericResourceSpec describes the resource which readiness is tracked by status conditions (kstatus-style):
+//   - the resource is not ready until status.observedGeneration reaches metadata.generation;
+//   - the resource is failed if Stalled condition is True;
+//   - the resource is not ready if Reconciling condition is True;
+//   - the resource is ready if Ready condition is True or the resource has no Ready condition.
+//
+// The resource without Ready condition counts as ready as soon as it exists and its observedGeneration (if any) is up to date,
+// because it is not known whether the controller reports conditions at all.
+//
+// Ready and failed conditions can be redefined with werf.io/ready-condition and werf.io/failed-condition annotations.
+type genericResourceSpec struct {
+	Name                 string
+	Namespace            string
+	Kind                 string
+	GroupVersionResource schema.GroupVersionResource
+
+	ReadyCondition       *resourceCondition
+	FailedCondition      *resourceCondition
+	TrackTerminationMode multitrack.TrackTerminationMode
+	FailMode             multitrack.FailMode
+	AllowFailuresCount   int
+}
+
+func (spec *genericResourceSpec) String() string {
+	return fmt.Sprintf("%s/%s", strings.ToLower(spec.Kind), spec.Name)
+}
+
+// resourceCondition is the condition specified as TYPE or TYPE=STATUS, the status is True by default.
+type resourceCondition struct {
+	Type   string
+	Status string
+}
+
+func parseResourceCondition(value string) (*resourceCondition, error) {
+	parts := strings.SplitN(value, "=", 2)
+
+	c := &resourceCondition{Type: strings.TrimSpace(parts[0]), Status: string(metav1.ConditionTrue)}
+	if len(parts) == 2 {
+		c.Status = strings.TrimSpace(parts[1])
+	}
+
+	if c.Type == "" || c.Status == "" {
+		return nil, fmt.Errorf("TYPE or TYPE=STATUS expected")
+	}
+
+	return c, nil
+}
+
+func (c *resourceCondition) String() string {
+	return fmt.Sprintf("%s=%s", c.Type, c.Status)
+}
+
+// makeGenericResourceSpec returns nil if the resource should not be tracked by status conditions:
+// only custom resources and resources with werf.io/ready-condition annotation are tracked.
+func makeGenericResourceSpec(ctx context.Context, info *resource.Info) (*genericResourceSpec, error) {
+	if info.Mapping == nil {
+		return nil, nil
+	}
+
+	accessor, err := meta.Accessor(info.Object)
+	if err != nil {
+		return nil, nil
+	}
+
+	annotations := accessor.GetAnnotations()
+	if _, isUnstructured := asVersioned(info).(*unstructured.Unstructured); !isUnstructured {
+		if _, hasReadyCondition := annotations[ReadyConditionAnnoName]; !hasReadyCondition {
+			return nil, nil
+		}
+	}
+
+	spec, err := prepareGenericResourceSpec(info, annotations)
+	if err != nil {
+		logboek.Context(ctx).Warn().LogLn()
+		logboek.Context(ctx).Warn().LogF("WARNING %s\n", err)
+		return nil, nil
+	}
+
+	return spec, nil
+}
+
+func prepareGenericResourceSpec(info *resource.Info, annotations map[string]string) (*genericResourceSpec, error) {
+	spec := &genericResourceSpec{
+		Name:                 info.Name,
+		Namespace:            info.Namespace,
+		Kind:                 info.Mapping.GroupVersionKind.Kind,
+		GroupVersionResource: info.Mapping.Resource,
+		TrackTerminationMode: multitrack.WaitUntilResourceReady,
+		FailMode:             multitrack.FailWholeDeployProcessImmediately,
+	}
+
+	for annoName, annoValue := range annotations {
+		invalidAnnoValueError := fmt.Errorf("%s annotation %s with invalid value %s", spec, annoName, annoValue)
+
+		switch annoName {
+		case ReadyConditionAnnoName:
+			c, err := parseResourceCondition(annoValue)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %s", invalidAnnoValueError, err)
+			}
+
+			spec.ReadyCondition = c
+		case FailedConditionAnnoName:
+			c, err := parseResourceCondition(annoValue)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %s", invalidAnnoValueError, err)
+			}
+
+			spec.FailedCondition = c
+		case TrackTerminationModeAnnoName:
+			switch value := multitrack.TrackTerminationMode(annoValue); value {
+			case multitrack.WaitUntilResourceReady, multitrack.NonBlocking:
+				spec.TrackTerminationMode = value
+			default:
+				return nil, fmt.Errorf("%s: choose one of %v", invalidAnnoValueError, []multitrack.TrackTerminationMode{multitrack.WaitUntilResourceReady, multitrack.NonBlocking})
+			}
+		case FailModeAnnoName:
+			switch value := multitrack.FailMode(annoValue); value {
+			case multitrack.IgnoreAndContinueDeployProcess, multitrack.FailWholeDeployProcessImmediately, multitrack.HopeUntilEndOfDeployProcess:
+				spec.FailMode = value
+			default:
+				return nil, fmt.Errorf("%s: choose one of %v", invalidAnnoValueError, []multitrack.FailMode{multitrack.IgnoreAndContinueDeployProcess, multitrack.FailWholeDeployProcessImmediately, multitrack.HopeUntilEndOfDeployProcess})
+			}
+		case FailuresAllowedPerReplicaAnnoName:
+			intValue, err := strconv.Atoi(annoValue)
+			if err != nil || intValue < 0 {
+				return nil, fmt.Errorf("%s: positive or zero integer expected", invalidAnnoValueError)
+			}
+
+			spec.AllowFailuresCount = intValue
+		}
+	}
+
+	return spec, nil
+}
+
+type genericResourceStatus struct {
+	IsReady  bool
+	IsFailed bool
+	Message  string
+}
+
+func getGenericResourceStatus(obj *unstructured.Unstructured, spec *genericResourceSpec) genericResourceStatus {
+	if observedGeneration, found, err := unstructured.NestedInt64(obj.Object, "status", "observedGeneration"); err == nil && found && observedGeneration < obj.GetGeneration() {
+		return genericResourceStatus{Message: fmt.Sprintf("observed generation %d is behind generation %d", observedGeneration, obj.GetGeneration())}
+	}
+
+	conditions := getResourceConditions(obj)
+
+	failedCondition := spec.FailedCondition
+	if failedCondition == nil {
+		failedCondition = &resourceCondition{Type: "Stalled", Status: string(metav1.ConditionTrue)}
+	}
+
+	if c := findResourceCondition(conditions, failedCondition.Type); c != nil && c.Status == failedCondition.Status {
+		return genericResourceStatus{IsFailed: true, Message: c.String()}
+	}
+
+	if spec.ReadyCondition != nil {
+		c := findResourceCondition(conditions, spec.ReadyCondition.Type)
+		switch {
+		case c == nil:
+			return genericResourceStatus{Message: fmt.Sprintf("waiting for condition %s", spec.ReadyCondition)}
+		case c.Status == spec.ReadyCondition.Status:
+			return genericResourceStatus{IsReady: true, Message: c.String()}
+		default:
+			return genericResourceStatus{Message: fmt.Sprintf("waiting for condition %s: %s", spec.ReadyCondition, c)}
+		}
+	}
+
+	if c := findResourceCondition(conditions, "Reconciling"); c != nil && c.Status == string(metav1.ConditionTrue) {
+		return genericResourceStatus{Message: c.String()}
+	}
+
+	if c := findResourceCondition(conditions, "Ready"); c != nil {
+		return genericResourceStatus{IsReady: c.Status == string(metav1.ConditionTrue), Message: c.String()}
+	}
+
+	return genericResourceStatus{IsReady: true}
+}
+
+type resourceConditionStatus struct {
+	Type    string
+	Status  string
+	Reason  string
+	Message string
+}
+
+func (c *resourceConditionStatus) String() string {
+	res := fmt.Sprintf("%s=%s", c.Type, c.Status)
+	if c.Reason != "" {
+		res += fmt.Sprintf(" (%s)", c.Reason)
+	}
+	if c.Message != "" {
+		res += fmt.Sprintf(": %s", c.Message)
+	}
+
+	return res
+}
+
+func getResourceConditions(obj *unstructured.Unstructured) []*resourceConditionStatus {
+	rawConditions, found, err := unstructured.NestedSlice(obj.Object, "status", "conditions")
+	if err != nil || !found {
+		return nil
+	}
+
+	var conditions []*resourceConditionStatus
+	for _, rawCondition := range rawConditions {
+		m, ok := rawCondition.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		c := &resourceConditionStatus{}
+		c.Type, _, _ = unstructured.NestedString(m, "type")
+		c.Status, _, _ = unstructured.NestedString(m, "status")
+		c.Reason, _, _ = unstructured.NestedString(m, "reason")
+		c.Message, _, _ = unstructured.NestedString(m, "message")
+		conditions = append(conditions, c)
+	}
+
+	return conditions
+}
+
+func findResourceCondition(conditions []*resourceConditionStatus, conditionType string) *resourceConditionStatus {
+	for _, c := range conditions {
+		if c.Type == conditionType {
+			return c
+		}
+	}
+
+	return nil
+}
+
+// genericTrackingState counts blocking resources which are not ready yet (including the resources tracked by multitrack),
+// Done is closed when there are no such resources, so non-blocking resources and resources in the hope mode stop waiting.
+type genericTrackingState struct {
+	Done chan struct{}
+
+	mux     sync.Mutex
+	pending int
+}
+
+func newGenericTrackingState(pending int) *genericTrackingState {
+	s := &genericTrackingState{Done: make(chan struct{}), pending: pending}
+	if pending == 0 {
+		close(s.Done)
+	}
+
+	return s
+}
+
+func (s *genericTrackingState) Finish() {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	s.pending--
+	if s.pending == 0 {
+		close(s.Done)
+	}
+}
+
+// Resume returns false if the tracking is already done.
+func (s *genericTrackingState) Resume() bool {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	if s.pending == 0 {
+		return false
+	}
+
+	s.pending++
+	return true
+}
+
+type genericResourcesTracker struct {
+	Client               dynamic.Interface
+	StatusProgressPeriod time.Duration
+
+	logMux sync.Mutex
+}
+
+func (t *genericResourcesTracker) Track(ctx context.Context, spec *genericResourceSpec, state *genericTrackingState) error {
+	isBlocking := spec.TrackTerminationMode != multitrack.NonBlocking
+	isHoping := false
+	isFailed := false
+	failuresCount := 0
+	lastProgressTime := time.Now()
+
+	finish := func() {
+		if isBlocking && !isHoping {
+			state.Finish()
+		}
+	}
+
+	var lastStatus genericResourceStatus
+	for {
+		obj, err := t.Client.Resource(spec.GroupVersionResource).Namespace(spec.Namespace).Get(ctx, spec.Name, metav1.GetOptions{})
+		switch {
+		case errors.IsNotFound(err):
+			lastStatus = genericResourceStatus{Message: "resource not found"}
+		case err != nil && ctx.Err() == nil:
+			return fmt.Errorf("unable to get %s: %s", spec, err)
+		case err == nil:
+			lastStatus = getGenericResourceStatus(obj, spec)
+		}
+
+		switch {
+		case lastStatus.IsReady:
+			t.log(ctx, "%s is ready\n", spec)
+			finish()
+			return nil
+		case lastStatus.IsFailed:
+			if !isFailed {
+				isFailed = true
+				failuresCount++
+				t.warn(ctx, "%s failed: %s\n", spec, lastStatus.Message)
+
+				if failuresCount > spec.AllowFailuresCount {
+					switch spec.FailMode {
+					case multitrack.IgnoreAndContinueDeployProcess:
+						finish()
+						return nil
+					case multitrack.HopeUntilEndOfDeployProcess:
+						if !isHoping && isBlocking {
+							isHoping = true
+							state.Finish()
+						}
+					default:
+						return fmt.Errorf("%s failed: %s", spec, lastStatus.Message)
+					}
+				}
+			}
+		default:
+			if isFailed {
+				isFailed = false
+				if isHoping && state.Resume() {
+					isHoping = false
+				}
+			}
+		}
+
+		if t.StatusProgressPeriod > 0 && time.Since(lastProgressTime) >= t.StatusProgressPeriod {
+			t.log(ctx, "%s is not ready: %s\n", spec, lastStatus.Message)
+			lastProgressTime = time.Now()
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("%s is not ready: %s: %s", spec, lastStatus.Message, ctx.Err())
+		case <-state.Done:
+			if isHoping && isFailed {
+				return fmt.Errorf("%s failed: %s", spec, lastStatus.Message)
+			} else if !isBlocking || isHoping {
+				return nil
+			}
+		case <-time.After(genericResourcePollPeriod):
+		}
+	}
+}
+
+func (t *genericResourcesTracker) log(ctx context.Context, format string, a ...interface{}) {
+	t.logMux.Lock()
+	defer t.logMux.Unlock()
+
+	logboek.Context(ctx).Default().LogF(format, a...)
+}
+
+func (t *genericResourcesTracker) warn(ctx context.Context, format string, a ...interface{}) {
+	t.logMux.Lock()
+	defer t.logMux.Unlock()
+
+	logboek.Context(ctx).Warn().LogF(format, a...)
+}
@@ -0,0 +1,380 @@
+package helm
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/werf/kubedog/pkg/kube"
+	"github.com/werf/kubedog/pkg/trackers/rollout/multitrack"
+	"github.com/werf/logboek"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/cli-runtime/pkg/resource"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+)
+
+func newTestContext() context.Context {
+	return logboek.NewContext(context.Background(), logboek.NewLogger(ioutil.Discard, ioutil.Discard))
+}
+
+var certificateGVR = schema.GroupVersionResource{Group: "cert-manager.io", Version: "v1", Resource: "certificates"}
+
+func newCertificate(name string, generation int64, status map[string]interface{}) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "cert-manager.io/v1",
+		"kind":       "Certificate",
+		"metadata": map[string]interface{}{
+			"name":      name,
+			"namespace": "default",
+		},
+	}}
+	obj.SetGeneration(generation)
+
+	if status != nil {
+		obj.Object["status"] = status
+	}
+
+	return obj
+}
+
+func conditions(typeAndStatus ...string) map[string]interface{} {
+	var res []interface{}
+	for _, c := range typeAndStatus {
+		parts := strings.SplitN(c, "=", 2)
+		res = append(res, map[string]interface{}{"type": parts[0], "status": parts[1], "reason": "Reason", "message": "message"})
+	}
+
+	return map[string]interface{}{"conditions": res}
+}
+
+func TestParseResourceCondition(t *testing.T) {
+	tests := []struct {
+		value       string
+		expected    string
+		expectedErr bool
+	}{
+		{value: "Ready", expected: "Ready=True"},
+		{value: "Synced=False", expected: "Synced=False"},
+		{value: " Available = Unknown ", expected: "Available=Unknown"},
+		{value: "", expectedErr: true},
+		{value: "=True", expectedErr: true},
+		{value: "Ready=", expectedErr: true},
+	}
+
+	for _, tt := range tests {
+		c, err := parseResourceCondition(tt.value)
+		if tt.expectedErr {
+			if err == nil {
+				t.Errorf("%q: expected error, got %s", tt.value, c)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("%q: unexpected error: %s", tt.value, err)
+		} else if c.String() != tt.expected {
+			t.Errorf("%q: expected %s, got %s", tt.value, tt.expected, c)
+		}
+	}
+}
+
+func TestPrepareGenericResourceSpec(t *testing.T) {
+	info := &resource.Info{
+		Name:      "cert",
+		Namespace: "default",
+		Mapping: &meta.RESTMapping{
+			Resource:         certificateGVR,
+			GroupVersionKind: schema.GroupVersionKind{Group: "cert-manager.io", Version: "v1", Kind: "Certificate"},
+		},
+	}
+
+	spec, err := prepareGenericResourceSpec(info, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if spec.String() != "certificate/cert" || spec.GroupVersionResource != certificateGVR || spec.ReadyCondition != nil || spec.FailedCondition != nil ||
+		spec.TrackTerminationMode != multitrack.WaitUntilResourceReady || spec.FailMode != multitrack.FailWholeDeployProcessImmediately || spec.AllowFailuresCount != 0 {
+		t.Errorf("unexpected default spec: %#v", spec)
+	}
+
+	spec, err = prepareGenericResourceSpec(info, map[string]string{
+		ReadyConditionAnnoName:            "Issued",
+		FailedConditionAnnoName:           "Issued=False",
+		TrackTerminationModeAnnoName:      string(multitrack.NonBlocking),
+		FailModeAnnoName:                  string(multitrack.HopeUntilEndOfDeployProcess),
+		FailuresAllowedPerReplicaAnnoName: "2",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if spec.ReadyCondition.String() != "Issued=True" || spec.FailedCondition.String() != "Issued=False" ||
+		spec.TrackTerminationMode != multitrack.NonBlocking || spec.FailMode != multitrack.HopeUntilEndOfDeployProcess || spec.AllowFailuresCount != 2 {
+		t.Errorf("unexpected spec: %#v", spec)
+	}
+
+	for annoName, annoValue := range map[string]string{
+		ReadyConditionAnnoName:            "=True",
+		FailedConditionAnnoName:           "",
+		TrackTerminationModeAnnoName:      "Unknown",
+		FailModeAnnoName:                  "Unknown",
+		FailuresAllowedPerReplicaAnnoName: "-1",
+	} {
+		if _, err := prepareGenericResourceSpec(info, map[string]string{annoName: annoValue}); err == nil {
+			t.Errorf("%s=%q: expected error", annoName, annoValue)
+		}
+	}
+}
+
+func TestMakeGenericResourceSpec(t *testing.T) {
+	service := &corev1.Service{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Service"},
+		ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "default"},
+	}
+	serviceMapping := &meta.RESTMapping{
+		Resource:         schema.GroupVersionResource{Version: "v1", Resource: "services"},
+		GroupVersionKind: schema.GroupVersionKind{Version: "v1", Kind: "Service"},
+	}
+	certificateMapping := &meta.RESTMapping{
+		Resource:         certificateGVR,
+		GroupVersionKind: schema.GroupVersionKind{Group: "cert-manager.io", Version: "v1", Kind: "Certificate"},
+	}
+
+	annotatedService := service.DeepCopy()
+	annotatedService.Annotations = map[string]string{ReadyConditionAnnoName: "Ready"}
+
+	invalidCertificate := newCertificate("cert", 1, nil)
+	invalidCertificate.SetAnnotations(map[string]string{FailModeAnnoName: "Unknown"})
+
+	tests := []struct {
+		name          string
+		info          *resource.Info
+		expectTracked bool
+	}{
+		{"custom resource", &resource.Info{Name: "cert", Object: newCertificate("cert", 1, nil), Mapping: certificateMapping}, true},
+		{"builtin resource", &resource.Info{Name: "app", Object: service, Mapping: serviceMapping}, false},
+		{"builtin resource with ready condition", &resource.Info{Name: "app", Object: annotatedService, Mapping: serviceMapping}, true},
+		{"invalid annotation", &resource.Info{Name: "cert", Object: invalidCertificate, Mapping: certificateMapping}, false},
+		{"no mapping", &resource.Info{Name: "cert", Object: newCertificate("cert", 1, nil)}, false},
+	}
+
+	for _, tt := range tests {
+		spec, err := makeGenericResourceSpec(newTestContext(), tt.info)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", tt.name, err)
+		} else if (spec != nil) != tt.expectTracked {
+			t.Errorf("%s: expected tracked %v, got %v", tt.name, tt.expectTracked, spec != nil)
+		}
+	}
+}
+
+func TestGetGenericResourceStatus(t *testing.T) {
+	tests := []struct {
+		name            string
+		obj             *unstructured.Unstructured
+		readyCondition  string
+		failedCondition string
+		expectedReady   bool
+		expectedFailed  bool
+	}{
+		{name: "no status", obj: newCertificate("cert", 1, nil), expectedReady: true},
+		{name: "no conditions", obj: newCertificate("cert", 1, map[string]interface{}{"observedGeneration": int64(1)}), expectedReady: true},
+		{name: "observed generation is behind", obj: newCertificate("cert", 2, map[string]interface{}{"observedGeneration": int64(1), "conditions": conditions("Ready=True")["conditions"]})},
+		{name: "ready", obj: newCertificate("cert", 1, conditions("Ready=True")), expectedReady: true},
+		{name: "not ready", obj: newCertificate("cert", 1, conditions("Ready=False"))},
+		{name: "reconciling", obj: newCertificate("cert", 1, conditions("Reconciling=True", "Ready=True"))},
+		{name: "stalled", obj: newCertificate("cert", 1, conditions("Stalled=True", "Ready=False")), expectedFailed: true},
+		{name: "custom ready condition", obj: newCertificate("cert", 1, conditions("Reconciling=True", "Issued=True")), readyCondition: "Issued", expectedReady: true},
+		{name: "custom ready condition with other status", obj: newCertificate("cert", 1, conditions("Issued=True")), readyCondition: "Issued=False"},
+		{name: "missing custom ready condition", obj: newCertificate("cert", 1, conditions("Ready=True")), readyCondition: "Issued"},
+		{name: "custom failed condition", obj: newCertificate("cert", 1, conditions("Issued=False")), failedCondition: "Issued=False", expectedFailed: true},
+		{name: "stalled with custom failed condition", obj: newCertificate("cert", 1, conditions("Stalled=True", "Ready=True")), failedCondition: "Issued=False", expectedReady: true},
+	}
+
+	for _, tt := range tests {
+		spec := &genericResourceSpec{Name: "cert", Kind: "Certificate"}
+		if tt.readyCondition != "" {
+			spec.ReadyCondition, _ = parseResourceCondition(tt.readyCondition)
+		}
+		if tt.failedCondition != "" {
+			spec.FailedCondition, _ = parseResourceCondition(tt.failedCondition)
+		}
+
+		status := getGenericResourceStatus(tt.obj, spec)
+		if status.IsReady != tt.expectedReady || status.IsFailed != tt.expectedFailed {
+			t.Errorf("%s: expected ready %v and failed %v, got %#v", tt.name, tt.expectedReady, tt.expectedFailed, status)
+		}
+	}
+}
+
+func TestGenericTrackingState(t *testing.T) {
+	s := newGenericTrackingState(0)
+	select {
+	case <-s.Done:
+	default:
+		t.Fatal("expected state without pending resources to be done")
+	}
+
+	if s.Resume() {
+		t.Error("expected done state not to be resumed")
+	}
+
+	s = newGenericTrackingState(2)
+	s.Finish()
+	if !s.Resume() {
+		t.Fatal("expected state to be resumed")
+	}
+
+	s.Finish()
+	s.Finish()
+	select {
+	case <-s.Done:
+	default:
+		t.Fatal("expected state to be done")
+	}
+}
+
+func TestGenericResourcesTracker_Track(t *testing.T) {
+	tests := []struct {
+		name        string
+		obj         *unstructured.Unstructured
+		spec        genericResourceSpec
+		expectedErr string
+	}{
+		{
+			name: "ready",
+			obj:  newCertificate("cert", 1, conditions("Ready=True")),
+			spec: genericResourceSpec{FailMode: multitrack.FailWholeDeployProcessImmediately},
+		},
+		{
+			name:        "failed",
+			obj:         newCertificate("cert", 1, conditions("Stalled=True")),
+			spec:        genericResourceSpec{FailMode: multitrack.FailWholeDeployProcessImmediately},
+			expectedErr: "certificate/cert failed: Stalled=True (Reason): message",
+		},
+		{
+			name: "failed in the ignore mode",
+			obj:  newCertificate("cert", 1, conditions("Stalled=True")),
+			spec: genericResourceSpec{FailMode: multitrack.IgnoreAndContinueDeployProcess},
+		},
+		{
+			name:        "failed in the hope mode",
+			obj:         newCertificate("cert", 1, conditions("Stalled=True")),
+			spec:        genericResourceSpec{FailMode: multitrack.HopeUntilEndOfDeployProcess},
+			expectedErr: "certificate/cert failed: Stalled=True (Reason): message",
+		},
+		{
+			name: "non-blocking when other resources are ready",
+			obj:  newCertificate("cert", 1, conditions("Ready=False")),
+			spec: genericResourceSpec{TrackTerminationMode: multitrack.NonBlocking, FailMode: multitrack.FailWholeDeployProcessImmediately},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spec := tt.spec
+			spec.Name = "cert"
+			spec.Namespace = "default"
+			spec.Kind = "Certificate"
+			spec.GroupVersionResource = certificateGVR
+			if spec.TrackTerminationMode == "" {
+				spec.TrackTerminationMode = multitrack.WaitUntilResourceReady
+			}
+
+			pending := 0
+			if spec.TrackTerminationMode != multitrack.NonBlocking {
+				pending++
+			}
+			state := newGenericTrackingState(pending)
+
+			tracker := &genericResourcesTracker{Client: dynamicfake.NewSimpleDynamicClient(runtime.NewScheme(), tt.obj)}
+
+			ctx, cancel := context.WithTimeout(newTestContext(), 10*time.Second)
+			defer cancel()
+
+			err := tracker.Track(ctx, &spec, state)
+			if tt.expectedErr != "" {
+				if err == nil || err.Error() != tt.expectedErr {
+					t.Fatalf("expected error %q, got %v", tt.expectedErr, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			select {
+			case <-state.Done:
+			default:
+				t.Error("expected tracking to be done")
+			}
+		})
+	}
+}
+
+func TestResourcesWaiter_trackGenericResources(t *testing.T) {
+	dynamicClient := kube.DynamicClient
+	defer func() { kube.DynamicClient = dynamicClient }()
+
+	kube.DynamicClient = dynamicfake.NewSimpleDynamicClient(runtime.NewScheme(),
+		newCertificate("ready", 1, conditions("Ready=True")),
+		newCertificate("failed", 1, conditions("Stalled=True")),
+	)
+
+	newSpec := func(name string) *genericResourceSpec {
+		return &genericResourceSpec{
+			Name:                 name,
+			Namespace:            "default",
+			Kind:                 "Certificate",
+			GroupVersionResource: certificateGVR,
+			TrackTerminationMode: multitrack.WaitUntilResourceReady,
+			FailMode:             multitrack.FailWholeDeployProcessImmediately,
+		}
+	}
+
+	waiter := &ResourcesWaiter{}
+
+	t.Run("ready", func(t *testing.T) {
+		err := waiter.trackGenericResources(newTestContext(), []*genericResourceSpec{newSpec("ready")}, func(context.Context) error { return nil }, 0, 10*time.Second)
+		if err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	t.Run("failed resource stops multitrack", func(t *testing.T) {
+		multitrackStopped := false
+		err := waiter.trackGenericResources(newTestContext(), []*genericResourceSpec{newSpec("ready"), newSpec("failed")}, func(ctx context.Context) error {
+			<-ctx.Done()
+			multitrackStopped = true
+			return nil
+		}, 0, 10*time.Second)
+
+		if err == nil || !strings.Contains(err.Error(), "certificate/failed failed") {
+			t.Fatalf("expected error of the failed resource, got %v", err)
+		}
+
+		if !multitrackStopped {
+			t.Error("expected multitrack to be stopped before return")
+		}
+	})
+
+	t.Run("multitrack error stops tracking", func(t *testing.T) {
+		err := waiter.trackGenericResources(newTestContext(), []*genericResourceSpec{newSpec("missing")}, func(context.Context) error {
+			return fmt.Errorf("deploy/app failed")
+		}, 0, 10*time.Second)
+
+		if err == nil || err.Error() != "deploy/app failed" {
+			t.Fatalf("expected multitrack error, got %v", err)
+		}
+	})
+}
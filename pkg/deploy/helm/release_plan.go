@@ -0,0 +1,452 @@
+package helm
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	jsonpatch "github.com/evanphx/json-patch"
+	"helm.sh/helm/v3/pkg/action"
+	helm_kube "helm.sh/helm/v3/pkg/kube"
+	"helm.sh/helm/v3/pkg/release"
+	helm_driver "helm.sh/helm/v3/pkg/storage/driver"
+	apiextv1beta1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/strategicpatch"
+	"k8s.io/cli-runtime/pkg/resource"
+
+	"github.com/werf/werf/pkg/util/secretvalues"
+)
+
+type ChangeAction string
+
+const (
+	ChangeCreate ChangeAction = "create"
+	ChangeUpdate ChangeAction = "update"
+	ChangeDelete ChangeAction = "delete"
+)
+
+const maskedValue = "***"
+
+// ResourceChange is the change of the release resource which will be made by the deploy process.
+type ResourceChange struct {
+	Action    ChangeAction `json:"action"`
+	Kind      string       `json:"kind"`
+	Name      string       `json:"name"`
+	Namespace string       `json:"namespace,omitempty"`
+	// Fields are all fields of the resource for created and deleted resources and changed fields for updated resources
+	Fields []*FieldChange `json:"fields,omitempty"`
+}
+
+func (c *ResourceChange) String() string {
+	return fmt.Sprintf("%s/%s", strings.ToLower(c.Kind), c.Name)
+}
+
+type FieldChange struct {
+	Action ChangeAction `json:"action"`
+	Path   string       `json:"path"`
+	Old    interface{}  `json:"old,omitempty"`
+	New    interface{}  `json:"new,omitempty"`
+}
+
+type PlanReleaseOptions struct {
+	// SecretValuesToMask are replaced in values of fields, data of Secrets is always masked
+	SecretValuesToMask []string
+}
+
+// GetCurrentRelease returns the release revision which resources are patched by the upgrade the same way helm chooses it,
+// nil is returned if the release does not exist.
+func GetCurrentRelease(actionConfig *action.Configuration, releaseName string) (*release.Release, error) {
+	history, err := actionConfig.Releases.History(releaseName)
+	if err == helm_driver.ErrReleaseNotFound || (err == nil && len(history) == 0) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("unable to get history of release %q: %s", releaseName, err)
+	}
+
+	lastRelease, err := actionConfig.Releases.Last(releaseName)
+	if err != nil {
+		return nil, err
+	}
+
+	if lastRelease.Info.Status == release.StatusDeployed {
+		return lastRelease, nil
+	}
+
+	for _, r := range history {
+		if r.Info.Status == release.StatusDeployed {
+			return r, nil
+		}
+	}
+
+	return lastRelease, nil
+}
+
+// PlanRelease compares resources of the target release manifest with the live objects and returns changes
+// which will be made by the upgrade: the three-way merge of the current release manifest, the target manifest and the live object is used the same way helm does.
+// Hooks are not taken into account.
+func PlanRelease(actionConfig *action.Configuration, targetRelease, currentRelease *release.Release, opts PlanReleaseOptions) ([]*ResourceChange, error) {
+	kubeClient, ok := actionConfig.KubeClient.(*helm_kube.Client)
+	if !ok {
+		return nil, fmt.Errorf("unable to plan release %q: kube client is not initialized", targetRelease.Name)
+	}
+
+	target, err := kubeClient.Build(bytes.NewBufferString(targetRelease.Manifest), false)
+	if err != nil {
+		return nil, fmt.Errorf("unable to build resources of release %q manifest: %s", targetRelease.Name, err)
+	}
+
+	var original helm_kube.ResourceList
+	if currentRelease != nil {
+		original, err = kubeClient.Build(bytes.NewBufferString(currentRelease.Manifest), false)
+		if err != nil {
+			return nil, fmt.Errorf("unable to build resources of release %q revision %d manifest: %s", currentRelease.Name, currentRelease.Version, err)
+		}
+	}
+
+	masker := newSecretsMasker(opts.SecretValuesToMask)
+
+	var changes []*ResourceChange
+	for _, info := range target {
+		targetData, err := objectToMap(info.Object)
+		if err != nil {
+			return nil, err
+		}
+		masker.AddSecretData(targetData)
+
+		liveObj, err := resource.NewHelper(info.Client, info.Mapping).Get(info.Namespace, info.Name)
+		if errors.IsNotFound(err) {
+			changes = append(changes, newResourceChange(ChangeCreate, info, getFieldChanges(nil, targetData)))
+			continue
+		} else if err != nil {
+			return nil, fmt.Errorf("unable to get %s: %s", info.ObjectName(), err)
+		}
+
+		liveData, err := objectToMap(liveObj)
+		if err != nil {
+			return nil, err
+		}
+		masker.AddSecretData(liveData)
+
+		originalObj := liveObj
+		if originalInfo := original.Get(info); originalInfo != nil {
+			originalObj = originalInfo.Object
+		}
+
+		predictedData, err := predictPatchedObject(info, originalObj, liveObj)
+		if err != nil {
+			return nil, fmt.Errorf("unable to predict changes of %s: %s", info.ObjectName(), err)
+		}
+
+		if fields := getFieldChanges(liveData, predictedData); len(fields) != 0 {
+			changes = append(changes, newResourceChange(ChangeUpdate, info, fields))
+		}
+	}
+
+	for _, info := range original.Difference(target) {
+		originalData, err := objectToMap(info.Object)
+		if err != nil {
+			return nil, err
+		}
+
+		if isResourceKept(originalData) {
+			continue
+		}
+
+		if _, err := resource.NewHelper(info.Client, info.Mapping).Get(info.Namespace, info.Name); errors.IsNotFound(err) {
+			continue
+		} else if err != nil {
+			return nil, fmt.Errorf("unable to get %s: %s", info.ObjectName(), err)
+		}
+
+		masker.AddSecretData(originalData)
+		changes = append(changes, newResourceChange(ChangeDelete, info, getFieldChanges(originalData, nil)))
+	}
+
+	masker.Mask(changes)
+
+	return changes, nil
+}
+
+func newResourceChange(action ChangeAction, info *resource.Info, fields []*FieldChange) *ResourceChange {
+	return &ResourceChange{
+		Action:    action,
+		Kind:      info.Mapping.GroupVersionKind.Kind,
+		Name:      info.Name,
+		Namespace: info.Namespace,
+		Fields:    fields,
+	}
+}
+
+// predictPatchedObject applies the patch, which helm creates to update the resource, to the live object.
+func predictPatchedObject(target *resource.Info, originalObj, liveObj runtime.Object) (map[string]interface{}, error) {
+	originalData, err := json.Marshal(originalObj)
+	if err != nil {
+		return nil, err
+	}
+
+	targetData, err := json.Marshal(target.Object)
+	if err != nil {
+		return nil, err
+	}
+
+	liveData, err := json.Marshal(liveObj)
+	if err != nil {
+		return nil, err
+	}
+
+	versionedObject := helm_kube.AsVersioned(target)
+	_, isUnstructured := versionedObject.(runtime.Unstructured)
+	_, isCRD := versionedObject.(*apiextv1beta1.CustomResourceDefinition)
+
+	var predictedData []byte
+	if isUnstructured || isCRD {
+		patch, err := jsonpatch.CreateMergePatch(originalData, targetData)
+		if err != nil {
+			return nil, err
+		}
+
+		predictedData, err = jsonpatch.MergePatch(liveData, patch)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		patchMeta, err := strategicpatch.NewPatchMetaFromStruct(versionedObject)
+		if err != nil {
+			return nil, err
+		}
+
+		patch, err := strategicpatch.CreateThreeWayMergePatch(originalData, targetData, liveData, patchMeta, true)
+		if err != nil {
+			return nil, err
+		}
+
+		predictedData, err = strategicpatch.StrategicMergePatchUsingLookupPatchMeta(liveData, patch, patchMeta)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	predictedObj := map[string]interface{}{}
+	if err := json.Unmarshal(predictedData, &predictedObj); err != nil {
+		return nil, err
+	}
+
+	return predictedObj, nil
+}
+
+func isResourceKept(data map[string]interface{}) bool {
+	metadata, _ := data["metadata"].(map[string]interface{})
+	annotations, _ := metadata["annotations"].(map[string]interface{})
+
+	return annotations["helm.sh/resource-policy"] == "keep"
+}
+
+func objectToMap(obj runtime.Object) (map[string]interface{}, error) {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	res := map[string]interface{}{}
+	if err := json.Unmarshal(data, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// ignoredFieldPaths are maintained by kubernetes and are not changed by the deploy process.
+var ignoredFieldPaths = []string{
+	"status",
+	"metadata.managedFields",
+	"metadata.resourceVersion",
+	"metadata.generation",
+	"metadata.uid",
+	"metadata.selfLink",
+	"metadata.creationTimestamp",
+}
+
+// getFieldChanges compares objects field by field, nil object means the object does not exist.
+func getFieldChanges(oldData, newData map[string]interface{}) []*FieldChange {
+	var res []*FieldChange
+	for _, c := range diffValues("", oldData, newData, oldData != nil, newData != nil) {
+		if !isFieldPathIgnored(c.Path) {
+			res = append(res, c)
+		}
+	}
+
+	return res
+}
+
+func isFieldPathIgnored(path string) bool {
+	for _, ignoredPath := range ignoredFieldPaths {
+		if isFieldPathUnder(path, ignoredPath) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isFieldPathUnder(path, parentPath string) bool {
+	return path == parentPath || strings.HasPrefix(path, parentPath+".") || strings.HasPrefix(path, parentPath+"[")
+}
+
+func diffValues(path string, oldValue, newValue interface{}, oldExists, newExists bool) []*FieldChange {
+	oldMap, isOldMap := oldValue.(map[string]interface{})
+	newMap, isNewMap := newValue.(map[string]interface{})
+	if (isOldMap || !oldExists) && (isNewMap || !newExists) {
+		keys := map[string]bool{}
+		for k := range oldMap {
+			keys[k] = true
+		}
+		for k := range newMap {
+			keys[k] = true
+		}
+
+		var sortedKeys []string
+		for k := range keys {
+			sortedKeys = append(sortedKeys, k)
+		}
+		sort.Strings(sortedKeys)
+
+		var res []*FieldChange
+		for _, k := range sortedKeys {
+			oldV, oldOk := oldMap[k]
+			newV, newOk := newMap[k]
+			res = append(res, diffValues(joinFieldPath(path, k), oldV, newV, oldOk, newOk)...)
+		}
+
+		return res
+	}
+
+	oldList, isOldList := oldValue.([]interface{})
+	newList, isNewList := newValue.([]interface{})
+	if (isOldList || !oldExists) && (isNewList || !newExists) && len(oldList)+len(newList) > 0 {
+		var res []*FieldChange
+		for i := 0; i < len(oldList) || i < len(newList); i++ {
+			var oldV, newV interface{}
+			if i < len(oldList) {
+				oldV = oldList[i]
+			}
+			if i < len(newList) {
+				newV = newList[i]
+			}
+			res = append(res, diffValues(fmt.Sprintf("%s[%d]", path, i), oldV, newV, i < len(oldList), i < len(newList))...)
+		}
+
+		return res
+	}
+
+	switch {
+	case !oldExists:
+		return []*FieldChange{{Action: ChangeCreate, Path: path, New: newValue}}
+	case !newExists:
+		return []*FieldChange{{Action: ChangeDelete, Path: path, Old: oldValue}}
+	case !reflect.DeepEqual(oldValue, newValue):
+		return []*FieldChange{{Action: ChangeUpdate, Path: path, Old: oldValue, New: newValue}}
+	}
+
+	return nil
+}
+
+func joinFieldPath(path, key string) string {
+	if strings.Contains(key, ".") {
+		return fmt.Sprintf("%s[%s]", path, key)
+	}
+
+	if path == "" {
+		return key
+	}
+
+	return path + "." + key
+}
+
+type secretsMasker struct {
+	values []string
+}
+
+func newSecretsMasker(values []string) *secretsMasker {
+	return &secretsMasker{values: values}
+}
+
+// AddSecretData adds values of data and stringData of the Secret to mask them in other resources.
+func (m *secretsMasker) AddSecretData(data map[string]interface{}) {
+	if data["kind"] != "Secret" {
+		return
+	}
+
+	secretData := map[string]interface{}{}
+	if d, ok := data["data"].(map[string]interface{}); ok {
+		for k, v := range d {
+			secretData[k] = v
+			if s, ok := v.(string); ok {
+				if decoded, err := base64.StdEncoding.DecodeString(s); err == nil {
+					secretData[k+"-decoded"] = string(decoded)
+				}
+			}
+		}
+	}
+	if d, ok := data["stringData"].(map[string]interface{}); ok {
+		for k, v := range d {
+			secretData["string-"+k] = v
+		}
+	}
+
+	m.values = append(m.values, secretvalues.ExtractSecretValuesFromMap(secretData)...)
+}
+
+func (m *secretsMasker) Mask(changes []*ResourceChange) {
+	// longer values are replaced first not to leave parts of values which contain shorter ones
+	sort.SliceStable(m.values, func(i, j int) bool {
+		return len(m.values[i]) > len(m.values[j])
+	})
+
+	for _, change := range changes {
+		for _, field := range change.Fields {
+			if change.Kind == "Secret" && (isFieldPathUnder(field.Path, "data") || isFieldPathUnder(field.Path, "stringData")) {
+				if field.Old != nil {
+					field.Old = maskedValue
+				}
+				if field.New != nil {
+					field.New = maskedValue
+				}
+				continue
+			}
+
+			field.Old = m.maskValue(field.Old)
+			field.New = m.maskValue(field.New)
+		}
+	}
+}
+
+func (m *secretsMasker) maskValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case string:
+		for _, secretValue := range m.values {
+			v = strings.ReplaceAll(v, secretValue, maskedValue)
+		}
+		return v
+	case map[string]interface{}:
+		// the field value is the whole map when the type of the field is changed
+		res := make(map[string]interface{}, len(v))
+		for k, elem := range v {
+			res[k] = m.maskValue(elem)
+		}
+		return res
+	case []interface{}:
+		res := make([]interface{}, len(v))
+		for i, elem := range v {
+			res[i] = m.maskValue(elem)
+		}
+		return res
+	}
+
+	return value
+}
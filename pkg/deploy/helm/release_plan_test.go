@@ -0,0 +1,223 @@
+package helm
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestDiffValues(t *testing.T) {
+	tests := []struct {
+		name     string
+		old      map[string]interface{}
+		new      map[string]interface{}
+		expected []*FieldChange
+	}{
+		{
+			name: "equal values",
+			old:  map[string]interface{}{"spec": map[string]interface{}{"replicas": 1, "ports": []interface{}{80}}},
+			new:  map[string]interface{}{"spec": map[string]interface{}{"replicas": 1, "ports": []interface{}{80}}},
+		},
+		{
+			name: "created resource",
+			new:  map[string]interface{}{"kind": "Service", "spec": map[string]interface{}{"ports": []interface{}{80, 443}}},
+			expected: []*FieldChange{
+				{Action: ChangeCreate, Path: "kind", New: "Service"},
+				{Action: ChangeCreate, Path: "spec.ports[0]", New: 80},
+				{Action: ChangeCreate, Path: "spec.ports[1]", New: 443},
+			},
+		},
+		{
+			name: "deleted resource",
+			old:  map[string]interface{}{"kind": "Service"},
+			expected: []*FieldChange{
+				{Action: ChangeDelete, Path: "kind", Old: "Service"},
+			},
+		},
+		{
+			name: "nested fields",
+			old:  map[string]interface{}{"spec": map[string]interface{}{"replicas": 1, "paused": true}},
+			new:  map[string]interface{}{"spec": map[string]interface{}{"replicas": 2, "strategy": "Recreate"}},
+			expected: []*FieldChange{
+				{Action: ChangeDelete, Path: "spec.paused", Old: true},
+				{Action: ChangeUpdate, Path: "spec.replicas", Old: 1, New: 2},
+				{Action: ChangeCreate, Path: "spec.strategy", New: "Recreate"},
+			},
+		},
+		{
+			name: "lists of different length",
+			old:  map[string]interface{}{"args": []interface{}{"a", "b", "c"}},
+			new:  map[string]interface{}{"args": []interface{}{"a", "d"}},
+			expected: []*FieldChange{
+				{Action: ChangeUpdate, Path: "args[1]", Old: "b", New: "d"},
+				{Action: ChangeDelete, Path: "args[2]", Old: "c"},
+			},
+		},
+		{
+			name: "keys with dots",
+			old:  map[string]interface{}{"metadata": map[string]interface{}{"annotations": map[string]interface{}{"werf.io/weight": "1"}}},
+			new:  map[string]interface{}{"metadata": map[string]interface{}{"annotations": map[string]interface{}{"werf.io/weight": "2"}}},
+			expected: []*FieldChange{
+				{Action: ChangeUpdate, Path: "metadata.annotations[werf.io/weight]", Old: "1", New: "2"},
+			},
+		},
+		{
+			name: "changed type of field",
+			old:  map[string]interface{}{"value": "a"},
+			new:  map[string]interface{}{"value": map[string]interface{}{"key": "a"}},
+			expected: []*FieldChange{
+				{Action: ChangeUpdate, Path: "value", Old: "a", New: map[string]interface{}{"key": "a"}},
+			},
+		},
+		{
+			name: "empty list is created",
+			new:  map[string]interface{}{"args": []interface{}{}},
+			expected: []*FieldChange{
+				{Action: ChangeCreate, Path: "args", New: []interface{}{}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := diffValues("", tt.old, tt.new, tt.old != nil, tt.new != nil)
+			if !reflect.DeepEqual(res, tt.expected) {
+				t.Errorf("expected %s, got %s", formatFieldChanges(tt.expected), formatFieldChanges(res))
+			}
+		})
+	}
+}
+
+func TestGetFieldChanges(t *testing.T) {
+	old := map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"name":            "app",
+			"resourceVersion": "1",
+			"managedFields":   []interface{}{map[string]interface{}{"manager": "helm"}},
+		},
+		"spec":   map[string]interface{}{"replicas": 1},
+		"status": map[string]interface{}{"readyReplicas": 1},
+	}
+	new := map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"name":            "app",
+			"resourceVersion": "2",
+			"generation":      2,
+		},
+		"spec":   map[string]interface{}{"replicas": 2},
+		"status": map[string]interface{}{"readyReplicas": 2},
+	}
+
+	expected := []*FieldChange{{Action: ChangeUpdate, Path: "spec.replicas", Old: 1, New: 2}}
+	if res := getFieldChanges(old, new); !reflect.DeepEqual(res, expected) {
+		t.Errorf("expected %s, got %s", formatFieldChanges(expected), formatFieldChanges(res))
+	}
+}
+
+func TestSecretsMasker(t *testing.T) {
+	oldSecret := map[string]interface{}{
+		"kind":       "Secret",
+		"data":       map[string]interface{}{"password": base64.StdEncoding.EncodeToString([]byte("old-password"))},
+		"stringData": map[string]interface{}{"token": "old-token"},
+	}
+	newSecret := map[string]interface{}{
+		"kind":       "Secret",
+		"data":       map[string]interface{}{"password": base64.StdEncoding.EncodeToString([]byte("new-password")), "key": "bm90LWJhc2U2NA"},
+		"stringData": map[string]interface{}{"token": "new-token", "config": "user: admin\npassword: multiline-password\n"},
+	}
+	oldConfigMap := map[string]interface{}{
+		"kind": "ConfigMap",
+		"data": map[string]interface{}{"url": "postgres://app:old-password@db", "replicas": 1},
+	}
+	newConfigMap := map[string]interface{}{
+		"kind": "ConfigMap",
+		"data": map[string]interface{}{
+			"url":      "postgres://app:new-password@db",
+			"token":    "Bearer new-token",
+			"encoded":  base64.StdEncoding.EncodeToString([]byte("new-password")),
+			"key":      "bm90LWJhc2U2NA",
+			"config":   "password: multiline-password",
+			"values":   map[string]interface{}{"secret": "secret-value-from-values"},
+			"replicas": 2,
+		},
+	}
+
+	masker := newSecretsMasker([]string{"secret-value-from-values"})
+	for _, data := range []map[string]interface{}{oldSecret, newSecret, oldConfigMap, newConfigMap} {
+		masker.AddSecretData(data)
+	}
+
+	changes := []*ResourceChange{
+		{Action: ChangeUpdate, Kind: "Secret", Name: "app", Fields: getFieldChanges(oldSecret, newSecret)},
+		{Action: ChangeCreate, Kind: "Secret", Name: "new", Fields: getFieldChanges(nil, newSecret)},
+		{Action: ChangeDelete, Kind: "Secret", Name: "old", Fields: getFieldChanges(oldSecret, nil)},
+		{Action: ChangeUpdate, Kind: "ConfigMap", Name: "app", Fields: getFieldChanges(oldConfigMap, newConfigMap)},
+		{Action: ChangeUpdate, Kind: "Deployment", Name: "app", Fields: getFieldChanges(
+			map[string]interface{}{"env": "secret-value-from-values"},
+			map[string]interface{}{"env": map[string]interface{}{"value": "new-token", "list": []interface{}{"new-password"}}},
+		)},
+	}
+	masker.Mask(changes)
+
+	data, err := json.Marshal(changes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	output := string(data)
+
+	for _, secretValue := range []string{
+		"old-password",
+		"new-password",
+		"old-token",
+		"new-token",
+		"multiline-password",
+		"secret-value-from-values",
+		base64.StdEncoding.EncodeToString([]byte("old-password")),
+		base64.StdEncoding.EncodeToString([]byte("new-password")),
+		"bm90LWJhc2U2NA",
+	} {
+		if strings.Contains(output, secretValue) {
+			t.Errorf("secret value %q is in the plan:\n%s", secretValue, output)
+		}
+	}
+
+	for _, field := range changes[0].Fields {
+		if field.Path == "kind" {
+			continue
+		}
+
+		if (field.Old != nil && field.Old != maskedValue) || (field.New != nil && field.New != maskedValue) {
+			t.Errorf("field %s of the Secret is not masked: %#v", field.Path, field)
+		}
+	}
+
+	expectedConfigMapFields := []*FieldChange{
+		{Action: ChangeCreate, Path: "data.config", New: "***"},
+		{Action: ChangeCreate, Path: "data.encoded", New: "***"},
+		{Action: ChangeCreate, Path: "data.key", New: "***"},
+		{Action: ChangeUpdate, Path: "data.replicas", Old: 1, New: 2},
+		{Action: ChangeCreate, Path: "data.token", New: "Bearer ***"},
+		{Action: ChangeUpdate, Path: "data.url", Old: "postgres://app:***@db", New: "postgres://app:***@db"},
+		{Action: ChangeCreate, Path: "data.values.secret", New: "***"},
+	}
+	if !reflect.DeepEqual(changes[3].Fields, expectedConfigMapFields) {
+		t.Errorf("expected %s, got %s", formatFieldChanges(expectedConfigMapFields), formatFieldChanges(changes[3].Fields))
+	}
+}
+
+func TestSecretsMasker_LongerValuesFirst(t *testing.T) {
+	masker := newSecretsMasker([]string{"pass", "password"})
+	changes := []*ResourceChange{{Kind: "ConfigMap", Fields: []*FieldChange{{Action: ChangeCreate, Path: "data.key", New: "password"}}}}
+	masker.Mask(changes)
+
+	if value := changes[0].Fields[0].New; value != maskedValue {
+		t.Errorf("expected %q, got %q", maskedValue, value)
+	}
+}
+
+func formatFieldChanges(changes []*FieldChange) string {
+	data, _ := json.Marshal(changes)
+	return string(data)
+}
@@ -0,0 +1,133 @@
+package helm
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/werf/logboek"
+	"github.com/werf/werf/pkg/deploy/lock_manager"
+	"helm.sh/helm/v3/pkg/action"
+	helm_kube "helm.sh/helm/v3/pkg/kube"
+	"helm.sh/helm/v3/pkg/release"
+	helm_driver "helm.sh/helm/v3/pkg/storage/driver"
+)
+
+type TrackReleaseOptions struct {
+	Timeout time.Duration
+	// UpdateReleaseStatus marks the tracked revision as deployed or failed depending on the tracking result
+	UpdateReleaseStatus bool
+	// LockManager is used to lock the release while its status is updated, the release is not locked if nil
+	LockManager *lock_manager.LockManager
+}
+
+// TrackRelease waits for resources of the latest revision of the release to become ready the same way the deploy process does.
+// Hooks of the revision are not executed.
+func TrackRelease(ctx context.Context, actionConfig *action.Configuration, releaseName string, opts TrackReleaseOptions) (*release.Release, error) {
+	rel, err := actionConfig.Releases.Last(releaseName)
+	if err == helm_driver.ErrReleaseNotFound {
+		return nil, fmt.Errorf("release %q not found", releaseName)
+	} else if err != nil {
+		return nil, fmt.Errorf("unable to get release %q: %s", releaseName, err)
+	}
+
+	kubeClient, ok := actionConfig.KubeClient.(*helm_kube.Client)
+	if !ok || kubeClient.ResourcesWaiter == nil {
+		return nil, fmt.Errorf("unable to track release %q: resources waiter is not initialized", releaseName)
+	}
+
+	logboek.Context(ctx).Default().LogF("Release %q revision %d status is %s\n", rel.Name, rel.Version, rel.Info.Status)
+
+	resources, err := kubeClient.Build(bytes.NewBufferString(rel.Manifest), false)
+	if err != nil {
+		return nil, fmt.Errorf("unable to build resources of release %q revision %d manifest: %s", rel.Name, rel.Version, err)
+	}
+
+	trackErr := kubeClient.ResourcesWaiter.Wait(ctx, rel.Namespace, resources, opts.Timeout)
+
+	if opts.UpdateReleaseStatus {
+		if err := lockRelease(ctx, opts.LockManager, rel.Name, func() error {
+			return updateTrackedReleaseStatus(ctx, actionConfig, rel, trackErr)
+		}); err != nil {
+			return rel, err
+		}
+	}
+
+	if trackErr != nil {
+		return rel, fmt.Errorf("release %q revision %d tracking failed: %s", rel.Name, rel.Version, trackErr)
+	}
+
+	return rel, nil
+}
+
+func lockRelease(ctx context.Context, lockManager *lock_manager.LockManager, releaseName string, f func() error) error {
+	if lockManager != nil {
+		if lock, err := lockManager.LockRelease(ctx, releaseName); err != nil {
+			return err
+		} else {
+			defer lockManager.Unlock(lock)
+		}
+	}
+	return f()
+}
+
+// updateTrackedReleaseStatus records the tracking result the same way helm does after waiting for resources:
+// the revision becomes deployed and previously deployed revisions become superseded, or the revision becomes failed.
+// The status is not updated if the release has been changed by another process during tracking.
+func updateTrackedReleaseStatus(ctx context.Context, actionConfig *action.Configuration, trackedRel *release.Release, trackErr error) error {
+	rel, err := actionConfig.Releases.Last(trackedRel.Name)
+	if err != nil {
+		return fmt.Errorf("unable to get release %q: %s", trackedRel.Name, err)
+	}
+
+	if rel.Version != trackedRel.Version {
+		logboek.Context(ctx).Warn().LogF("WARNING: Release %q revision %d status is not updated: revision %d has been created during tracking\n", rel.Name, trackedRel.Version, rel.Version)
+		return nil
+	}
+
+	switch rel.Info.Status {
+	case release.StatusUninstalling, release.StatusUninstalled:
+		return nil
+	}
+
+	if trackErr != nil {
+		if rel.Info.Status == release.StatusFailed {
+			return nil
+		}
+
+		rel.SetStatus(release.StatusFailed, fmt.Sprintf("Release %q tracking failed: %s", rel.Name, trackErr))
+	} else {
+		if rel.Info.Status == release.StatusDeployed {
+			return nil
+		}
+
+		history, err := actionConfig.Releases.History(rel.Name)
+		if err != nil {
+			return fmt.Errorf("unable to get history of release %q: %s", rel.Name, err)
+		}
+
+		for _, r := range history {
+			if r.Version == rel.Version || r.Info.Status != release.StatusDeployed {
+				continue
+			}
+
+			r.Info.Status = release.StatusSuperseded
+			if err := actionConfig.Releases.Update(r); err != nil {
+				return fmt.Errorf("unable to update release %q revision %d: %s", r.Name, r.Version, err)
+			}
+		}
+
+		rel.SetStatus(release.StatusDeployed, "Tracking complete")
+	}
+
+	if err := actionConfig.Releases.Update(rel); err != nil {
+		return fmt.Errorf("unable to update release %q revision %d: %s", rel.Name, rel.Version, err)
+	}
+
+	trackedRel.Info = rel.Info
+
+	logboek.Context(ctx).Default().LogF("Release %q revision %d status is set to %s\n", rel.Name, rel.Version, rel.Info.Status)
+
+	return nil
+}
@@ -19,7 +19,6 @@ import (
 	appsv1beta1 "k8s.io/api/apps/v1beta1"
 	appsv1beta2 "k8s.io/api/apps/v1beta2"
 	batchv1 "k8s.io/api/batch/v1"
-	v1 "k8s.io/api/core/v1"
 	extensions "k8s.io/api/extensions/v1beta1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -61,6 +60,7 @@ func (waiter *ResourcesWaiter) Wait(ctx context.Context, namespace string, resou
 	}
 
 	specs := multitrack.MultitrackSpecs{}
+	var genericSpecs []*genericResourceSpec
 
 	for _, v := range resources {
 		switch value := asVersioned(v).(type) {
@@ -158,12 +158,14 @@ func (waiter *ResourcesWaiter) Wait(ctx context.Context, namespace string, resou
 			if spec != nil {
 				specs.Jobs = append(specs.Jobs, *spec)
 			}
-		case *v1.ReplicationController:
-		case *extensions.ReplicaSet:
-		case *appsv1beta2.ReplicaSet:
-		case *appsv1.ReplicaSet:
-		case *v1.PersistentVolumeClaim:
-		case *v1.Service:
+		default:
+			spec, err := makeGenericResourceSpec(ctx, v)
+			if err != nil {
+				return fmt.Errorf("cannot track %s %s: %s", v.Mapping.GroupVersionKind.Kind, v.Name, err)
+			}
+			if spec != nil {
+				genericSpecs = append(genericSpecs, spec)
+			}
 		}
 	}
 
@@ -171,16 +173,72 @@ func (waiter *ResourcesWaiter) Wait(ctx context.Context, namespace string, resou
 	logboek.Context(ctx).LogOptionalLn()
 	return logboek.Context(ctx).LogProcess("Waiting for release resources to become ready").
 		DoError(func() error {
-			return multitrack.Multitrack(kube.Client, specs, multitrack.MultitrackOptions{
-				StatusProgressPeriod: waiter.StatusProgressPeriod,
-				Options: tracker.Options{
-					Timeout:      timeout,
-					LogsFromTime: waiter.LogsFromTime,
-				},
-			})
+			multitrackFunc := func(ctx context.Context) error {
+				return multitrack.Multitrack(kube.Client, specs, multitrack.MultitrackOptions{
+					StatusProgressPeriod: waiter.StatusProgressPeriod,
+					Options: tracker.Options{
+						ParentContext: ctx,
+						Timeout:       timeout,
+						LogsFromTime:  waiter.LogsFromTime,
+					},
+				})
+			}
+
+			if len(genericSpecs) == 0 {
+				return multitrackFunc(ctx)
+			}
+
+			return waiter.trackGenericResources(ctx, genericSpecs, multitrackFunc, waiter.StatusProgressPeriod, timeout)
 		})
 }
 
+// trackGenericResources tracks resources by status conditions concurrently with the resources tracked by multitrack.
+// The first error stops the tracking of all resources, the function returns when all trackers are stopped.
+func (waiter *ResourcesWaiter) trackGenericResources(ctx context.Context, genericSpecs []*genericResourceSpec, multitrackFunc func(ctx context.Context) error, statusProgressPeriod, timeout time.Duration) error {
+	var cancel context.CancelFunc
+	if timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+	} else {
+		ctx, cancel = context.WithCancel(ctx)
+	}
+	defer cancel()
+
+	pending := 1
+	for _, spec := range genericSpecs {
+		if spec.TrackTerminationMode != multitrack.NonBlocking {
+			pending++
+		}
+	}
+
+	state := newGenericTrackingState(pending)
+	t := &genericResourcesTracker{Client: kube.DynamicClient, StatusProgressPeriod: statusProgressPeriod}
+
+	errCh := make(chan error, len(genericSpecs)+1)
+	go func() {
+		err := multitrackFunc(ctx)
+		if err == nil {
+			state.Finish()
+		}
+		errCh <- err
+	}()
+
+	for _, spec := range genericSpecs {
+		go func(spec *genericResourceSpec) {
+			errCh <- t.Track(ctx, spec, state)
+		}(spec)
+	}
+
+	var resErr error
+	for i := 0; i < len(genericSpecs)+1; i++ {
+		if err := <-errCh; err != nil && resErr == nil {
+			resErr = err
+			cancel()
+		}
+	}
+
+	return resErr
+}
+
 func makeMultitrackSpec(ctx context.Context, objMeta *metav1.ObjectMeta, failuresCountOptions allowedFailuresCountOptions, kind string) (*multitrack.MultitrackSpec, error) {
 	multitrackSpec, err := prepareMultitrackSpec(objMeta.Name, kind, objMeta.Namespace, objMeta.Annotations, failuresCountOptions)
 	if err != nil {
@@ -362,6 +420,17 @@ func (waiter *ResourcesWaiter) WatchUntilReady(ctx context.Context, namespace st
 				})
 
 		default:
+			spec, err := makeGenericResourceSpec(ctx, info)
+			if err != nil {
+				return fmt.Errorf("cannot track %s %s: %s", kind, name, err)
+			}
+			if spec != nil {
+				return logboek.Context(ctx).LogProcess("Waiting for helm hook %s to become ready", spec).
+					DoError(func() error {
+						return waiter.trackGenericResources(ctx, []*genericResourceSpec{spec}, func(context.Context) error { return nil }, waiter.HooksStatusProgressPeriod, timeout)
+					})
+			}
+
 			logboek.Context(ctx).Default().LogFDetails("Will not track helm hook %s/%s: %s kind not supported for tracking\n", strings.ToLower(kind), name, kind)
 		}
 	}
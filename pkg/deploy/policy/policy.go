@@ -0,0 +1,359 @@
+package policy
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/checker/decls"
+	"github.com/google/cel-go/common/types"
+	"github.com/google/cel-go/common/types/ref"
+	"github.com/google/cel-go/common/types/traits"
+	"helm.sh/helm/v3/pkg/chart"
+	"helm.sh/helm/v3/pkg/releaseutil"
+	"sigs.k8s.io/yaml"
+
+	"github.com/werf/werf/pkg/werf/global_warnings"
+)
+
+const (
+	// DirName is the dir of the chart with policy rules files
+	DirName = "policies"
+
+	ActionDeny = "deny"
+	ActionWarn = "warn"
+)
+
+// Rule is the policy rule for rendered resources written in the CEL language (https://github.com/google/cel-spec).
+type Rule struct {
+	Name string `json:"name"`
+	// Kinds of resources the rule is applied to, all resources by default
+	Kinds []string `json:"kinds,omitempty"`
+	// Expression is evaluated with the variables of the resource (see newEnv),
+	// the resource violates the rule when the result is true, a non-empty list, map or string
+	Expression string `json:"expression"`
+	Message    string `json:"message,omitempty"`
+	// Action is ActionDeny (default) to fail the deploy or ActionWarn to print the warning only
+	Action string `json:"action,omitempty"`
+
+	program cel.Program
+}
+
+func (r *Rule) isApplicable(kind string) bool {
+	if len(r.Kinds) == 0 {
+		return true
+	}
+
+	for _, k := range r.Kinds {
+		if k == kind {
+			return true
+		}
+	}
+
+	return false
+}
+
+type rulesFile struct {
+	Rules []*Rule `json:"rules"`
+}
+
+// LoadRules loads rules from yaml files of the policies dir of the chart.
+func LoadRules(files []*chart.File) ([]*Rule, error) {
+	var res []*Rule
+	rulesFiles := map[string]string{}
+
+	for _, file := range files {
+		if !strings.HasPrefix(file.Name, DirName+"/") {
+			continue
+		}
+
+		switch filepath.Ext(file.Name) {
+		case ".yaml", ".yml":
+		default:
+			continue
+		}
+
+		rules, err := parseRules(file.Data)
+		if err != nil {
+			return nil, fmt.Errorf("unable to load policy rules file %q: %s", file.Name, err)
+		}
+
+		for _, rule := range rules {
+			if fileName, ok := rulesFiles[rule.Name]; ok {
+				return nil, fmt.Errorf("unable to load policy rules file %q: rule %q is already defined in %q", file.Name, rule.Name, fileName)
+			}
+			rulesFiles[rule.Name] = file.Name
+		}
+
+		res = append(res, rules...)
+	}
+
+	return res, nil
+}
+
+// newEnv declares variables of the resource available in expressions:
+//   - object is the resource;
+//   - podSpec is the pod spec of the Pod or the pod template spec of the workload (CronJob, Deployment, etc.), empty for other resources;
+//   - containers are containers and init containers of the podSpec.
+func newEnv() (*cel.Env, error) {
+	return cel.NewEnv(cel.Declarations(
+		decls.NewVar("object", decls.NewMapType(decls.String, decls.Dyn)),
+		decls.NewVar("podSpec", decls.NewMapType(decls.String, decls.Dyn)),
+		decls.NewVar("containers", decls.NewListType(decls.Dyn)),
+	))
+}
+
+func parseRules(data []byte) ([]*Rule, error) {
+	var f rulesFile
+	if err := yaml.UnmarshalStrict(data, &f); err != nil {
+		return nil, err
+	}
+
+	env, err := newEnv()
+	if err != nil {
+		return nil, fmt.Errorf("unable to create CEL environment: %s", err)
+	}
+
+	for i, rule := range f.Rules {
+		if rule == nil || rule.Name == "" {
+			return nil, fmt.Errorf("name of rule #%d is required", i+1)
+		}
+
+		if rule.Expression == "" {
+			return nil, fmt.Errorf("expression of rule %q is required", rule.Name)
+		}
+
+		ast, issues := env.Compile(rule.Expression)
+		if issues != nil && issues.Err() != nil {
+			return nil, fmt.Errorf("invalid expression of rule %q: %s", rule.Name, issues.Err())
+		}
+
+		program, err := env.Program(ast)
+		if err != nil {
+			return nil, fmt.Errorf("invalid expression of rule %q: %s", rule.Name, err)
+		}
+		rule.program = program
+
+		switch rule.Action {
+		case "":
+			rule.Action = ActionDeny
+		case ActionDeny, ActionWarn:
+		default:
+			return nil, fmt.Errorf("invalid action %q of rule %q: expected %q or %q", rule.Action, rule.Name, ActionDeny, ActionWarn)
+		}
+	}
+
+	return f.Rules, nil
+}
+
+type Violation struct {
+	// Resource is Kind/name of the resource with the source template
+	Resource string
+	Rule     *Rule
+	// Result of the rule expression which is printed along with the message unless it is true
+	Result interface{}
+	// EvalErr is the evaluation error of the warn rule, evaluation errors of deny rules fail the check
+	EvalErr error
+}
+
+func (v *Violation) String() string {
+	res := fmt.Sprintf("%s: %s", v.Resource, v.Rule.Name)
+	if v.EvalErr != nil {
+		return fmt.Sprintf("%s: unable to evaluate rule: %s", res, v.EvalErr)
+	}
+
+	if v.Rule.Message != "" {
+		res = fmt.Sprintf("%s: %s", res, v.Rule.Message)
+	}
+
+	if v.Result != true {
+		if data, err := json.Marshal(v.Result); err == nil {
+			res = fmt.Sprintf("%s: %s", res, data)
+		}
+	}
+
+	return res
+}
+
+var manifestSourceRegex = regexp.MustCompile("# Source: (.*)")
+
+// Check evaluates rules against each resource of the rendered manifests and returns violations in the order of the manifests.
+// Manifests should include hooks, e.g. the output of helm template.
+func Check(manifestsData []byte, rules []*Rule) ([]*Violation, error) {
+	splitManifestsByKeys := releaseutil.SplitManifests(string(manifestsData))
+
+	manifestsKeys := make([]string, 0, len(splitManifestsByKeys))
+	for k := range splitManifestsByKeys {
+		manifestsKeys = append(manifestsKeys, k)
+	}
+	sort.Sort(releaseutil.BySplitManifestsOrder(manifestsKeys))
+
+	var res []*Violation
+	for _, manifestKey := range manifestsKeys {
+		manifestContent := splitManifestsByKeys[manifestKey]
+
+		var obj map[string]interface{}
+		if err := yaml.Unmarshal([]byte(manifestContent), &obj); err != nil {
+			return nil, fmt.Errorf("unable to parse manifest: %s\n---\n%s", err, manifestContent)
+		}
+
+		if len(obj) == 0 {
+			continue
+		}
+
+		kind, _ := obj["kind"].(string)
+		resource := kind
+		if metadata, ok := obj["metadata"].(map[string]interface{}); ok {
+			if name, ok := metadata["name"].(string); ok {
+				resource = fmt.Sprintf("%s/%s", resource, name)
+			}
+		}
+
+		if match := manifestSourceRegex.FindStringSubmatch(manifestContent); match != nil {
+			resource = fmt.Sprintf("%s (%s)", resource, match[1])
+		}
+
+		vars := newVars(obj)
+		for _, rule := range rules {
+			if !rule.isApplicable(kind) {
+				continue
+			}
+
+			result, err := rule.eval(vars)
+			if err != nil {
+				if rule.Action == ActionWarn {
+					res = append(res, &Violation{Resource: resource, Rule: rule, EvalErr: err})
+					continue
+				}
+
+				return nil, fmt.Errorf("unable to evaluate rule %q for %s: %s", rule.Name, resource, err)
+			}
+
+			if isTruthy(result) {
+				res = append(res, &Violation{Resource: resource, Rule: rule, Result: result})
+			}
+		}
+	}
+
+	return res, nil
+}
+
+func (r *Rule) eval(vars map[string]interface{}) (interface{}, error) {
+	out, _, err := r.program.Eval(vars)
+	if err != nil {
+		return nil, err
+	}
+
+	return toNative(out)
+}
+
+func newVars(obj map[string]interface{}) map[string]interface{} {
+	podSpec := map[string]interface{}{}
+	switch {
+	case getMap(obj, "spec", "jobTemplate", "spec", "template", "spec") != nil:
+		podSpec = getMap(obj, "spec", "jobTemplate", "spec", "template", "spec")
+	case getMap(obj, "spec", "template", "spec") != nil:
+		podSpec = getMap(obj, "spec", "template", "spec")
+	case obj["kind"] == "Pod" && getMap(obj, "spec") != nil:
+		podSpec = getMap(obj, "spec")
+	}
+
+	containers := []interface{}{}
+	for _, key := range []string{"containers", "initContainers"} {
+		if list, ok := podSpec[key].([]interface{}); ok {
+			containers = append(containers, list...)
+		}
+	}
+
+	return map[string]interface{}{
+		"object":     obj,
+		"podSpec":    podSpec,
+		"containers": containers,
+	}
+}
+
+func getMap(obj map[string]interface{}, keys ...string) map[string]interface{} {
+	for _, key := range keys {
+		var ok bool
+		if obj, ok = obj[key].(map[string]interface{}); !ok {
+			return nil
+		}
+	}
+	return obj
+}
+
+// toNative converts the result of the expression into the value of the yaml or json document
+func toNative(val ref.Val) (interface{}, error) {
+	switch v := val.(type) {
+	case types.Null:
+		return nil, nil
+	case traits.Mapper:
+		res := map[string]interface{}{}
+		for it := v.Iterator(); it.HasNext() == types.True; {
+			key := it.Next()
+			value, err := toNative(v.Get(key))
+			if err != nil {
+				return nil, err
+			}
+			res[fmt.Sprintf("%v", key.Value())] = value
+		}
+		return res, nil
+	case traits.Lister:
+		res := []interface{}{}
+		for it := v.Iterator(); it.HasNext() == types.True; {
+			value, err := toNative(it.Next())
+			if err != nil {
+				return nil, err
+			}
+			res = append(res, value)
+		}
+		return res, nil
+	case *types.Err:
+		return nil, v
+	default:
+		return v.Value(), nil
+	}
+}
+
+// isTruthy returns false for null, false, empty string, list and map
+func isTruthy(value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return false
+	case bool:
+		return v
+	case string:
+		return v != ""
+	case []interface{}:
+		return len(v) != 0
+	case map[string]interface{}:
+		return len(v) != 0
+	default:
+		return true
+	}
+}
+
+// ReportViolations prints warnings and returns the error when there are violations of deny rules.
+func ReportViolations(ctx context.Context, violations []*Violation) error {
+	var denied []string
+	for _, v := range violations {
+		if v.EvalErr != nil {
+			global_warnings.GlobalWarningLn(ctx, fmt.Sprintf("policy check: %s", v))
+		} else if v.Rule.Action == ActionWarn {
+			global_warnings.GlobalWarningLn(ctx, fmt.Sprintf("policy violation: %s", v))
+		} else {
+			denied = append(denied, v.String())
+		}
+	}
+
+	if len(denied) > 0 {
+		return fmt.Errorf("policy check of manifests failed:\n%s", strings.Join(denied, "\n"))
+	}
+
+	return nil
+}
@@ -0,0 +1,359 @@
+package policy
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/google/cel-go/common/types"
+	"helm.sh/helm/v3/pkg/chart"
+)
+
+func TestLoadRules(t *testing.T) {
+	tests := []struct {
+		name          string
+		files         []*chart.File
+		expectedRules []string
+		expectedErr   string
+	}{
+		{
+			name: "rules of policies dir",
+			files: []*chart.File{
+				{Name: "policies/a.yaml", Data: []byte("rules:\n- name: a\n  expression: 'true'\n")},
+				{Name: "policies/b.yml", Data: []byte("rules:\n- name: b\n  expression: 'false'\n  action: warn\n")},
+			},
+			expectedRules: []string{"a", "b"},
+		},
+		{
+			name: "files outside of policies dir and not yaml files are skipped",
+			files: []*chart.File{
+				{Name: "files/a.yaml", Data: []byte("rules:\n- name: a\n  expression: 'true'\n")},
+				{Name: "policies/README.md", Data: []byte("not a yaml")},
+				{Name: "policiesx/b.yaml", Data: []byte("rules:\n- name: b\n  expression: 'true'\n")},
+			},
+		},
+		{
+			name: "duplicated rule name",
+			files: []*chart.File{
+				{Name: "policies/a.yaml", Data: []byte("rules:\n- name: a\n  expression: 'true'\n")},
+				{Name: "policies/b.yaml", Data: []byte("rules:\n- name: a\n  expression: 'false'\n")},
+			},
+			expectedErr: `rule "a" is already defined in "policies/a.yaml"`,
+		},
+		{
+			name: "invalid expression",
+			files: []*chart.File{
+				{Name: "policies/a.yaml", Data: []byte("rules:\n- name: a\n  expression: 'object.'\n")},
+			},
+			expectedErr: `invalid expression of rule "a"`,
+		},
+		{
+			name: "undeclared variable",
+			files: []*chart.File{
+				{Name: "policies/a.yaml", Data: []byte("rules:\n- name: a\n  expression: 'resource.kind'\n")},
+			},
+			expectedErr: `invalid expression of rule "a"`,
+		},
+		{
+			name: "invalid action",
+			files: []*chart.File{
+				{Name: "policies/a.yaml", Data: []byte("rules:\n- name: a\n  expression: 'true'\n  action: block\n")},
+			},
+			expectedErr: `invalid action "block" of rule "a"`,
+		},
+		{
+			name: "missing name",
+			files: []*chart.File{
+				{Name: "policies/a.yaml", Data: []byte("rules:\n- expression: 'true'\n")},
+			},
+			expectedErr: "name of rule #1 is required",
+		},
+		{
+			name: "missing expression",
+			files: []*chart.File{
+				{Name: "policies/a.yaml", Data: []byte("rules:\n- name: a\n")},
+			},
+			expectedErr: `expression of rule "a" is required`,
+		},
+		{
+			name: "unknown field",
+			files: []*chart.File{
+				{Name: "policies/a.yaml", Data: []byte("rules:\n- name: a\n  expression: 'true'\n  kind: Pod\n")},
+			},
+			expectedErr: `unable to load policy rules file "policies/a.yaml"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rules, err := LoadRules(tt.files)
+			if tt.expectedErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.expectedErr) {
+					t.Fatalf("expected error containing %q, got %v", tt.expectedErr, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var names []string
+			for _, rule := range rules {
+				names = append(names, rule.Name)
+			}
+
+			if !reflect.DeepEqual(names, tt.expectedRules) {
+				t.Errorf("expected rules %v, got %v", tt.expectedRules, names)
+			}
+		})
+	}
+}
+
+func TestLoadRules_DefaultAction(t *testing.T) {
+	rules, err := LoadRules([]*chart.File{
+		{Name: "policies/a.yaml", Data: []byte("rules:\n- name: a\n  expression: 'true'\n")},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if rules[0].Action != ActionDeny {
+		t.Errorf("expected action %q, got %q", ActionDeny, rules[0].Action)
+	}
+}
+
+const checkManifests = `---
+# Source: mychart/templates/deployment.yaml
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: app
+spec:
+  template:
+    spec:
+      containers:
+      - name: main
+        image: app:latest
+      initContainers:
+      - name: init
+        image: init:1.0
+---
+# Source: mychart/templates/cronjob.yaml
+apiVersion: batch/v1beta1
+kind: CronJob
+metadata:
+  name: cleanup
+spec:
+  jobTemplate:
+    spec:
+      template:
+        spec:
+          containers:
+          - name: cleanup
+            image: cleanup:latest
+---
+# Source: mychart/templates/configmap.yaml
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: config
+data:
+  key: value
+---
+# Source: mychart/templates/migrate.yaml
+apiVersion: batch/v1
+kind: Job
+metadata:
+  name: migrate
+  annotations:
+    helm.sh/hook: pre-install
+spec:
+  template:
+    spec:
+      containers:
+      - name: migrate
+        image: migrate:latest
+`
+
+func TestCheck(t *testing.T) {
+	tests := []struct {
+		name               string
+		rules              string
+		expectedViolations []string
+		expectedErr        string
+	}{
+		{
+			name: "kinds filter",
+			rules: `rules:
+- name: no-latest-tag
+  kinds: [Deployment, CronJob, Job]
+  expression: "containers.filter(c, c.image.endsWith(':latest')).map(c, c.name)"
+  message: latest tag is forbidden
+`,
+			expectedViolations: []string{
+				`Deployment/app (mychart/templates/deployment.yaml): no-latest-tag: latest tag is forbidden: ["main"]`,
+				`CronJob/cleanup (mychart/templates/cronjob.yaml): no-latest-tag: latest tag is forbidden: ["cleanup"]`,
+				`Job/migrate (mychart/templates/migrate.yaml): no-latest-tag: latest tag is forbidden: ["migrate"]`,
+			},
+		},
+		{
+			name: "all kinds by default",
+			rules: `rules:
+- name: has-name
+  expression: "has(object.metadata.name)"
+`,
+			expectedViolations: []string{
+				`Deployment/app (mychart/templates/deployment.yaml): has-name`,
+				`CronJob/cleanup (mychart/templates/cronjob.yaml): has-name`,
+				`ConfigMap/config (mychart/templates/configmap.yaml): has-name`,
+				`Job/migrate (mychart/templates/migrate.yaml): has-name`,
+			},
+		},
+		{
+			name: "init containers and empty pod spec of other resources",
+			rules: `rules:
+- name: init-containers
+  expression: "containers.size() > 1"
+- name: no-pod-spec
+  kinds: [ConfigMap]
+  expression: "podSpec.size() == 0 && containers.size() == 0"
+`,
+			expectedViolations: []string{
+				`Deployment/app (mychart/templates/deployment.yaml): init-containers`,
+				`ConfigMap/config (mychart/templates/configmap.yaml): no-pod-spec`,
+			},
+		},
+		{
+			name: "evaluation error of warn rule",
+			rules: `rules:
+- name: missing-key
+  kinds: [ConfigMap]
+  expression: "object.spec.replicas > 1"
+  action: warn
+`,
+			expectedViolations: []string{
+				`ConfigMap/config (mychart/templates/configmap.yaml): missing-key: unable to evaluate rule: no such key: spec`,
+			},
+		},
+		{
+			name: "evaluation error of deny rule",
+			rules: `rules:
+- name: missing-key
+  kinds: [ConfigMap]
+  expression: "object.spec.replicas > 1"
+`,
+			expectedErr: `unable to evaluate rule "missing-key" for ConfigMap/config (mychart/templates/configmap.yaml)`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rules, err := LoadRules([]*chart.File{{Name: "policies/rules.yaml", Data: []byte(tt.rules)}})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			violations, err := Check([]byte(checkManifests), rules)
+			if tt.expectedErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.expectedErr) {
+					t.Fatalf("expected error containing %q, got %v", tt.expectedErr, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var res []string
+			for _, v := range violations {
+				res = append(res, v.String())
+			}
+
+			if !reflect.DeepEqual(res, tt.expectedViolations) {
+				t.Errorf("expected violations:\n%s\ngot:\n%s", strings.Join(tt.expectedViolations, "\n"), strings.Join(res, "\n"))
+			}
+		})
+	}
+}
+
+func TestIsTruthy(t *testing.T) {
+	tests := []struct {
+		value    interface{}
+		expected bool
+	}{
+		{nil, false},
+		{false, false},
+		{true, true},
+		{"", false},
+		{"value", true},
+		{[]interface{}{}, false},
+		{[]interface{}{"a"}, true},
+		{map[string]interface{}{}, false},
+		{map[string]interface{}{"a": 1}, true},
+		{int64(0), true},
+	}
+
+	for _, tt := range tests {
+		if res := isTruthy(tt.value); res != tt.expected {
+			t.Errorf("isTruthy(%#v): expected %v, got %v", tt.value, tt.expected, res)
+		}
+	}
+}
+
+func TestToNative(t *testing.T) {
+	env, err := newEnv()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		expression string
+		expected   interface{}
+	}{
+		{"null", nil},
+		{"true", true},
+		{"'str'", "str"},
+		{"1 + 1", int64(2)},
+		{"[1, 'a', [true]]", []interface{}{int64(1), "a", []interface{}{true}}},
+		{"{'a': {'b': [null]}, 1: 'c'}", map[string]interface{}{"a": map[string]interface{}{"b": []interface{}{nil}}, "1": "c"}},
+		{"containers.map(c, c.name)", []interface{}{"main"}},
+	}
+
+	vars := map[string]interface{}{
+		"object":     map[string]interface{}{},
+		"podSpec":    map[string]interface{}{},
+		"containers": []interface{}{map[string]interface{}{"name": "main"}},
+	}
+
+	for _, tt := range tests {
+		ast, issues := env.Compile(tt.expression)
+		if issues != nil && issues.Err() != nil {
+			t.Fatalf("%s: %s", tt.expression, issues.Err())
+		}
+
+		program, err := env.Program(ast)
+		if err != nil {
+			t.Fatalf("%s: %s", tt.expression, err)
+		}
+
+		out, _, err := program.Eval(vars)
+		if err != nil {
+			t.Fatalf("%s: %s", tt.expression, err)
+		}
+
+		res, err := toNative(out)
+		if err != nil {
+			t.Fatalf("%s: %s", tt.expression, err)
+		}
+
+		if !reflect.DeepEqual(res, tt.expected) {
+			t.Errorf("%s: expected %#v, got %#v", tt.expression, tt.expected, res)
+		}
+	}
+
+	if _, err := toNative(types.NewErr("error")); err == nil {
+		t.Errorf("expected error for error value")
+	}
+}
@@ -43,6 +43,16 @@ func GetSafeSecretManager(ctx context.Context, projectDir, helmChartDir string,
 			isSecretsExists = true
 		}
 
+		if isAccepted, err := giterminism_inspector.IsUncommittedHelmFileAccepted(defaultSecretValuesFilePath); err != nil {
+			return nil, err
+		} else if isAccepted {
+			if exists, err := util.RegularFileExists(filepath.Join(projectDir, defaultSecretValuesFilePath)); err != nil {
+				return nil, fmt.Errorf("unable to check file %s existence: %s", defaultSecretValuesFilePath, err)
+			} else if exists {
+				isSecretsExists = true
+			}
+		}
+
 		if exists, err := localGitRepo.IsCommitFileExists(ctx, commit, defaultSecretValuesFilePath); err != nil {
 			return nil, fmt.Errorf("error checking existence of the file %q in the local git repo commit %s: %s", defaultSecretValuesFilePath, commit, err)
 		} else if exists {
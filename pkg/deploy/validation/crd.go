@@ -0,0 +1,141 @@
+package validation
+
+import (
+	"fmt"
+	"strings"
+)
+
+const gvkExtension = "x-kubernetes-group-version-kind"
+
+func isCRD(obj map[string]interface{}) bool {
+	apiVersion, _ := obj["apiVersion"].(string)
+	kind, _ := obj["kind"].(string)
+	return strings.HasPrefix(apiVersion, "apiextensions.k8s.io/") && kind == "CustomResourceDefinition"
+}
+
+// crdDefinitions converts schemas of served versions of the CRD (apiextensions.k8s.io/v1 or v1beta1)
+// into OpenAPI v2 definitions the same way the apiserver publishes them.
+func crdDefinitions(crd map[string]interface{}) map[string]interface{} {
+	spec := getMap(crd, "spec")
+	group, _ := spec["group"].(string)
+	kind, _ := getMap(spec, "names")["kind"].(string)
+	if group == "" || kind == "" {
+		return nil
+	}
+
+	// v1beta1 CRD preserves unknown fields by default
+	preserveUnknownFields, ok := spec["preserveUnknownFields"].(bool)
+	if !ok {
+		apiVersion, _ := crd["apiVersion"].(string)
+		preserveUnknownFields = apiVersion == "apiextensions.k8s.io/v1beta1"
+	}
+
+	commonSchema := getMap(getMap(spec, "validation"), "openAPIV3Schema")
+
+	versions := map[string]map[string]interface{}{}
+	if version, ok := spec["version"].(string); ok {
+		versions[version] = commonSchema
+	}
+
+	versionsList, _ := spec["versions"].([]interface{})
+	for _, v := range versionsList {
+		version, _ := v.(map[string]interface{})
+		name, _ := version["name"].(string)
+		if served, ok := version["served"].(bool); name == "" || (ok && !served) {
+			delete(versions, name)
+			continue
+		}
+
+		versionSchema := getMap(getMap(version, "schema"), "openAPIV3Schema")
+		if versionSchema == nil {
+			versionSchema = commonSchema
+		}
+		versions[name] = versionSchema
+	}
+
+	res := map[string]interface{}{}
+	for version, versionSchema := range versions {
+		definition := map[string]interface{}{}
+		if versionSchema != nil && !preserveUnknownFields {
+			definition = convertSchema(versionSchema)
+		}
+
+		if properties, ok := definition["properties"].(map[string]interface{}); ok {
+			properties["apiVersion"] = map[string]interface{}{"type": "string"}
+			properties["kind"] = map[string]interface{}{"type": "string"}
+			properties["metadata"] = map[string]interface{}{"$ref": "#/definitions/io.k8s.apimachinery.pkg.apis.meta.v1.ObjectMeta"}
+		}
+
+		definition[gvkExtension] = []interface{}{
+			map[string]interface{}{"group": group, "version": version, "kind": kind},
+		}
+
+		res[crdDefinitionName(group, version, kind)] = definition
+	}
+
+	return res
+}
+
+// crdDefinitionName returns the definition name in the reversed domain notation: com.example.stable.v1.CronTab
+func crdDefinitionName(group, version, kind string) string {
+	parts := strings.Split(group, ".")
+	for i, j := 0, len(parts)-1; i < j; i, j = i+1, j-1 {
+		parts[i], parts[j] = parts[j], parts[i]
+	}
+	return fmt.Sprintf("%s.%s.%s", strings.Join(parts, "."), version, kind)
+}
+
+// convertSchema converts the OpenAPI v3 schema of the CRD to the v2 schema.
+// Fields which cannot be expressed in v2 become arbitrary and are not validated.
+func convertSchema(s map[string]interface{}) map[string]interface{} {
+	if preserve, _ := s["x-kubernetes-preserve-unknown-fields"].(bool); preserve {
+		return map[string]interface{}{}
+	}
+
+	if intOrString, _ := s["x-kubernetes-int-or-string"].(bool); intOrString {
+		return map[string]interface{}{}
+	}
+
+	for _, field := range []string{"oneOf", "anyOf", "allOf", "not"} {
+		if _, ok := s[field]; ok {
+			return map[string]interface{}{}
+		}
+	}
+
+	res := map[string]interface{}{}
+	for _, field := range []string{"type", "format", "required"} {
+		if value, ok := s[field]; ok {
+			res[field] = value
+		}
+	}
+
+	if properties, ok := s["properties"].(map[string]interface{}); ok {
+		resProperties := map[string]interface{}{}
+		for name, property := range properties {
+			if propertySchema, ok := property.(map[string]interface{}); ok {
+				resProperties[name] = convertSchema(propertySchema)
+			}
+		}
+		res["properties"] = resProperties
+	}
+
+	if items, ok := s["items"].(map[string]interface{}); ok {
+		res["items"] = convertSchema(items)
+	}
+
+	switch additionalProperties := s["additionalProperties"].(type) {
+	case map[string]interface{}:
+		res["additionalProperties"] = convertSchema(additionalProperties)
+	case bool:
+		if additionalProperties {
+			res["additionalProperties"] = map[string]interface{}{}
+		}
+	}
+
+	return res
+}
+
+func getMap(obj map[string]interface{}, field string) map[string]interface{} {
+	res, _ := obj[field].(map[string]interface{})
+	return res
+}
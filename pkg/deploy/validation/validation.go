@@ -0,0 +1,336 @@
+package validation
+
+//go:generate ../../../scripts/update_kube_schemas.sh 1.13 1.14 1.15 1.16 1.17 1.18 1.19
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/googleapis/gnostic/compiler"
+	openapi_v2 "github.com/googleapis/gnostic/openapiv2"
+	"gopkg.in/yaml.v2"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	k8s_yaml "k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/kubectl/pkg/util/openapi"
+	kubectl_validation "k8s.io/kubectl/pkg/util/openapi/validation"
+	sigs_yaml "sigs.k8s.io/yaml"
+
+	"github.com/werf/logboek"
+
+	"github.com/werf/werf/pkg/deploy/validation/kube_schemas"
+)
+
+// Bundled schemas are definitions of the Kubernetes OpenAPI spec without descriptions, they are generated into
+// kube_schemas/static.go by scripts/update_kube_schemas.sh, a schema file is named by the Kubernetes version: v1.19.json.
+
+type Options struct {
+	// KubeVersion is MAJOR.MINOR version of Kubernetes to validate against, the latest bundled version by default
+	KubeVersion string
+	// CRDFiles are files or dirs with CustomResourceDefinition manifests, custom resources are validated against schemas of these CRDs
+	CRDFiles []string
+}
+
+// GetKubeVersions returns versions of Kubernetes with bundled schemas sorted from the oldest to the latest.
+func GetKubeVersions() ([]string, error) {
+	dir, err := kube_schemas.FS(false).Open("/kube_schemas")
+	if err != nil {
+		return nil, err
+	}
+
+	infos, err := dir.Readdir(0)
+	if err != nil {
+		return nil, err
+	}
+
+	var versions []string
+	for _, info := range infos {
+		versions = append(versions, strings.TrimPrefix(strings.TrimSuffix(info.Name(), ".json"), "v"))
+	}
+
+	sort.Slice(versions, func(i, j int) bool {
+		return compareKubeVersions(versions[i], versions[j]) < 0
+	})
+
+	return versions, nil
+}
+
+// ValidateManifests validates rendered manifests against bundled Kubernetes schemas and schemas of CRDs without access to the cluster.
+// CRDs are taken from CRD files and the manifests themselves. Custom resources without CRD schemas are skipped with the warning,
+// CRDs themselves are not validated.
+func ValidateManifests(ctx context.Context, manifestsData []byte, opts Options) error {
+	kubeVersion, err := getKubeVersion(opts.KubeVersion)
+	if err != nil {
+		return err
+	}
+
+	manifests, err := parseManifests(manifestsData, "")
+	if err != nil {
+		return err
+	}
+
+	crds, err := readCRDFiles(opts.CRDFiles)
+	if err != nil {
+		return err
+	}
+
+	for _, m := range manifests {
+		if isCRD(m.Object) {
+			crds = append(crds, m)
+		}
+	}
+
+	swagger, err := kube_schemas.FSByte(false, fmt.Sprintf("/kube_schemas/v%s.json", kubeVersion))
+	if err != nil {
+		return fmt.Errorf("unable to read Kubernetes %s schemas: %s", kubeVersion, err)
+	}
+
+	resources, builtinGroups, err := newResources(swagger, crds)
+	if err != nil {
+		return fmt.Errorf("unable to load Kubernetes %s schemas: %s", kubeVersion, err)
+	}
+
+	validator := kubectl_validation.NewSchemaValidation(resources)
+
+	var problems []string
+	for _, m := range manifests {
+		// CRDs are sources of schemas, they are validated by the apiserver thoroughly
+		if isCRD(m.Object) {
+			continue
+		}
+
+		gvk := schema.FromAPIVersionAndKind(m.getString("apiVersion"), m.getString("kind"))
+
+		if gvk.Kind != "List" && resources.LookupResource(gvk) == nil {
+			if builtinGroups[gvk.Group] {
+				problems = append(problems, fmt.Sprintf("%s: apiVersion %q of kind %q is not available in Kubernetes %s", m, gvk.GroupVersion(), gvk.Kind, kubeVersion))
+			} else {
+				logboek.Context(ctx).Warn().LogF("WARNING: %s: schema of custom resource %s %q is not found, validation skipped\n", m, gvk.GroupVersion(), gvk.Kind)
+			}
+			continue
+		}
+
+		if err := validator.ValidateBytes(m.Data); err != nil {
+			var errs []error
+			if agg, ok := err.(utilerrors.Aggregate); ok {
+				errs = agg.Errors()
+			} else {
+				errs = []error{err}
+			}
+
+			for _, err := range errs {
+				problems = append(problems, fmt.Sprintf("%s: %s", m, err))
+			}
+		}
+	}
+
+	if len(problems) > 0 {
+		return fmt.Errorf("validation of manifests against Kubernetes %s schemas failed:\n%s", kubeVersion, strings.Join(problems, "\n"))
+	}
+
+	return nil
+}
+
+func getKubeVersion(version string) (string, error) {
+	versions, err := GetKubeVersions()
+	if err != nil {
+		return "", fmt.Errorf("unable to get bundled Kubernetes schemas: %s", err)
+	}
+
+	if version == "" {
+		return versions[len(versions)-1], nil
+	}
+
+	parts := strings.Split(strings.TrimPrefix(version, "v"), ".")
+	if len(parts) >= 2 {
+		majorMinor := strings.Join(parts[:2], ".")
+		for _, v := range versions {
+			if v == majorMinor {
+				return v, nil
+			}
+		}
+	}
+
+	return "", fmt.Errorf("schemas of Kubernetes %q are not bundled, supported versions: %s", version, strings.Join(versions, ", "))
+}
+
+func compareKubeVersions(a, b string) int {
+	aParts, bParts := strings.Split(a, "."), strings.Split(b, ".")
+	for i := 0; i < len(aParts) && i < len(bParts); i++ {
+		aNum, _ := strconv.Atoi(aParts[i])
+		bNum, _ := strconv.Atoi(bParts[i])
+		if aNum != bNum {
+			return aNum - bNum
+		}
+	}
+	return len(aParts) - len(bParts)
+}
+
+type manifest struct {
+	Source string
+	// Data is the manifest converted to JSON
+	Data   []byte
+	Object map[string]interface{}
+}
+
+func (m *manifest) getString(field string) string {
+	value, _ := m.Object[field].(string)
+	return value
+}
+
+func (m *manifest) String() string {
+	name := m.getString("kind")
+	if metadata, ok := m.Object["metadata"].(map[string]interface{}); ok {
+		if metadataName, ok := metadata["name"].(string); ok {
+			name = fmt.Sprintf("%s/%s", name, metadataName)
+		}
+	}
+
+	if m.Source == "" {
+		return name
+	}
+	return fmt.Sprintf("%s (%s)", name, m.Source)
+}
+
+// parseManifests parses the multi-document YAML, the source of the manifest is taken from the "# Source:" comment of helm
+func parseManifests(data []byte, source string) ([]*manifest, error) {
+	var res []*manifest
+
+	reader := k8s_yaml.NewYAMLReader(bufio.NewReader(bytes.NewReader(data)))
+	for {
+		doc, err := reader.Read()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, fmt.Errorf("unable to read manifests: %s", err)
+		}
+
+		m := &manifest{Source: source}
+		for _, line := range strings.Split(string(doc), "\n") {
+			if strings.HasPrefix(line, "# Source: ") {
+				m.Source = strings.TrimPrefix(line, "# Source: ")
+				break
+			}
+		}
+
+		m.Data, err = sigs_yaml.YAMLToJSON(doc)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse manifest %s: %s", m.Source, err)
+		}
+
+		if err := json.Unmarshal(m.Data, &m.Object); err != nil {
+			return nil, fmt.Errorf("unable to parse manifest %s: %s", m.Source, err)
+		}
+
+		if len(m.Object) == 0 {
+			continue
+		}
+
+		res = append(res, m)
+	}
+
+	return res, nil
+}
+
+func readCRDFiles(paths []string) ([]*manifest, error) {
+	var res []*manifest
+
+	for _, path := range paths {
+		if err := filepath.Walk(path, func(path string, info os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+
+			if info.IsDir() {
+				return nil
+			}
+
+			switch filepath.Ext(path) {
+			case ".yaml", ".yml", ".json":
+			default:
+				return nil
+			}
+
+			data, err := ioutil.ReadFile(path)
+			if err != nil {
+				return err
+			}
+
+			manifests, err := parseManifests(data, path)
+			if err != nil {
+				return err
+			}
+
+			for _, m := range manifests {
+				if isCRD(m.Object) {
+					res = append(res, m)
+				}
+			}
+
+			return nil
+		}); err != nil {
+			return nil, fmt.Errorf("unable to read CRD files %q: %s", path, err)
+		}
+	}
+
+	return res, nil
+}
+
+// newResources loads the Kubernetes swagger with definitions of CRDs added.
+// Groups of built-in Kubernetes resources are returned to tell removed API versions from custom resources.
+func newResources(swaggerData []byte, crds []*manifest) (openapi.Resources, map[string]bool, error) {
+	var swagger map[string]interface{}
+	if err := json.Unmarshal(swaggerData, &swagger); err != nil {
+		return nil, nil, err
+	}
+
+	definitions, _ := swagger["definitions"].(map[string]interface{})
+
+	builtinGroups := map[string]bool{}
+	for _, definition := range definitions {
+		gvks, _ := definition.(map[string]interface{})[gvkExtension].([]interface{})
+		for _, gvk := range gvks {
+			if group, ok := gvk.(map[string]interface{})["group"].(string); ok {
+				builtinGroups[group] = true
+			}
+		}
+	}
+
+	for _, crd := range crds {
+		for name, definition := range crdDefinitions(crd.Object) {
+			definitions[name] = definition
+		}
+	}
+
+	data, err := json.Marshal(swagger)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var info yaml.MapSlice
+	if err := yaml.Unmarshal(data, &info); err != nil {
+		return nil, nil, err
+	}
+
+	doc, err := openapi_v2.NewDocument(info, compiler.NewContext("$root", nil))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resources, err := openapi.NewOpenAPIData(doc)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return resources, builtinGroups, nil
+}
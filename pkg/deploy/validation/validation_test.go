@@ -0,0 +1,400 @@
+package validation
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/werf/logboek"
+)
+
+const deploymentManifest = `---
+# Source: mychart/templates/deployment.yaml
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: app
+spec:
+  replicas: 1
+  selector:
+    matchLabels:
+      app: app
+  template:
+    metadata:
+      labels:
+        app: app
+    spec:
+      containers:
+      - name: main
+        image: alpine
+`
+
+const crdV1Manifest = `---
+# Source: mychart/crds/crontab.yaml
+apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  name: crontabs.stable.example.com
+spec:
+  group: stable.example.com
+  names:
+    kind: CronTab
+    plural: crontabs
+  scope: Namespaced
+  versions:
+  - name: v1
+    served: true
+    storage: true
+    schema:
+      openAPIV3Schema:
+        type: object
+        properties:
+          spec:
+            type: object
+            required: [cronSpec]
+            properties:
+              cronSpec:
+                type: string
+              replicas:
+                type: integer
+              template:
+                type: object
+                x-kubernetes-preserve-unknown-fields: true
+  - name: v1alpha1
+    served: false
+    storage: false
+`
+
+const crdV1beta1Manifest = `---
+apiVersion: apiextensions.k8s.io/v1beta1
+kind: CustomResourceDefinition
+metadata:
+  name: backups.stable.example.com
+spec:
+  group: stable.example.com
+  version: v1
+  names:
+    kind: Backup
+    plural: backups
+  scope: Namespaced
+  validation:
+    openAPIV3Schema:
+      type: object
+      properties:
+        spec:
+          type: object
+          properties:
+            schedule:
+              type: string
+`
+
+func TestValidateManifests(t *testing.T) {
+	tests := []struct {
+		name        string
+		manifests   string
+		opts        Options
+		expectedErr []string
+	}{
+		{
+			name:      "valid manifest",
+			manifests: deploymentManifest,
+		},
+		{
+			name:      "unknown field",
+			manifests: strings.Replace(deploymentManifest, "  replicas: 1", "  replica: 1", 1),
+			expectedErr: []string{
+				"validation of manifests against Kubernetes 1.19 schemas failed",
+				`Deployment/app (mychart/templates/deployment.yaml): ValidationError(Deployment.spec): unknown field "replica"`,
+			},
+		},
+		{
+			name:      "wrong type",
+			manifests: strings.Replace(deploymentManifest, "  replicas: 1", "  replicas: \"1\"", 1),
+			expectedErr: []string{
+				`Deployment/app (mychart/templates/deployment.yaml): ValidationError(Deployment.spec.replicas): invalid type for io.k8s.api.apps.v1.DeploymentSpec.replicas: got "string", expected "integer"`,
+			},
+		},
+		{
+			name:      "missing required field",
+			manifests: strings.Replace(deploymentManifest, "      - name: main\n", "      - imagePullPolicy: Always\n", 1),
+			expectedErr: []string{
+				`ValidationError(Deployment.spec.template.spec.containers[0]): missing required field "name"`,
+			},
+		},
+		{
+			name:        "removed apiVersion",
+			manifests:   strings.Replace(deploymentManifest, "apps/v1", "extensions/v1beta1", 1),
+			opts:        Options{KubeVersion: "1.16"},
+			expectedErr: []string{`Deployment/app (mychart/templates/deployment.yaml): apiVersion "extensions/v1beta1" of kind "Deployment" is not available in Kubernetes 1.16`},
+		},
+		{
+			name:      "apiVersion available in the specified Kubernetes version",
+			manifests: strings.Replace(deploymentManifest, "apps/v1", "extensions/v1beta1", 1),
+			opts:      Options{KubeVersion: "v1.15.3"},
+		},
+		{
+			name:        "unsupported Kubernetes version",
+			manifests:   deploymentManifest,
+			opts:        Options{KubeVersion: "1.12"},
+			expectedErr: []string{`schemas of Kubernetes "1.12" are not bundled, supported versions: 1.13, 1.14, 1.15, 1.16, 1.17, 1.18, 1.19`},
+		},
+		{
+			name: "custom resource",
+			manifests: crdV1Manifest + `---
+apiVersion: stable.example.com/v1
+kind: CronTab
+metadata:
+  name: backup
+spec:
+  cronSpec: "* * * * */5"
+  replicas: 2
+  template:
+    any: field
+`,
+		},
+		{
+			name: "invalid custom resource",
+			manifests: crdV1Manifest + `---
+# Source: mychart/templates/crontab.yaml
+apiVersion: stable.example.com/v1
+kind: CronTab
+metadata:
+  name: backup
+spec:
+  replicas: "2"
+  image: alpine
+`,
+			expectedErr: []string{
+				`CronTab/backup (mychart/templates/crontab.yaml): ValidationError(CronTab.spec): unknown field "image"`,
+				`CronTab/backup (mychart/templates/crontab.yaml): ValidationError(CronTab.spec): missing required field "cronSpec"`,
+				`CronTab/backup (mychart/templates/crontab.yaml): ValidationError(CronTab.spec.replicas): invalid type for com.example.stable.v1.CronTab.spec.replicas: got "string", expected "integer"`,
+			},
+		},
+		{
+			name: "not served version of custom resource",
+			manifests: crdV1Manifest + `---
+apiVersion: stable.example.com/v1alpha1
+kind: CronTab
+metadata:
+  name: backup
+spec:
+  image: alpine
+`,
+		},
+		{
+			name: "custom resource without CRD",
+			manifests: `---
+apiVersion: stable.example.com/v1
+kind: CronTab
+metadata:
+  name: backup
+spec:
+  image: alpine
+`,
+		},
+		{
+			name: "v1beta1 CRD preserves unknown fields by default",
+			manifests: crdV1beta1Manifest + `---
+apiVersion: stable.example.com/v1
+kind: Backup
+metadata:
+  name: backup
+spec:
+  schedule: 1
+  unknown: field
+`,
+		},
+		{
+			name: "v1beta1 CRD without unknown fields",
+			manifests: strings.Replace(crdV1beta1Manifest, "  version: v1\n", "  version: v1\n  preserveUnknownFields: false\n", 1) + `---
+apiVersion: stable.example.com/v1
+kind: Backup
+metadata:
+  name: backup
+spec:
+  schedule: "0 0 * * *"
+  unknown: field
+`,
+			expectedErr: []string{`Backup/backup: ValidationError(Backup.spec): unknown field "unknown"`},
+		},
+		{
+			name: "list",
+			manifests: `---
+apiVersion: v1
+kind: List
+items:
+- apiVersion: v1
+  kind: ConfigMap
+  metadata:
+    name: config
+  data:
+    key: value
+- apiVersion: v1
+  kind: Service
+  metadata:
+    name: app
+  spec:
+    port: 80
+`,
+			expectedErr: []string{`List: ValidationError(Service.spec): unknown field "port"`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateManifests(newTestContext(), []byte(tt.manifests), tt.opts)
+			if len(tt.expectedErr) == 0 {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+
+			if err == nil {
+				t.Fatalf("expected error, got nil")
+			}
+
+			for _, expected := range tt.expectedErr {
+				if !strings.Contains(err.Error(), expected) {
+					t.Errorf("expected error containing %q, got:\n%s", expected, err)
+				}
+			}
+		})
+	}
+}
+
+func TestValidateManifests_CRDFiles(t *testing.T) {
+	dir, err := ioutil.TempDir("", "werf-validation-crds-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	for path, data := range map[string]string{
+		"crontab.yaml":      crdV1Manifest + deploymentManifest,
+		"nested/README":     "not a manifest",
+		"nested/backup.yml": strings.Replace(crdV1beta1Manifest, "  version: v1\n", "  version: v1\n  preserveUnknownFields: false\n", 1),
+	} {
+		if err := os.MkdirAll(filepath.Dir(filepath.Join(dir, path)), 0755); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := ioutil.WriteFile(filepath.Join(dir, path), []byte(data), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	manifests := `---
+apiVersion: stable.example.com/v1
+kind: CronTab
+metadata:
+  name: crontab
+spec:
+  image: alpine
+---
+apiVersion: stable.example.com/v1
+kind: Backup
+metadata:
+  name: backup
+spec:
+  unknown: field
+`
+
+	err = ValidateManifests(newTestContext(), []byte(manifests), Options{CRDFiles: []string{dir}})
+	if err == nil {
+		t.Fatalf("expected error, got nil")
+	}
+
+	for _, expected := range []string{
+		`CronTab/crontab: ValidationError(CronTab.spec): unknown field "image"`,
+		`Backup/backup: ValidationError(Backup.spec): unknown field "unknown"`,
+	} {
+		if !strings.Contains(err.Error(), expected) {
+			t.Errorf("expected error containing %q, got:\n%s", expected, err)
+		}
+	}
+
+	if err := ValidateManifests(newTestContext(), []byte(manifests), Options{CRDFiles: []string{filepath.Join(dir, "not-exist")}}); err == nil || !strings.Contains(err.Error(), "unable to read CRD files") {
+		t.Errorf("expected CRD files error, got %v", err)
+	}
+}
+
+func TestGetKubeVersions(t *testing.T) {
+	versions, err := GetKubeVersions()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{"1.13", "1.14", "1.15", "1.16", "1.17", "1.18", "1.19"}
+	if !reflect.DeepEqual(versions, expected) {
+		t.Errorf("expected %v, got %v", expected, versions)
+	}
+}
+
+func TestCrdDefinitionName(t *testing.T) {
+	if name := crdDefinitionName("stable.example.com", "v1", "CronTab"); name != "com.example.stable.v1.CronTab" {
+		t.Errorf("unexpected definition name %q", name)
+	}
+}
+
+func TestConvertSchema(t *testing.T) {
+	tests := []struct {
+		name     string
+		schema   map[string]interface{}
+		expected map[string]interface{}
+	}{
+		{
+			name: "properties, items and additional properties",
+			schema: map[string]interface{}{
+				"type":        "object",
+				"description": "skipped",
+				"required":    []interface{}{"a"},
+				"properties": map[string]interface{}{
+					"a": map[string]interface{}{"type": "array", "items": map[string]interface{}{"type": "string", "pattern": "^a"}},
+					"b": map[string]interface{}{"type": "object", "additionalProperties": map[string]interface{}{"type": "integer", "format": "int32"}},
+					"c": map[string]interface{}{"type": "object", "additionalProperties": true},
+				},
+			},
+			expected: map[string]interface{}{
+				"type":     "object",
+				"required": []interface{}{"a"},
+				"properties": map[string]interface{}{
+					"a": map[string]interface{}{"type": "array", "items": map[string]interface{}{"type": "string"}},
+					"b": map[string]interface{}{"type": "object", "additionalProperties": map[string]interface{}{"type": "integer", "format": "int32"}},
+					"c": map[string]interface{}{"type": "object", "additionalProperties": map[string]interface{}{}},
+				},
+			},
+		},
+		{
+			name:     "preserve unknown fields",
+			schema:   map[string]interface{}{"type": "object", "x-kubernetes-preserve-unknown-fields": true, "properties": map[string]interface{}{}},
+			expected: map[string]interface{}{},
+		},
+		{
+			name:     "int or string",
+			schema:   map[string]interface{}{"x-kubernetes-int-or-string": true},
+			expected: map[string]interface{}{},
+		},
+		{
+			name:     "oneOf",
+			schema:   map[string]interface{}{"type": "object", "oneOf": []interface{}{}},
+			expected: map[string]interface{}{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if res := convertSchema(tt.schema); !reflect.DeepEqual(res, tt.expected) {
+				t.Errorf("expected %#v, got %#v", tt.expected, res)
+			}
+		})
+	}
+}
+
+func newTestContext() context.Context {
+	return logboek.NewContext(context.Background(), logboek.NewLogger(ioutil.Discard, ioutil.Discard))
+}
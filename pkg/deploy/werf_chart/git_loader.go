@@ -28,6 +28,21 @@ func GiterministicFilesLoader(ctx context.Context, localGitRepo *git_repo.Local,
 		return nil, err
 	}
 
+	localFiles, err := loader.GetFilesFromLocalFilesystem(loadDir)
+	if err != nil {
+		return nil, err
+	}
+
+	// files allowed by the giterminism config are loaded from the filesystem
+	relativeLoadDir := util.GetRelativeToBaseFilepath(projectDir, loadDir)
+	for _, f := range localFiles {
+		if isAccepted, err := giterminism_inspector.IsUncommittedHelmFileAccepted(filepath.Join(relativeLoadDir, f.Name)); err != nil {
+			return nil, err
+		} else if isAccepted {
+			gitFiles = append(gitFiles, f)
+		}
+	}
+
 	for _, f := range gitFiles {
 		switch {
 		case f.Name == "Chart.lock":
@@ -47,11 +62,6 @@ func GiterministicFilesLoader(ctx context.Context, localGitRepo *git_repo.Local,
 
 	res = gitFiles
 
-	localFiles, err := loader.GetFilesFromLocalFilesystem(loadDir)
-	if err != nil {
-		return nil, err
-	}
-
 CheckUncommittedChartYaml:
 	for _, f := range localFiles {
 		if f.Name == "Chart.yaml" {
@@ -110,6 +120,7 @@ func LoadFilesFromGit(ctx context.Context, localGitRepo *git_repo.Local, project
 	// FIXME: .helmignore
 
 	relativeLoadDir := util.GetRelativeToBaseFilepath(projectDir, loadDir)
+	loadDirPath := relativeLoadDir
 
 	if isSymlink, linkDest, err := localGitRepo.CheckAndReadCommitSymlink(ctx, relativeLoadDir, commit); err != nil {
 		return nil, fmt.Errorf("error checking %s is symlink in the local git repo commit %s: %s", relativeLoadDir, commit, err)
@@ -122,6 +133,13 @@ func LoadFilesFromGit(ctx context.Context, localGitRepo *git_repo.Local, project
 
 	for _, repoPath := range repoPaths {
 		if util.IsSubpathOfBasePath(relativeLoadDir, repoPath) {
+			// files allowed by the giterminism config are loaded from the filesystem by GiterministicFilesLoader
+			if isAccepted, err := giterminism_inspector.IsUncommittedHelmFileAccepted(filepath.Join(loadDirPath, util.GetRelativeToBaseFilepath(relativeLoadDir, repoPath))); err != nil {
+				return nil, err
+			} else if isAccepted {
+				continue
+			}
+
 			if d, err := git_repo.ReadCommitFileAndCompareWithProjectFile(ctx, localGitRepo, commit, projectDir, repoPath); err != nil {
 				return nil, err
 			} else {
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"io/ioutil"
+	"path/filepath"
 
 	"github.com/werf/werf/pkg/git_repo"
 
@@ -14,7 +15,13 @@ import (
 func DecodeSecretValuesFileFromGitCommit(ctx context.Context, path string, commit string, localGitRepo *git_repo.Local, m secret.Manager, projectDir string) (map[string]interface{}, error) {
 	var data []byte
 
-	if d, err := git_repo.ReadCommitFileAndCompareWithProjectFile(ctx, localGitRepo, commit, projectDir, path); err != nil {
+	// the path is relative to the project dir
+	absPath := path
+	if !filepath.IsAbs(path) {
+		absPath = filepath.Join(projectDir, path)
+	}
+
+	if d, err := ReadValuesFile(ctx, localGitRepo, commit, projectDir, absPath); err != nil {
 		return nil, err
 	} else {
 		data = d
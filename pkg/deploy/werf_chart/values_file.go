@@ -0,0 +1,48 @@
+package werf_chart
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	"github.com/werf/werf/pkg/git_repo"
+	"github.com/werf/werf/pkg/giterminism_inspector"
+	"github.com/werf/werf/pkg/util"
+)
+
+// ReadValuesFile reads the values file specified with --values, --set-file or --secret-values option.
+// The file allowed by the giterminism config is read from the filesystem, otherwise the file should be committed.
+func ReadValuesFile(ctx context.Context, localGitRepo *git_repo.Local, commit, projectDir, path string) ([]byte, error) {
+	relPath := util.GetRelativeToBaseFilepath(projectDir, path)
+	absPath := filepath.Join(projectDir, relPath)
+
+	if isAccepted, err := giterminism_inspector.IsHelmValuesFileAccepted(relPath); err != nil {
+		return nil, err
+	} else if isAccepted {
+		data, err := ioutil.ReadFile(absPath)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read values file %s: %s", path, err)
+		}
+
+		return data, nil
+	}
+
+	isExternal := filepath.ToSlash(relPath) == ".." || strings.HasPrefix(filepath.ToSlash(relPath), "../")
+	if !isExternal {
+		if exists, err := localGitRepo.IsCommitFileExists(ctx, commit, relPath); err != nil {
+			return nil, fmt.Errorf("unable to check existence of %s in the local git repo commit %s: %s", relPath, commit, err)
+		} else if exists {
+			return git_repo.ReadCommitFileAndCompareWithProjectFile(ctx, localGitRepo, commit, projectDir, relPath)
+		}
+	}
+
+	if exists, err := util.RegularFileExists(absPath); err != nil {
+		return nil, fmt.Errorf("unable to check existence of %s: %s", absPath, err)
+	} else if !exists {
+		return nil, fmt.Errorf("values file %s not found", path)
+	}
+
+	return nil, giterminism_inspector.ReportHelmValuesFile(ctx, relPath)
+}
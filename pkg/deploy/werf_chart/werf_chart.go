@@ -22,6 +22,7 @@ import (
 	"github.com/werf/werf/pkg/config"
 	"github.com/werf/werf/pkg/deploy/helm"
 	"github.com/werf/werf/pkg/deploy/lock_manager"
+	"github.com/werf/werf/pkg/deploy/policy"
 	"github.com/werf/werf/pkg/deploy/secret"
 	"github.com/werf/werf/pkg/git_repo"
 	"github.com/werf/werf/pkg/giterminism_inspector"
@@ -94,6 +95,43 @@ func (wc *WerfChart) GetPostRenderer() (postrender.PostRenderer, error) {
 	return wc.ExtraAnnotationsAndLabelsPostRenderer, nil
 }
 
+// CheckPolicies checks the rendered manifests including hooks with policy rules of the chart.
+// Warnings are printed and the error is returned when deny rules are violated, the chart must be loaded.
+func (wc *WerfChart) CheckPolicies(ctx context.Context, manifests []byte) error {
+	if wc.HelmChart == nil {
+		return fmt.Errorf("unable to load policy rules: chart is not loaded")
+	}
+
+	rules, err := policy.LoadRules(wc.HelmChart.Files)
+	if err != nil {
+		return err
+	}
+
+	if len(rules) == 0 {
+		return nil
+	}
+
+	violations, err := policy.Check(manifests, rules)
+	if err != nil {
+		return err
+	}
+
+	return policy.ReportViolations(ctx, violations)
+}
+
+// GetSecretValuesToMask returns decoded secret values and lines of decoded secret files, which should not be printed.
+// Secrets are loaded when the chart is loaded.
+func (wc *WerfChart) GetSecretValuesToMask() []string {
+	res := append([]string{}, wc.secretValuesToMask...)
+
+	secretFilesData := map[string]interface{}{}
+	for k, v := range wc.decodedSecretFilesData {
+		secretFilesData[k] = v
+	}
+
+	return append(res, secretvalues.ExtractSecretValuesFromMap(secretFilesData)...)
+}
+
 func (wc *WerfChart) SetupChart(c *chart.Chart) error {
 	wc.HelmChart = c
 	return nil
@@ -159,6 +197,26 @@ func (wc *WerfChart) loadSecretsFromFilesystem() error {
 	return nil
 }
 
+// loadDefaultSecretValuesFromFilesystem is used when the uncommitted default secret values file is allowed by the giterminism config.
+func (wc *WerfChart) loadDefaultSecretValuesFromFilesystem() error {
+	path := filepath.Join(wc.ProjectDir, wc.ChartDir, DefaultSecretValuesFileName)
+	if exists, err := util.RegularFileExists(path); err != nil {
+		return fmt.Errorf("unable to check file %s existence: %s", path, err)
+	} else if !exists {
+		return nil
+	}
+
+	decodedValues, err := DecodeSecretValuesFileFromFilesystem(wc.Ctx, path, wc.SecretsManager)
+	if err != nil {
+		return fmt.Errorf("unable to decode secret values file %q: %s", path, err)
+	}
+
+	wc.decodedSecretValues = chartutil.CoalesceTables(decodedValues, wc.decodedSecretValues)
+	wc.secretValuesToMask = append(wc.secretValuesToMask, secretvalues.ExtractSecretValuesFromMap(decodedValues)...)
+
+	return nil
+}
+
 func (wc *WerfChart) loadSecretsFromLocalGitRepo() error {
 	var secretValuesFiles []string
 
@@ -177,7 +235,13 @@ func (wc *WerfChart) loadSecretsFromLocalGitRepo() error {
 	}
 
 	defaultSecretValuesFile := filepath.Join(chartDir, DefaultSecretValuesFileName)
-	if exists, err := wc.LocalGitRepo.IsCommitFileExists(wc.Ctx, commit, defaultSecretValuesFile); err != nil {
+	if isAccepted, err := giterminism_inspector.IsUncommittedHelmFileAccepted(filepath.Join(wc.ChartDir, DefaultSecretValuesFileName)); err != nil {
+		return err
+	} else if isAccepted {
+		if err := wc.loadDefaultSecretValuesFromFilesystem(); err != nil {
+			return err
+		}
+	} else if exists, err := wc.LocalGitRepo.IsCommitFileExists(wc.Ctx, commit, defaultSecretValuesFile); err != nil {
 		return fmt.Errorf("error checking existence of the file %q in the local git repo commit %s: %s", defaultSecretValuesFile, commit, err)
 	} else if exists {
 		logboek.Context(wc.Ctx).Debug().LogF("Check %s exists in the local git repo commit %s: FOUND\n", defaultSecretValuesFile, commit)
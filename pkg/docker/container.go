@@ -1,6 +1,8 @@
 package docker
 
 import (
+	"io"
+
 	"github.com/docker/cli/cli/command"
 	"github.com/docker/cli/cli/command/container"
 	"github.com/docker/docker/api/types"
@@ -43,6 +45,14 @@ func ContainerRemove(ctx context.Context, ref string, options types.ContainerRem
 	return apiCli(ctx).ContainerRemove(ctx, ref, options)
 }
 
+func ContainerStatPath(ctx context.Context, ref, path string) (types.ContainerPathStat, error) {
+	return apiCli(ctx).ContainerStatPath(ctx, ref, path)
+}
+
+func CopyFromContainer(ctx context.Context, ref, srcPath string) (io.ReadCloser, types.ContainerPathStat, error) {
+	return apiCli(ctx).CopyFromContainer(ctx, ref, srcPath)
+}
+
 func doCliCreate(c command.Cli, args ...string) error {
 	return prepareCliCmd(container.NewCreateCommand(c), args...).Execute()
 }
@@ -3,6 +3,7 @@ package docker
 import (
 	"fmt"
 	"io"
+	"io/ioutil"
 	"math/rand"
 	"os"
 	"strings"
@@ -13,6 +14,7 @@ import (
 	"github.com/docker/cli/cli/streams"
 	"github.com/docker/docker/api/types"
 	"github.com/docker/docker/client"
+	"github.com/docker/docker/pkg/jsonmessage"
 	"golang.org/x/net/context"
 
 	"github.com/werf/logboek"
@@ -63,6 +65,20 @@ func ImageInspect(ctx context.Context, ref string) (*types.ImageInspect, error)
 	return &inspect, nil
 }
 
+func ImageSave(ctx context.Context, refs ...string) (io.ReadCloser, error) {
+	return apiCli(ctx).ImageSave(ctx, refs)
+}
+
+func ImageLoad(ctx context.Context, input io.Reader) error {
+	response, err := apiCli(ctx).ImageLoad(ctx, input, true)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	return jsonmessage.DisplayJSONMessagesStream(response.Body, ioutil.Discard, 0, false, nil)
+}
+
 func doCliPull(c command.Cli, args ...string) error {
 	return prepareCliCmd(image.NewPullCommand(c), args...).Execute()
 }
@@ -77,6 +77,15 @@ func (api *api) GetRepoImageConfigFile(_ context.Context, reference string) (*v1
 	return imageInfo.ConfigFile()
 }
 
+func (api *api) GetRepoImageLayers(_ context.Context, reference string) ([]v1.Layer, error) {
+	imageInfo, _, err := api.image(reference)
+	if err != nil {
+		return nil, err
+	}
+
+	return imageInfo.Layers()
+}
+
 func (api *api) GetRepoImage(_ context.Context, reference string) (*image.Info, error) {
 	imageInfo, _, err := api.image(reference)
 	if err != nil {
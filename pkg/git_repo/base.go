@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"os"
 	"path"
 	"path/filepath"
@@ -105,7 +106,8 @@ func (repo *Base) GetName() string {
 	return repo.Name
 }
 
-func (repo *Base) createPatch(ctx context.Context, repoPath, gitDir, repoID, workTreeCacheDir string, opts PatchOptions) (Patch, error) {
+// createPatch creates the patch between commits, isPartialClone limits git diff by the base path to avoid fetching unrelated objects.
+func (repo *Base) createPatch(ctx context.Context, repoPath, gitDir, repoID, workTreeCacheDir string, isPartialClone bool, opts PatchOptions) (Patch, error) {
 	if patch, err := CommonGitDataManager.GetPatchFile(ctx, repoID, opts); err != nil {
 		return nil, err
 	} else if patch != nil {
@@ -142,6 +144,15 @@ func (repo *Base) createPatch(ctx context.Context, repoPath, gitDir, repoID, wor
 		return nil, err
 	}
 
+	limitByBasePath := isPartialClone
+	if limitByBasePath && hasSubmodules {
+		if isInSubmodule, err := isPathInSubmodule(toCommit, opts.BasePath); err != nil {
+			return nil, err
+		} else if isInSubmodule {
+			limitByBasePath = false
+		}
+	}
+
 	tmpFile, err := CommonGitDataManager.NewTmpFile()
 	if err != nil {
 		return nil, err
@@ -163,6 +174,8 @@ func (repo *Base) createPatch(ctx context.Context, repoPath, gitDir, repoID, wor
 		),
 		WithEntireFileContext: opts.WithEntireFileContext,
 		WithBinary:            opts.WithBinary,
+		LFS:                   makeTrueGitLFSOptions(gitDir, opts.LFS),
+		LimitByBasePath:       limitByBasePath,
 	}
 
 	var desc *true_git.PatchDescriptor
@@ -199,6 +212,34 @@ func HasSubmodulesInCommit(commit *object.Commit) (bool, error) {
 	return true, nil
 }
 
+// isPathInSubmodule checks whether the path is the submodule path or is inside the submodule of the commit.
+func isPathInSubmodule(commit *object.Commit, p string) (bool, error) {
+	file, err := commit.File(".gitmodules")
+	if err != nil {
+		return false, fmt.Errorf("unable to get .gitmodules of commit %s: %s", commit.Hash, err)
+	}
+
+	content, err := file.Contents()
+	if err != nil {
+		return false, fmt.Errorf("unable to read .gitmodules of commit %s: %s", commit.Hash, err)
+	}
+
+	modules := config.NewModules()
+	if err := modules.Unmarshal([]byte(content)); err != nil {
+		return false, fmt.Errorf("unable to parse .gitmodules of commit %s: %s", commit.Hash, err)
+	}
+
+	p = strings.TrimPrefix(filepath.ToSlash(filepath.Clean(p)), "/")
+	for _, submodule := range modules.Submodules {
+		submodulePath := path.Clean(submodule.Path)
+		if p == submodulePath || strings.HasPrefix(p, submodulePath+"/") {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
 func (repo *Base) createDetachedMergeCommit(ctx context.Context, gitDir, path, workTreeCacheDir string, fromCommit, toCommit string) (string, error) {
 	repository, err := git.PlainOpenWithOptions(path, &git.PlainOpenOptions{EnableDotGitCommonDir: true})
 	if err != nil {
@@ -289,6 +330,7 @@ func (repo *Base) createArchive(ctx context.Context, repoPath, gitDir, repoID, w
 			opts.ExcludePaths,
 			true,
 		),
+		LFS: makeTrueGitLFSOptions(gitDir, opts.LFS),
 	}
 
 	var desc *true_git.ArchiveDescriptor
@@ -830,6 +872,15 @@ func (repo *Base) checksumWithLsTree(ctx context.Context, repoPath, gitDir, work
 				})
 			}
 
+			if pathChecksum != "" && opts.LFS != nil {
+				lfsChecksum, err := lfsObjectsChecksum(ctx, worktreeDir, pathLsTreeResult)
+				if err != nil {
+					return err
+				}
+
+				pathChecksum = util.Sha256Hash(pathChecksum, lfsChecksum)
+			}
+
 			if pathChecksum != "" {
 				checksum.Hash.Write([]byte(pathChecksum))
 			} else {
@@ -846,3 +897,51 @@ func (repo *Base) checksumWithLsTree(ctx context.Context, repoPath, gitDir, work
 
 	return checksum, nil
 }
+
+func makeTrueGitLFSOptions(gitDir string, opts *LFSOptions) *true_git.LFSOptions {
+	if opts == nil {
+		return nil
+	}
+
+	return &true_git.LFSOptions{
+		StoreDir:     filepath.Join(gitDir, "lfs", "objects"),
+		ServerURL:    opts.ServerURL,
+		SSHRemoteURL: opts.SSHRemoteURL,
+	}
+}
+
+// lfsObjectsChecksum calculates checksum of Git LFS object ids of the pointer files from the ls-tree result.
+func lfsObjectsChecksum(ctx context.Context, worktreeDir string, lsTreeResult *ls_tree.Result) (string, error) {
+	var oids []string
+	if err := lsTreeResult.Walk(func(lsTreeEntry *ls_tree.LsTreeEntry) error {
+		if !lsTreeEntry.Mode.IsFile() || lsTreeEntry.Mode == filemode.Symlink {
+			return nil
+		}
+
+		absFilepath := filepath.Join(worktreeDir, lsTreeEntry.FullFilepath)
+		info, err := os.Lstat(absFilepath)
+		if err != nil {
+			return fmt.Errorf("lstat %s failed: %s", absFilepath, err)
+		}
+
+		if info.Size() > 1024 {
+			return nil
+		}
+
+		data, err := ioutil.ReadFile(absFilepath)
+		if err != nil {
+			return fmt.Errorf("unable to read file %s: %s", absFilepath, err)
+		}
+
+		if pointer := true_git.ParseLFSPointer(data); pointer != nil {
+			logboek.Context(ctx).Debug().LogF("Git LFS object was added: %s -> %s\n", lsTreeEntry.FullFilepath, pointer.Oid)
+			oids = append(oids, pointer.Oid)
+		}
+
+		return nil
+	}); err != nil {
+		return "", err
+	}
+
+	return util.Sha256Hash(oids...), nil
+}
@@ -2,6 +2,8 @@ package git_repo
 
 import (
 	"context"
+	"encoding/json"
+	"net/url"
 	"path/filepath"
 
 	"github.com/werf/werf/pkg/werf"
@@ -15,17 +17,47 @@ type PatchOptions struct {
 
 	WithEntireFileContext bool
 	WithBinary            bool
+
+	LFS *LFSOptions `json:",omitempty"`
 }
 
 type ArchiveOptions struct {
 	FilterOptions
 	Commit string
+
+	LFS *LFSOptions `json:",omitempty"`
 }
 
 type ChecksumOptions struct {
 	FilterOptions
 	Paths  []string
 	Commit string
+
+	LFS *LFSOptions `json:",omitempty"`
+}
+
+// LFSOptions enables Git LFS objects resolving.
+// Objects are taken from the local LFS store of the repository and downloaded from the ServerURL when not found.
+// SSHRemoteURL is the ssh remote url of the repository, which is used to authenticate to the default LFS server.
+type LFSOptions struct {
+	ServerURL    string
+	SSHRemoteURL string
+}
+
+// ServerURLWithoutCredentials returns the ServerURL with the userinfo removed.
+func (opts *LFSOptions) ServerURLWithoutCredentials() string {
+	u, err := url.Parse(opts.ServerURL)
+	if err != nil || u.User == nil {
+		return opts.ServerURL
+	}
+
+	u.User = nil
+	return u.String()
+}
+
+// MarshalJSON omits credentials, because options are part of the cache keys and metadata of archives, patches and checksums.
+func (opts LFSOptions) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct{ ServerURL string }{ServerURL: opts.ServerURLWithoutCredentials()})
 }
 
 type FilterOptions struct {
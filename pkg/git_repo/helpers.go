@@ -11,6 +11,8 @@ import (
 	"github.com/werf/werf/pkg/giterminism_inspector"
 
 	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/werf/werf/pkg/git_repo/status"
+	"github.com/werf/werf/pkg/path_matcher"
 	"github.com/werf/werf/pkg/util"
 )
 
@@ -69,3 +71,47 @@ func compareGitRepoFileWithProjectFile(repoFileData []byte, projectDir, relPath
 
 	return isDataIdentical, nil
 }
+
+// ReportUncommittedDirFiles reports untracked and uncommitted files of the project dir to the giterminism inspector,
+// files accepted by the optional isAcceptedFunc are skipped.
+func ReportUncommittedDirFiles(ctx context.Context, localGitRepo *Local, projectDir, relDir string, isAcceptedFunc func(path string) (bool, error)) error {
+	commit, err := localGitRepo.HeadCommit(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to get local repo head commit: %s", err)
+	}
+
+	statusResult, err := localGitRepo.Status(ctx, path_matcher.NewSimplePathMatcher(relDir, nil, false))
+	if err != nil {
+		return err
+	}
+
+	for _, relPath := range statusResult.FilePathList(status.FilterOptions{WorktreeOnly: giterminism_inspector.DevMode}) {
+		if isAcceptedFunc != nil {
+			if isAccepted, err := isAcceptedFunc(relPath); err != nil {
+				return err
+			} else if isAccepted {
+				continue
+			}
+		}
+
+		if exists, err := localGitRepo.IsCommitFileExists(ctx, commit, relPath); err != nil {
+			return fmt.Errorf("unable to check existence of %s in the local git repo commit %s: %s", relPath, commit, err)
+		} else if exists {
+			if _, err := ReadCommitFileAndCompareWithProjectFile(ctx, localGitRepo, commit, projectDir, relPath); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		if exists, err := util.RegularFileExists(filepath.Join(projectDir, relPath)); err != nil {
+			return fmt.Errorf("unable to check existence of %s: %s", relPath, err)
+		} else if exists {
+			if err := giterminism_inspector.ReportUntrackedFile(ctx, relPath); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
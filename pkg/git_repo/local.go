@@ -194,6 +194,14 @@ func (repo *Local) IsAncestor(_ context.Context, ancestorCommit, descendantCommi
 	return true_git.IsAncestor(ancestorCommit, descendantCommit, repo.GitDir)
 }
 
+func (repo *Local) ResolveCommit(_ context.Context, rev string) (string, error) {
+	return true_git.ResolveCommit(repo.GitDir, rev)
+}
+
+func (repo *Local) ChangedPaths(_ context.Context, fromCommit, toCommit string) ([]string, error) {
+	return true_git.ChangedPaths(repo.GitDir, fromCommit, toCommit)
+}
+
 func (repo *Local) RemoteOriginUrl(ctx context.Context) (string, error) {
 	return repo.remoteOriginUrl(repo.Path)
 }
@@ -203,7 +211,7 @@ func (repo *Local) HeadCommit(_ context.Context) (string, error) {
 }
 
 func (repo *Local) CreatePatch(ctx context.Context, opts PatchOptions) (Patch, error) {
-	return repo.createPatch(ctx, repo.Path, repo.GitDir, repo.getRepoID(), repo.getRepoWorkTreeCacheDir(repo.getRepoID()), opts)
+	return repo.createPatch(ctx, repo.Path, repo.GitDir, repo.getRepoID(), repo.getRepoWorkTreeCacheDir(repo.getRepoID()), false, opts)
 }
 
 func (repo *Local) CreateArchive(ctx context.Context, opts ArchiveOptions) (Archive, error) {
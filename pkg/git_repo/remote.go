@@ -3,17 +3,23 @@ package git_repo
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"os"
 	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
 	"time"
 
 	"gopkg.in/ini.v1"
 
 	"github.com/werf/werf/pkg/true_git"
+	"github.com/werf/werf/pkg/util"
 	"github.com/werf/werf/pkg/werf"
 
 	"github.com/go-git/go-git/v5"
 	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
 	"github.com/go-git/go-git/v5/plumbing/storer"
 	"github.com/go-git/go-git/v5/plumbing/transport"
 
@@ -21,12 +27,24 @@ import (
 	"github.com/werf/logboek"
 )
 
+const (
+	BloblessPartialCloneFilter = "blob:none"
+	TreelessPartialCloneFilter = "tree:0"
+)
+
 type Remote struct {
 	Base
 	Url      string
 	IsDryRun bool
 
+	// PartialCloneFilter enables partial clone of the repository (BloblessPartialCloneFilter or TreelessPartialCloneFilter).
+	// Filtered out objects are fetched on demand and work trees are checked out only for the sparse paths.
+	PartialCloneFilter string
+
 	Endpoint *transport.Endpoint
+
+	sparsePaths      []string
+	sparsePathsMutex sync.Mutex
 }
 
 func OpenRemoteRepo(name, url string) (*Remote, error) {
@@ -47,7 +65,16 @@ func (repo *Remote) ValidateEndpoint() error {
 }
 
 func (repo *Remote) CreateDetachedMergeCommit(ctx context.Context, fromCommit, toCommit string) (string, error) {
-	return repo.createDetachedMergeCommit(ctx, repo.GetClonePath(), repo.GetClonePath(), repo.getWorkTreeCacheDir(repo.getRepoID()), fromCommit, toCommit)
+	if err := repo.preparePartialCloneCommit(ctx, toCommit); err != nil {
+		return "", err
+	}
+
+	workTreeCacheDir, err := repo.prepareWorkTreeCacheDir()
+	if err != nil {
+		return "", err
+	}
+
+	return repo.createDetachedMergeCommit(ctx, repo.GetClonePath(), repo.GetClonePath(), workTreeCacheDir, fromCommit, toCommit)
 }
 
 func (repo *Remote) GetMergeCommitParents(_ context.Context, commit string) ([]string, error) {
@@ -63,9 +90,73 @@ func (repo *Remote) getFilesystemRelativePathByEndpoint() string {
 }
 
 func (repo *Remote) GetClonePath() string {
+	if repo.PartialCloneFilter != "" {
+		return filepath.Join(GetGitRepoCacheDir(), repo.getPartialCloneDirName(), repo.getFilesystemRelativePathByEndpoint())
+	}
+
 	return filepath.Join(GetGitRepoCacheDir(), repo.getFilesystemRelativePathByEndpoint())
 }
 
+func (repo *Remote) getPartialCloneDirName() string {
+	switch repo.PartialCloneFilter {
+	case BloblessPartialCloneFilter:
+		return "partial-blobless"
+	case TreelessPartialCloneFilter:
+		return "partial-treeless"
+	default:
+		return fmt.Sprintf("partial-%s", util.Sha256Hash(repo.PartialCloneFilter)[:12])
+	}
+}
+
+// AddSparsePaths adds paths which should be available in the partial clone work trees.
+// An empty path means the whole repository.
+func (repo *Remote) AddSparsePaths(paths ...string) {
+	repo.sparsePathsMutex.Lock()
+	defer repo.sparsePathsMutex.Unlock()
+
+	for _, p := range paths {
+		p = filepath.ToSlash(filepath.Clean(p))
+		if p == "." || p == "/" {
+			p = ""
+		}
+
+		repo.sparsePaths = util.UniqAppendString(repo.sparsePaths, p)
+	}
+
+	sort.Strings(repo.sparsePaths)
+}
+
+// getSparsePaths returns nil if the whole repository is needed.
+func (repo *Remote) getSparsePaths() []string {
+	repo.sparsePathsMutex.Lock()
+	defer repo.sparsePathsMutex.Unlock()
+
+	if repo.PartialCloneFilter == "" || len(repo.sparsePaths) == 0 {
+		return nil
+	}
+
+	for _, p := range repo.sparsePaths {
+		if p == "" {
+			return nil
+		}
+	}
+
+	return append([]string{}, repo.sparsePaths...)
+}
+
+// preparePartialCloneCommit fetches objects of the commit, which are needed for git operations without checkout.
+func (repo *Remote) preparePartialCloneCommit(ctx context.Context, commit string) error {
+	if repo.PartialCloneFilter == "" || repo.IsDryRun {
+		return nil
+	}
+
+	if err := true_git.FetchPartialCloneObjects(ctx, repo.GetClonePath(), commit, repo.getSparsePaths()); err != nil {
+		return fmt.Errorf("unable to fetch objects of commit %s of repo %s: %s", commit, repo.String(), err)
+	}
+
+	return nil
+}
+
 func (repo *Remote) RemoteOriginUrl() (string, error) {
 	return repo.remoteOriginUrl(repo.GetClonePath())
 }
@@ -90,6 +181,42 @@ func (repo *Remote) CloneAndFetch(ctx context.Context) error {
 	return repo.Fetch(ctx)
 }
 
+// getAuth returns basic auth for https repository if credentials are configured, otherwise ssh-agent or anonymous access is used.
+func (repo *Remote) getAuth() transport.AuthMethod {
+	if repo.Endpoint.Protocol != "https" && repo.Endpoint.Protocol != "http" {
+		return nil
+	}
+
+	if !true_git.HasHTTPSCredentials() {
+		return nil
+	}
+
+	return &httpsCredentialsAuth{}
+}
+
+// httpsCredentialsAuth sets the https credential matched by the url of each request.
+// The same auth is used for submodules, so the credential of the repository is never sent to the servers of its submodules.
+type httpsCredentialsAuth struct{}
+
+func (a *httpsCredentialsAuth) Name() string {
+	return "https-credentials-auth"
+}
+
+func (a *httpsCredentialsAuth) String() string {
+	return a.Name()
+}
+
+func (a *httpsCredentialsAuth) SetAuth(r *http.Request) {
+	repoURL := fmt.Sprintf("%s://%s%s", r.URL.Scheme, r.URL.Host, r.URL.Path)
+	for _, suffix := range []string{"/info/refs", "/git-upload-pack", "/git-receive-pack"} {
+		repoURL = strings.TrimSuffix(repoURL, suffix)
+	}
+
+	if credential := true_git.GetHTTPSCredential(repoURL); credential != nil {
+		r.SetBasicAuth(credential.Username, credential.Password)
+	}
+}
+
 func (repo *Remote) isCloneExists() (bool, error) {
 	_, err := os.Stat(repo.GetClonePath())
 	if err == nil {
@@ -141,12 +268,19 @@ func (repo *Remote) Clone(ctx context.Context) (bool, error) {
 		// Ensure cleanup on failure
 		defer os.RemoveAll(tmpPath)
 
-		_, err = git.PlainClone(tmpPath, true, &git.CloneOptions{
-			URL:               repo.Url,
-			RecurseSubmodules: git.DefaultSubmoduleRecursionDepth,
-		})
-		if err != nil {
-			return err
+		if repo.PartialCloneFilter != "" {
+			if err := true_git.PartialClone(ctx, repo.Url, tmpPath, repo.PartialCloneFilter); err != nil {
+				return err
+			}
+		} else {
+			_, err = git.PlainClone(tmpPath, true, &git.CloneOptions{
+				URL:               repo.Url,
+				Auth:              repo.getAuth(),
+				RecurseSubmodules: git.DefaultSubmoduleRecursionDepth,
+			})
+			if err != nil {
+				return err
+			}
 		}
 
 		if err := os.Rename(tmpPath, repo.GetClonePath()); err != nil {
@@ -188,7 +322,15 @@ func (repo *Remote) Fetch(ctx context.Context) error {
 
 		logboek.Context(ctx).Default().LogFDetails("Fetch remote %s of %s\n", remoteName, repo.Url)
 
-		err = rawRepo.Fetch(&git.FetchOptions{RemoteName: remoteName, Force: true, Tags: git.AllTags})
+		if repo.PartialCloneFilter != "" {
+			if err := true_git.PartialCloneFetch(ctx, repo.GetClonePath()); err != nil {
+				return fmt.Errorf("cannot fetch remote `%s` of repo `%s`: %s", remoteName, repo.String(), err)
+			}
+
+			return nil
+		}
+
+		err = rawRepo.Fetch(&git.FetchOptions{RemoteName: remoteName, Force: true, Tags: git.AllTags, Auth: repo.getAuth()})
 		if err != nil && err != git.NoErrAlreadyUpToDate {
 			return fmt.Errorf("cannot fetch remote `%s` of repo `%s`: %s", remoteName, repo.String(), err)
 		}
@@ -277,16 +419,43 @@ func (repo *Remote) TagCommit(ctx context.Context, tag string) (string, error) {
 }
 
 func (repo *Remote) CreatePatch(ctx context.Context, opts PatchOptions) (Patch, error) {
-	return repo.createPatch(ctx, repo.GetClonePath(), repo.GetClonePath(), repo.getRepoID(), repo.getWorkTreeCacheDir(repo.getRepoID()), opts)
+	if err := repo.preparePartialCloneCommit(ctx, opts.ToCommit); err != nil {
+		return nil, err
+	}
+
+	workTreeCacheDir, err := repo.prepareWorkTreeCacheDir()
+	if err != nil {
+		return nil, err
+	}
+
+	return repo.createPatch(ctx, repo.GetClonePath(), repo.GetClonePath(), repo.getRepoID(), workTreeCacheDir, repo.PartialCloneFilter != "", opts)
 }
 
 func (repo *Remote) CreateArchive(ctx context.Context, opts ArchiveOptions) (Archive, error) {
-	return repo.createArchive(ctx, repo.GetClonePath(), repo.GetClonePath(), repo.getRepoID(), repo.getWorkTreeCacheDir(repo.getRepoID()), opts)
+	if err := repo.preparePartialCloneCommit(ctx, opts.Commit); err != nil {
+		return nil, err
+	}
+
+	workTreeCacheDir, err := repo.prepareWorkTreeCacheDir()
+	if err != nil {
+		return nil, err
+	}
+
+	return repo.createArchive(ctx, repo.GetClonePath(), repo.GetClonePath(), repo.getRepoID(), workTreeCacheDir, opts)
 }
 
 func (repo *Remote) Checksum(ctx context.Context, opts ChecksumOptions) (checksum Checksum, err error) {
+	if err := repo.preparePartialCloneCommit(ctx, opts.Commit); err != nil {
+		return nil, err
+	}
+
+	workTreeCacheDir, err := repo.prepareWorkTreeCacheDir()
+	if err != nil {
+		return nil, err
+	}
+
 	logboek.Context(ctx).Debug().LogProcess("Calculating checksum").Do(func() {
-		checksum, err = repo.checksumWithLsTree(ctx, repo.GetClonePath(), repo.GetClonePath(), repo.getWorkTreeCacheDir(repo.getRepoID()), opts)
+		checksum, err = repo.checksumWithLsTree(ctx, repo.GetClonePath(), repo.GetClonePath(), workTreeCacheDir, opts)
 	})
 
 	return checksum, err
@@ -296,14 +465,95 @@ func (repo *Remote) IsCommitExists(ctx context.Context, commit string) (bool, er
 	return repo.isCommitExists(ctx, repo.GetClonePath(), repo.GetClonePath(), commit)
 }
 
+// GetCommitFilePathList returns paths of files of the commit tree, files of submodules are not listed.
+func (repo *Remote) GetCommitFilePathList(ctx context.Context, commit string) ([]string, error) {
+	tree, err := repo.getCommitTree(ctx, commit)
+	if err != nil {
+		return nil, err
+	}
+
+	var res []string
+	if err := tree.Files().ForEach(func(f *object.File) error {
+		res = append(res, f.Name)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// ReadCommitFile reads the file from the commit tree, symlinks are not resolved.
+func (repo *Remote) ReadCommitFile(ctx context.Context, commit, path string) ([]byte, error) {
+	tree, err := repo.getCommitTree(ctx, commit)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := tree.File(filepath.ToSlash(path))
+	if err != nil {
+		return nil, fmt.Errorf("unable to get file %s of commit %s: %s", path, commit, err)
+	}
+
+	content, err := f.Contents()
+	if err != nil {
+		return nil, fmt.Errorf("unable to read file %s of commit %s: %s", path, commit, err)
+	}
+
+	return []byte(content), nil
+}
+
+func (repo *Remote) getCommitTree(ctx context.Context, commit string) (*object.Tree, error) {
+	if err := repo.preparePartialCloneCommit(ctx, commit); err != nil {
+		return nil, err
+	}
+
+	repository, err := git.PlainOpenWithOptions(repo.GetClonePath(), &git.PlainOpenOptions{EnableDotGitCommonDir: true})
+	if err != nil {
+		return nil, fmt.Errorf("cannot open repo %s: %s", repo.GetClonePath(), err)
+	}
+
+	commitHash, err := newHash(commit)
+	if err != nil {
+		return nil, fmt.Errorf("bad commit hash %q: %s", commit, err)
+	}
+
+	commitObj, err := repository.CommitObject(commitHash)
+	if err != nil {
+		return nil, fmt.Errorf("bad commit %s: %s", commit, err)
+	}
+
+	return commitObj.Tree()
+}
+
 func (repo *Remote) getRepoID() string {
 	return repo.getFilesystemRelativePathByEndpoint()
 }
 
 func (repo *Remote) getWorkTreeCacheDir(repoID string) string {
+	if repo.PartialCloneFilter != "" {
+		workTreeCacheDir := filepath.Join(GetWorkTreeCacheDir(), repo.getPartialCloneDirName(), repoID)
+		if sparsePaths := repo.getSparsePaths(); sparsePaths != nil {
+			return filepath.Join(workTreeCacheDir, fmt.Sprintf("sparse-%s", util.Sha256Hash(sparsePaths...)[:12]))
+		}
+		return filepath.Join(workTreeCacheDir, "full")
+	}
+
 	return filepath.Join(GetWorkTreeCacheDir(), repoID)
 }
 
+func (repo *Remote) prepareWorkTreeCacheDir() (string, error) {
+	workTreeCacheDir := repo.getWorkTreeCacheDir(repo.getRepoID())
+
+	if sparsePaths := repo.getSparsePaths(); sparsePaths != nil {
+		if err := true_git.InitSparseWorkTreeCache(workTreeCacheDir, sparsePaths); err != nil {
+			return "", fmt.Errorf("unable to init sparse work tree cache %s: %s", workTreeCacheDir, err)
+		}
+	}
+
+	return workTreeCacheDir, nil
+}
+
 func (repo *Remote) withRemoteRepoLock(ctx context.Context, f func() error) error {
 	lockName := fmt.Sprintf("remote_git_mapping.%s", repo.Name)
 	return werf.WithHostLock(ctx, lockName, lockgate.AcquireOptions{Timeout: 600 * time.Second}, f)
@@ -0,0 +1,143 @@
+package giterminism_inspector
+
+import (
+	"fmt"
+	"sort"
+
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	ViolationUncommittedFile              = "uncommittedFile"
+	ViolationUntrackedFile                = "untrackedFile"
+	ViolationUncommittedDockerfileContext = "uncommittedDockerfileContextFile"
+	ViolationConfigEnv                    = "configEnv"
+	ViolationConfigValuesFile             = "configValuesFile"
+	ViolationConfigSetValue               = "configSetValue"
+	ViolationConfigStapelMountBuildDir    = "configStapelMountBuildDir"
+	ViolationConfigStapelMountFromPath    = "configStapelMountFromPath"
+	ViolationConfigContextAddFile         = "configDockerfileContextAddFile"
+	ViolationHelmValuesFile               = "helmValuesFile"
+	ViolationHelmSetValue                 = "helmSetValue"
+)
+
+var (
+	// CheckMode enables the collection of violations instead of failing on the first one
+	CheckMode bool
+	// CheckSource is the part of the project which is being checked, e.g. werf.yaml or the helm chart
+	CheckSource string
+	Violations  []*Violation
+)
+
+// Violation is the giterminism restriction violation collected in the check mode.
+type Violation struct {
+	Type    string `json:"type"`
+	Path    string `json:"path"`
+	Source  string `json:"source"`
+	Message string `json:"message"`
+	// Allow is the werf-giterminism.yaml snippet which allows the violation,
+	// the violation cannot be allowed by werf-giterminism.yaml if the snippet is empty
+	Allow string `json:"allow,omitempty"`
+
+	allowConfig map[string]interface{}
+}
+
+// SetAllowConfig sets the werf-giterminism.yaml directive which allows the violation, e.g. config.allowUncommitted.
+func (v *Violation) SetAllowConfig(directive []string, value interface{}) {
+	v.allowConfig = newAllowConfig(directive, value)
+	if data, err := yaml.Marshal(v.allowConfig); err != nil {
+		panic(fmt.Sprintf("unexpected error: %s", err))
+	} else {
+		v.Allow = string(data)
+	}
+}
+
+// GetViolationsAllowConfig returns werf-giterminism.yaml which allows all collected violations.
+func GetViolationsAllowConfig() (string, error) {
+	res := map[string]interface{}{}
+	for _, v := range Violations {
+		if v.allowConfig != nil {
+			mergeAllowConfig(res, v.allowConfig)
+		}
+	}
+
+	if len(res) == 0 {
+		return "", nil
+	}
+
+	data, err := yaml.Marshal(res)
+	if err != nil {
+		return "", err
+	}
+
+	return "giterminismConfigVersion: \"1\"\n" + string(data), nil
+}
+
+func reportViolation(violationType, path, message string, allowDirective []string, allowValue interface{}) {
+	if GetViolation(violationType, path) != nil {
+		return
+	}
+
+	v := &Violation{Type: violationType, Path: path, Source: CheckSource, Message: message}
+	if allowDirective != nil {
+		v.SetAllowConfig(allowDirective, allowValue)
+	}
+
+	Violations = append(Violations, v)
+}
+
+func newAllowConfig(directive []string, value interface{}) map[string]interface{} {
+	res := map[string]interface{}{directive[len(directive)-1]: value}
+	for i := len(directive) - 2; i >= 0; i-- {
+		res = map[string]interface{}{directive[i]: res}
+	}
+
+	return res
+}
+
+// mergeAllowConfig merges maps recursively, lists are merged into sorted lists of unique items.
+func mergeAllowConfig(dst, src map[string]interface{}) {
+	for key, value := range src {
+		switch v := value.(type) {
+		case map[string]interface{}:
+			dstMap, ok := dst[key].(map[string]interface{})
+			if !ok {
+				dstMap = map[string]interface{}{}
+				dst[key] = dstMap
+			}
+			mergeAllowConfig(dstMap, v)
+		case []string:
+			dstList, _ := dst[key].([]string)
+			for _, item := range v {
+				if !containsString(dstList, item) {
+					dstList = append(dstList, item)
+				}
+			}
+			sort.Strings(dstList)
+			dst[key] = dstList
+		default:
+			dst[key] = value
+		}
+	}
+}
+
+func containsString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+
+	return false
+}
+
+// GetViolation returns the collected violation or nil.
+func GetViolation(violationType, path string) *Violation {
+	for _, v := range Violations {
+		if v.Type == violationType && v.Path == path {
+			return v
+		}
+	}
+
+	return nil
+}
@@ -23,10 +23,35 @@ type config struct {
 
 type goTemplateRendering struct {
 	AllowEnvVariables []string `json:"allowEnvVariables"`
+	AllowValuesFiles  []string `json:"allowValuesFiles"`
+	// AllowSetValues restricts keys only if specified, the same way as helm.AllowSetValues
+	AllowSetValues []string `json:"allowSetValues"`
 }
 
 func (r goTemplateRendering) IsEnvNameAccepted(name string) (bool, error) {
-	for _, pattern := range r.AllowEnvVariables {
+	return isNameMatched(r.AllowEnvVariables, name)
+}
+
+func (r goTemplateRendering) IsValuesFileAccepted(path string) (bool, error) {
+	return isPathMatched(r.AllowValuesFiles, path, true)
+}
+
+func (r goTemplateRendering) IsSetValueAccepted(key string) (bool, error) {
+	return isSetValueAccepted(r.AllowSetValues, key)
+}
+
+// isSetValueAccepted accepts all keys if patterns are not specified (nil), the empty list forbids all keys.
+// Set values are explicit options of the command rather than the state of the environment, so they are not restricted by default.
+func isSetValueAccepted(patterns []string, key string) (bool, error) {
+	if patterns == nil {
+		return true, nil
+	}
+
+	return isNameMatched(patterns, key)
+}
+
+func isNameMatched(patterns []string, name string) (bool, error) {
+	for _, pattern := range patterns {
 		if strings.HasPrefix(pattern, "/") && strings.HasSuffix(pattern, "/") {
 			r, err := regexp.Compile(pattern[1 : len(pattern)-1])
 			if err != nil {
@@ -79,6 +104,21 @@ func (d dockerfile) IsUncommittedDockerignoreAccepted(path string) (bool, error)
 
 type helm struct {
 	AllowUncommittedFiles []string `json:"allowUncommittedFiles"`
+	AllowValuesFiles      []string `json:"allowValuesFiles"`
+	// AllowSetValues restricts keys only if specified
+	AllowSetValues []string `json:"allowSetValues"`
+}
+
+func (h helm) IsUncommittedFileAccepted(path string) (bool, error) {
+	return isPathMatched(h.AllowUncommittedFiles, path, true)
+}
+
+func (h helm) IsValuesFileAccepted(path string) (bool, error) {
+	return isPathMatched(h.AllowValuesFiles, path, true)
+}
+
+func (h helm) IsSetValueAccepted(key string) (bool, error) {
+	return isSetValueAccepted(h.AllowSetValues, key)
 }
 
 func isPathMatched(patterns []string, path string, withGlobs bool) (bool, error) {
@@ -0,0 +1,60 @@
+package config
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestIsSetValueAccepted(t *testing.T) {
+	tests := []struct {
+		name     string
+		config   string
+		key      string
+		expected bool
+	}{
+		{name: "all keys are allowed by default", config: "", key: "image.tag", expected: true},
+		{name: "key", config: "allowSetValues: [replicas]", key: "replicas", expected: true},
+		{name: "regexp", config: `allowSetValues: ["/^image\\./"]`, key: "image.tag", expected: true},
+		{name: "not matched key", config: `allowSetValues: [replicas, "/^image\\./"]`, key: "global.env", expected: false},
+		{name: "empty list forbids all keys", config: "allowSetValues: []", key: "replicas", expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			helmConfig := prepareTestConfig(t, "giterminismConfigVersion: \"1\"\nhelm: {"+tt.config+"}\n")
+			if accepted, err := helmConfig.Helm.IsSetValueAccepted(tt.key); err != nil {
+				t.Fatal(err)
+			} else if accepted != tt.expected {
+				t.Errorf("helm: expected %v, got %v", tt.expected, accepted)
+			}
+
+			configConfig := prepareTestConfig(t, "giterminismConfigVersion: \"1\"\nconfig: {goTemplateRendering: {"+tt.config+"}}\n")
+			if accepted, err := configConfig.Config.GoTemplateRendering.IsSetValueAccepted(tt.key); err != nil {
+				t.Fatal(err)
+			} else if accepted != tt.expected {
+				t.Errorf("config.goTemplateRendering: expected %v, got %v", tt.expected, accepted)
+			}
+		})
+	}
+}
+
+func prepareTestConfig(t *testing.T, data string) GiterminismConfig {
+	projectDir, err := ioutil.TempDir("", "werf-giterminism-config-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(projectDir)
+
+	if err := ioutil.WriteFile(filepath.Join(projectDir, "werf-giterminism.yaml"), []byte(data), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	c, err := PrepareConfig(projectDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return c
+}
@@ -47,6 +47,14 @@ definitions:
         type: array
         items:
           type: string
+      allowValuesFiles:
+        type: array
+        items:
+          type: string
+      allowSetValues:
+        type: array
+        items:
+          type: string
   ConfigStapel:
     type: object
     additionalProperties: {}
@@ -87,6 +95,14 @@ definitions:
         type: array
         items:
           type: string
+      allowValuesFiles:
+        type: array
+        items:
+          type: string
+      allowSetValues:
+        type: array
+        items:
+          type: string
 `
 )
 
@@ -3,6 +3,7 @@ package giterminism_inspector
 import (
 	"context"
 	"fmt"
+	"path/filepath"
 
 	"github.com/werf/logboek"
 	"github.com/werf/werf/pkg/giterminism_inspector/config"
@@ -52,6 +53,18 @@ func IsUncommittedDockerignoreAccepted(path string) (bool, error) {
 	return giterminismConfig.Config.Dockerfile.IsUncommittedDockerignoreAccepted(path)
 }
 
+func IsUncommittedHelmFileAccepted(path string) (bool, error) {
+	return giterminismConfig.Helm.IsUncommittedFileAccepted(path)
+}
+
+func IsConfigGoTemplateRenderingValuesFileAccepted(path string) (bool, error) {
+	return giterminismConfig.Config.GoTemplateRendering.IsValuesFileAccepted(path)
+}
+
+func IsHelmValuesFileAccepted(path string) (bool, error) {
+	return giterminismConfig.Helm.IsValuesFileAccepted(path)
+}
+
 func ReportUntrackedFile(ctx context.Context, path string) error {
 	for _, p := range ReportedUntrackedPaths {
 		if p == path {
@@ -60,6 +73,11 @@ func ReportUntrackedFile(ctx context.Context, path string) error {
 	}
 	ReportedUntrackedPaths = append(ReportedUntrackedPaths, path)
 
+	if CheckMode {
+		reportViolation(ViolationUntrackedFile, path, fmt.Sprintf("untracked file %s is not taken into account, the file should be committed", path), nil, nil)
+		return nil
+	}
+
 	if NonStrict {
 		logboek.Context(ctx).Warn().LogF("WARNING: Untracked file %s was not taken into account (more info %s)\n", path, giterminismDocPageURL)
 		return nil
@@ -76,6 +94,11 @@ func ReportUncommittedFile(ctx context.Context, path string) error {
 	}
 	ReportedUncommittedPaths = append(ReportedUncommittedPaths, path)
 
+	if CheckMode {
+		reportViolation(ViolationUncommittedFile, path, fmt.Sprintf("uncommitted changes of file %s are not taken into account, the file should be committed", path), nil, nil)
+		return nil
+	}
+
 	if NonStrict {
 		logboek.Context(ctx).Warn().LogF("WARNING: Uncommitted file %s was not taken into account (more info %s)\n", path, giterminismDocPageURL)
 		return nil
@@ -89,6 +112,11 @@ func ReportConfigStapelMountBuildDir(_ context.Context) error {
 		return nil
 	}
 
+	if CheckMode {
+		reportViolation(ViolationConfigStapelMountBuildDir, "", "'mount { from: build_dir, ... }' is forbidden", []string{"config", "stapel", "mount", "allowBuildDir"}, true)
+		return nil
+	}
+
 	return fmt.Errorf("'mount { from: build_dir, ... }' is forbidden due to enabled giterminism mode (more info %s), it is recommended to avoid this directive", giterminismDocPageURL)
 }
 
@@ -99,6 +127,11 @@ func ReportConfigStapelMountFromPath(_ context.Context, fromPath string) error {
 		return nil
 	}
 
+	if CheckMode {
+		reportViolation(ViolationConfigStapelMountFromPath, fromPath, fmt.Sprintf("'mount { fromPath: %s, ... }' is forbidden", fromPath), []string{"config", "stapel", "mount", "allowFromPaths"}, []string{fromPath})
+		return nil
+	}
+
 	return fmt.Errorf("'mount { fromPath: %s, ... }' is forbidden due to enabled giterminism mode (more info %s), it is recommended to avoid this directive", fromPath, giterminismDocPageURL)
 }
 
@@ -109,6 +142,11 @@ func ReportConfigDockerfileContextAddFile(_ context.Context, contextAddFile stri
 		return nil
 	}
 
+	if CheckMode {
+		reportViolation(ViolationConfigContextAddFile, contextAddFile, fmt.Sprintf("'contextAddFile %s' is forbidden", contextAddFile), []string{"config", "dockerfile", "allowContextAddFile"}, []string{contextAddFile})
+		return nil
+	}
+
 	return fmt.Errorf("'contextAddFile %s' is forbidden due to enabled giterminism mode (more info %s), it is recommended to avoid this directive", contextAddFile, giterminismDocPageURL)
 }
 
@@ -119,9 +157,90 @@ func ReportConfigGoTemplateRenderingEnv(_ context.Context, envName string) error
 		return nil
 	}
 
+	if CheckMode {
+		reportViolation(ViolationConfigEnv, envName, fmt.Sprintf("env name %s is forbidden", envName), []string{"config", "goTemplateRendering", "allowEnvVariables"}, []string{envName})
+		return nil
+	}
+
 	return fmt.Errorf("env name %s is forbidden due to enabled giterminism mode (more info %s)", envName, giterminismDocPageURL)
 }
 
+func ReportConfigGoTemplateRenderingValuesFile(_ context.Context, path string) error {
+	if CheckMode {
+		reportViolation(ViolationConfigValuesFile, path, fmt.Sprintf("config values file %s is not committed", path), []string{"config", "goTemplateRendering", "allowValuesFiles"}, []string{path})
+		return nil
+	}
+
+	return fmt.Errorf("config values file %s is not committed and forbidden due to enabled giterminism mode (more info %s)", path, giterminismDocPageURL)
+}
+
+func ReportConfigGoTemplateRenderingSetValue(_ context.Context, key string) error {
+	if isAccepted, err := giterminismConfig.Config.GoTemplateRendering.IsSetValueAccepted(key); err != nil {
+		return err
+	} else if isAccepted {
+		return nil
+	}
+
+	if CheckMode {
+		reportViolation(ViolationConfigSetValue, key, fmt.Sprintf("config set value %s is forbidden", key), []string{"config", "goTemplateRendering", "allowSetValues"}, []string{key})
+		return nil
+	}
+
+	return fmt.Errorf("config set value %s is forbidden due to enabled giterminism mode (more info %s)", key, giterminismDocPageURL)
+}
+
+// ReportHelmValuesFile reports the values file which is not committed to the local git repo,
+// the file is read from the filesystem only if it is allowed (see IsHelmValuesFileAccepted).
+func ReportHelmValuesFile(_ context.Context, path string) error {
+	if CheckMode {
+		reportViolation(ViolationHelmValuesFile, path, fmt.Sprintf("helm values file %s is not committed", path), []string{"helm", "allowValuesFiles"}, []string{path})
+		return nil
+	}
+
+	return fmt.Errorf("helm values file %s is not committed and forbidden due to enabled giterminism mode (more info %s)", path, giterminismDocPageURL)
+}
+
+func ReportHelmSetValue(_ context.Context, key string) error {
+	if isAccepted, err := giterminismConfig.Helm.IsSetValueAccepted(key); err != nil {
+		return err
+	} else if isAccepted {
+		return nil
+	}
+
+	if CheckMode {
+		reportViolation(ViolationHelmSetValue, key, fmt.Sprintf("helm set value %s is forbidden", key), []string{"helm", "allowSetValues"}, []string{key})
+		return nil
+	}
+
+	return fmt.Errorf("helm set value %s is forbidden due to enabled giterminism mode (more info %s)", key, giterminismDocPageURL)
+}
+
+// ReportUncommittedDockerfileContextFile reports the uncommitted file of the dockerfile image context,
+// such files are not taken into account unless they are added with contextAddFile directive.
+// The path of the file is relative to the project dir, the contextAddFile directive takes the path relative to the image context.
+func ReportUncommittedDockerfileContextFile(ctx context.Context, contextPath, path string) error {
+	if CheckMode {
+		contextAddFile := path
+		if relPath, err := filepath.Rel(contextPath, path); err == nil {
+			contextAddFile = filepath.ToSlash(relPath)
+		}
+
+		// the file is added to the context only with werf.yaml directive, which in turn is allowed by config.dockerfile.allowContextAddFile
+		reportViolation(ViolationUncommittedDockerfileContext, path, fmt.Sprintf("uncommitted changes of the dockerfile context file %s are not taken into account, the file should be committed or added to the context with werf.yaml directive 'contextAddFile: [%s]'", path, contextAddFile), nil, nil)
+		return nil
+	}
+
+	logboek.Context(ctx).Warn().LogF("WARNING: Uncommitted changes of the dockerfile context file %s were not taken into account (more info %s)\n", path, giterminismDocPageURL)
+
+	return nil
+}
+
+// ReportConfigGoTemplateRenderingRemoteTemplates reports the commit of the remote repository werf config templates are imported from,
+// the commit is pinned in werf.yaml, so imported templates are reproducible.
+func ReportConfigGoTemplateRenderingRemoteTemplates(ctx context.Context, url, commit string) {
+	logboek.Context(ctx).Info().LogF("Using werf config templates of the remote repository %s at commit %s\n", url, commit)
+}
+
 func PrintInspectionDebrief(ctx context.Context) {
 	if NonStrict {
 		if len(ReportedUncommittedPaths) > 0 || len(ReportedUntrackedPaths) > 0 {
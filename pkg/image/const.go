@@ -8,6 +8,7 @@ const (
 	WerfDevLabel                  = "werf-dev"
 	WerfDockerImageName           = "werf-docker-image-name"
 	WerfStageDigestLabel          = "werf-stage-digest"
+	WerfStageNameLabel            = "werf-stage-name"
 	WerfStageContentDigestLabel   = "werf-stage-content-digest"
 	WerfProjectRepoCommitLabel    = "werf-project-repo-commit"
 	WerfImportChecksumLabelPrefix = "werf-import-checksum-"
@@ -3,6 +3,7 @@ package image
 import (
 	"fmt"
 	"strconv"
+	"strings"
 	"time"
 )
 
@@ -31,3 +32,18 @@ func ParseUniqueIDAsTimestamp(uniqueID string) (int64, error) {
 		return timestamp, nil
 	}
 }
+
+// ParseStageID parses the stage id in the DIGEST-UNIQUEID format.
+func ParseStageID(stageID string) (*StageID, error) {
+	parts := strings.SplitN(stageID, "-", 2)
+	if len(parts) != 2 || parts[0] == "" {
+		return nil, fmt.Errorf("bad stage id %q: expected format DIGEST-UNIQUEID", stageID)
+	}
+
+	uniqueID, err := ParseUniqueIDAsTimestamp(parts[1])
+	if err != nil {
+		return nil, fmt.Errorf("bad stage id %q: unable to parse unique id %q as timestamp: %s", stageID, parts[1], err)
+	}
+
+	return &StageID{Digest: parts[0], UniqueID: uniqueID}, nil
+}
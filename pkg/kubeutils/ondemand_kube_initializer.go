@@ -0,0 +1,47 @@
+package kubeutils
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/werf/kubedog/pkg/kube"
+)
+
+// OndemandKubeInitializer initializes kube clients and kubedog on the first use.
+type OndemandKubeInitializer struct {
+	KubeContext      string
+	KubeConfig       string
+	KubeConfigBase64 string
+
+	initialized bool
+}
+
+func NewOndemandKubeInitializer(kubeContext, kubeConfig, kubeConfigBase64 string) *OndemandKubeInitializer {
+	return &OndemandKubeInitializer{
+		KubeContext:      kubeContext,
+		KubeConfig:       kubeConfig,
+		KubeConfigBase64: kubeConfigBase64,
+	}
+}
+
+func (initializer *OndemandKubeInitializer) Init(ctx context.Context) error {
+	if initializer.initialized {
+		return nil
+	}
+
+	if err := kube.Init(kube.InitOptions{KubeConfigOptions: kube.KubeConfigOptions{
+		Context:          initializer.KubeContext,
+		ConfigPath:       initializer.KubeConfig,
+		ConfigDataBase64: initializer.KubeConfigBase64,
+	}}); err != nil {
+		return fmt.Errorf("cannot initialize kube: %s", err)
+	}
+
+	if err := InitKubedog(ctx); err != nil {
+		return fmt.Errorf("cannot init kubedog: %s", err)
+	}
+
+	initializer.initialized = true
+
+	return nil
+}
@@ -0,0 +1,146 @@
+package stage_diff
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sort"
+
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/tarball"
+
+	"github.com/werf/logboek"
+
+	"github.com/werf/werf/pkg/docker"
+	"github.com/werf/werf/pkg/docker_registry"
+	"github.com/werf/werf/pkg/image"
+	"github.com/werf/werf/pkg/storage"
+	"github.com/werf/werf/pkg/werf"
+)
+
+type ChangeType string
+
+const (
+	Added    ChangeType = "added"
+	Removed  ChangeType = "removed"
+	Modified ChangeType = "modified"
+)
+
+type Change struct {
+	Type ChangeType `json:"type"`
+	Path string     `json:"path"`
+	Old  *FileInfo  `json:"old,omitempty"`
+	New  *FileInfo  `json:"new,omitempty"`
+}
+
+// Differ calculates filesystem changes between stages reading the stages images layers:
+// layers of the local stages are taken from the saved docker images, layers of the repo stages are pulled from the registry.
+// No containers are run.
+type Differ struct {
+	StagesStorage storage.StagesStorage
+
+	layersReader *layersReader
+	tmpFiles     []string
+}
+
+func NewDiffer(stagesStorage storage.StagesStorage) *Differ {
+	return &Differ{StagesStorage: stagesStorage, layersReader: newLayersReader()}
+}
+
+// Diff returns changes of the filesystem of stage b relative to stage a sorted by path.
+// Nil stage a means the empty filesystem.
+func (d *Differ) Diff(ctx context.Context, a, b *image.StageDescription) ([]*Change, error) {
+	var filesA map[string]*FileInfo
+	if a != nil {
+		files, err := d.stageFilesystem(ctx, a)
+		if err != nil {
+			return nil, err
+		}
+		filesA = files
+	}
+
+	filesB, err := d.stageFilesystem(ctx, b)
+	if err != nil {
+		return nil, err
+	}
+
+	return DiffFilesystems(filesA, filesB), nil
+}
+
+func DiffFilesystems(filesA, filesB map[string]*FileInfo) []*Change {
+	var changes []*Change
+	for p, fileB := range filesB {
+		if fileA, hasKey := filesA[p]; !hasKey {
+			changes = append(changes, &Change{Type: Added, Path: p, New: fileB})
+		} else if !fileA.equal(fileB) {
+			changes = append(changes, &Change{Type: Modified, Path: p, Old: fileA, New: fileB})
+		}
+	}
+
+	for p, fileA := range filesA {
+		if _, hasKey := filesB[p]; !hasKey {
+			changes = append(changes, &Change{Type: Removed, Path: p, Old: fileA})
+		}
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Path < changes[j].Path
+	})
+
+	return changes
+}
+
+func (d *Differ) stageFilesystem(ctx context.Context, desc *image.StageDescription) (map[string]*FileInfo, error) {
+	var layers []v1.Layer
+	if err := logboek.Context(ctx).Info().LogProcess("Reading layers of stage %s", desc.StageID.String()).DoError(func() error {
+		var err error
+		layers, err = d.stageLayers(ctx, desc)
+		if err != nil {
+			return err
+		}
+
+		return nil
+	}); err != nil {
+		return nil, fmt.Errorf("unable to get layers of stage %s: %s", desc.StageID.String(), err)
+	}
+
+	return d.layersReader.Filesystem(layers)
+}
+
+func (d *Differ) stageLayers(ctx context.Context, desc *image.StageDescription) ([]v1.Layer, error) {
+	if d.StagesStorage.Address() != storage.LocalStorageAddress {
+		return docker_registry.API().GetRepoImageLayers(ctx, desc.Info.Name)
+	}
+
+	tmpFile, err := ioutil.TempFile(werf.GetTmpDir(), "werf-stage-diff-")
+	if err != nil {
+		return nil, fmt.Errorf("unable to create tmp file: %s", err)
+	}
+	d.tmpFiles = append(d.tmpFiles, tmpFile.Name())
+	defer tmpFile.Close()
+
+	rc, err := docker.ImageSave(ctx, desc.Info.ID)
+	if err != nil {
+		return nil, fmt.Errorf("unable to save image %s: %s", desc.Info.Name, err)
+	}
+	defer rc.Close()
+
+	if _, err := tmpFile.ReadFrom(rc); err != nil {
+		return nil, fmt.Errorf("unable to save image %s: %s", desc.Info.Name, err)
+	}
+
+	img, err := tarball.ImageFromPath(tmpFile.Name(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("unable to open saved image %s: %s", desc.Info.Name, err)
+	}
+
+	return img.Layers()
+}
+
+// Cleanup removes saved images of the local stages.
+func (d *Differ) Cleanup() {
+	for _, p := range d.tmpFiles {
+		os.RemoveAll(p)
+	}
+}
@@ -0,0 +1,170 @@
+package stage_diff
+
+import (
+	"archive/tar"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"strings"
+
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+)
+
+const (
+	whiteoutPrefix       = ".wh."
+	whiteoutOpaqueMarker = ".wh..wh..opq"
+)
+
+type FileInfo struct {
+	Path     string      `json:"path"`
+	Mode     os.FileMode `json:"mode"`
+	Size     int64       `json:"size"`
+	Uid      int         `json:"uid"`
+	Gid      int         `json:"gid"`
+	Linkname string      `json:"linkname,omitempty"`
+	Digest   string      `json:"digest,omitempty"`
+}
+
+func (f *FileInfo) equal(other *FileInfo) bool {
+	return f.Mode == other.Mode && f.Size == other.Size && f.Uid == other.Uid && f.Gid == other.Gid && f.Linkname == other.Linkname && f.Digest == other.Digest
+}
+
+type layerEntryType int
+
+const (
+	layerEntryFile layerEntryType = iota
+	layerEntryWhiteout
+	layerEntryOpaqueDir
+)
+
+type layerEntry struct {
+	Type layerEntryType
+	Path string
+	File *FileInfo
+}
+
+// layersReader reads every layer only once, layers shared by both stages are the common case.
+type layersReader struct {
+	entriesByDiffID map[string][]*layerEntry
+}
+
+func newLayersReader() *layersReader {
+	return &layersReader{entriesByDiffID: map[string][]*layerEntry{}}
+}
+
+// Filesystem returns files of the image filesystem built by applying the layers in order.
+func (r *layersReader) Filesystem(layers []v1.Layer) (map[string]*FileInfo, error) {
+	files := map[string]*FileInfo{}
+
+	for _, layer := range layers {
+		entries, err := r.layerEntries(layer)
+		if err != nil {
+			return nil, err
+		}
+
+		layerPaths := map[string]bool{}
+		for _, entry := range entries {
+			switch entry.Type {
+			case layerEntryOpaqueDir:
+				for filePath := range files {
+					if isSubpath(entry.Path, filePath) && filePath != entry.Path && !layerPaths[filePath] {
+						delete(files, filePath)
+					}
+				}
+			case layerEntryWhiteout:
+				for filePath := range files {
+					if isSubpath(entry.Path, filePath) {
+						delete(files, filePath)
+					}
+				}
+			case layerEntryFile:
+				files[entry.Path] = entry.File
+				layerPaths[entry.Path] = true
+			}
+		}
+	}
+
+	return files, nil
+}
+
+func (r *layersReader) layerEntries(layer v1.Layer) ([]*layerEntry, error) {
+	diffID, err := layer.DiffID()
+	if err != nil {
+		return nil, fmt.Errorf("unable to get layer diff id: %s", err)
+	}
+
+	if entries, hasKey := r.entriesByDiffID[diffID.String()]; hasKey {
+		return entries, nil
+	}
+
+	entries, err := readLayerEntries(layer)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read layer %s: %s", diffID, err)
+	}
+
+	r.entriesByDiffID[diffID.String()] = entries
+
+	return entries, nil
+}
+
+func readLayerEntries(layer v1.Layer) ([]*layerEntry, error) {
+	rc, err := layer.Uncompressed()
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+
+	var entries []*layerEntry
+	tr := tar.NewReader(rc)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		entryPath := path.Join("/", header.Name)
+		entryDir, entryBase := path.Split(entryPath)
+		entryDir = path.Clean(entryDir)
+
+		switch {
+		case entryBase == whiteoutOpaqueMarker:
+			entries = append(entries, &layerEntry{Type: layerEntryOpaqueDir, Path: entryDir})
+			continue
+		case strings.HasPrefix(entryBase, whiteoutPrefix):
+			entries = append(entries, &layerEntry{Type: layerEntryWhiteout, Path: path.Join(entryDir, strings.TrimPrefix(entryBase, whiteoutPrefix))})
+			continue
+		}
+
+		file := &FileInfo{
+			Path:     entryPath,
+			Mode:     header.FileInfo().Mode(),
+			Uid:      header.Uid,
+			Gid:      header.Gid,
+			Linkname: header.Linkname,
+		}
+
+		if header.Typeflag == tar.TypeReg || header.Typeflag == tar.TypeRegA {
+			h := sha256.New()
+			size, err := io.Copy(h, tr)
+			if err != nil {
+				return nil, err
+			}
+
+			file.Size = size
+			file.Digest = fmt.Sprintf("%x", h.Sum(nil))
+		}
+
+		entries = append(entries, &layerEntry{Type: layerEntryFile, Path: entryPath, File: file})
+	}
+
+	return entries, nil
+}
+
+func isSubpath(basePath, p string) bool {
+	return p == basePath || basePath == "/" || strings.HasPrefix(p, basePath+"/")
+}
@@ -0,0 +1,195 @@
+package stage_inspect
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"time"
+
+	"github.com/werf/werf/pkg/image"
+	"github.com/werf/werf/pkg/storage/manager"
+)
+
+var gitMappingLabelRegexp = regexp.MustCompile(`^werf-git-([0-9a-f]+)-(commit|virtual-merge|virtual-merge-from-commit|virtual-merge-into-commit)$`)
+
+type GitMapping struct {
+	ParamsHash             string `json:"paramsHash"`
+	Commit                 string `json:"commit,omitempty"`
+	VirtualMerge           bool   `json:"virtualMerge"`
+	VirtualMergeFromCommit string `json:"virtualMergeFromCommit,omitempty"`
+	VirtualMergeIntoCommit string `json:"virtualMergeIntoCommit,omitempty"`
+}
+
+// ImageMetadata is the image metadata record referencing the stage: the stage has been the last stage of the image for the commits.
+type ImageMetadata struct {
+	ImageName string   `json:"imageName"`
+	Commits   []string `json:"commits"`
+}
+
+type Stage struct {
+	StageID           string            `json:"stageID"`
+	StageName         string            `json:"stageName,omitempty"`
+	DockerImageName   string            `json:"dockerImageName"`
+	ImageID           string            `json:"imageID"`
+	ParentStageID     string            `json:"parentStageID,omitempty"`
+	ParentImageID     string            `json:"parentImageID,omitempty"`
+	CreatedAt         time.Time         `json:"createdAt"`
+	Size              int64             `json:"size"`
+	WerfVersion       string            `json:"werfVersion,omitempty"`
+	ProjectRepoCommit string            `json:"projectRepoCommit,omitempty"`
+	GitMappings       []*GitMapping     `json:"gitMappings,omitempty"`
+	ImageMetadata     []*ImageMetadata  `json:"imageMetadata,omitempty"`
+	Labels            map[string]string `json:"labels,omitempty"`
+}
+
+func (s *Stage) HasVirtualMerge() bool {
+	for _, gm := range s.GitMappings {
+		if gm.VirtualMerge {
+			return true
+		}
+	}
+
+	return false
+}
+
+// GetStages returns all stages of the project from the stages storage sorted by creation time, the newest first.
+// Image metadata records of the images from imageNameList are reported with image names, records of other images are reported with image name ids.
+func GetStages(ctx context.Context, storageManager *manager.StorageManager, imageNameList []string) ([]*Stage, error) {
+	descs, err := storageManager.GetStageDescriptionList(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get stages from %s: %s", storageManager.StagesStorage.String(), err)
+	}
+
+	imageMetadataByImageName, imageMetadataByNotManagedImageName, err := storageManager.StagesStorage.GetAllAndGroupImageMetadataByImageName(ctx, storageManager.ProjectName, imageNameList)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get image metadata from %s: %s", storageManager.StagesStorage.String(), err)
+	}
+
+	imageMetadataByStageID := map[string][]*ImageMetadata{}
+	for _, imageMetadata := range []map[string]map[string][]string{imageMetadataByImageName, imageMetadataByNotManagedImageName} {
+		for imageName, commitListByStageID := range imageMetadata {
+			for stageID, commitList := range commitListByStageID {
+				imageMetadataByStageID[stageID] = append(imageMetadataByStageID[stageID], &ImageMetadata{ImageName: imageName, Commits: commitList})
+			}
+		}
+	}
+
+	stageIDByImageRef := map[string]string{}
+	for _, desc := range descs {
+		stageIDByImageRef[desc.Info.ID] = desc.StageID.String()
+		stageIDByImageRef[desc.Info.Name] = desc.StageID.String()
+	}
+
+	var stages []*Stage
+	for _, desc := range descs {
+		stg := newStage(desc)
+
+		if desc.Info.ParentID != "" {
+			if parentStageID, hasKey := stageIDByImageRef[desc.Info.ParentID]; hasKey {
+				stg.ParentStageID = parentStageID
+			} else {
+				stg.ParentImageID = desc.Info.ParentID
+			}
+		}
+
+		stg.ImageMetadata = imageMetadataByStageID[stg.StageID]
+		sort.Slice(stg.ImageMetadata, func(i, j int) bool {
+			return stg.ImageMetadata[i].ImageName < stg.ImageMetadata[j].ImageName
+		})
+
+		stages = append(stages, stg)
+	}
+
+	sort.Slice(stages, func(i, j int) bool {
+		return stages[i].CreatedAt.After(stages[j].CreatedAt)
+	})
+
+	return stages, nil
+}
+
+// ImageStages returns stages of the image: stages referenced by image metadata records of the image and their parent stages.
+// Intermediate stages of the image which have never been the last stage of the image cannot be detected this way.
+func ImageStages(stages []*Stage, imageName string) []*Stage {
+	stageByID := map[string]*Stage{}
+	for _, stg := range stages {
+		stageByID[stg.StageID] = stg
+	}
+
+	imageStageIDs := map[string]bool{}
+	for _, stg := range stages {
+		if !stg.IsReferencedByImage(imageName) {
+			continue
+		}
+
+		for s := stg; s != nil && !imageStageIDs[s.StageID]; s = stageByID[s.ParentStageID] {
+			imageStageIDs[s.StageID] = true
+		}
+	}
+
+	var res []*Stage
+	for _, stg := range stages {
+		if imageStageIDs[stg.StageID] {
+			res = append(res, stg)
+		}
+	}
+
+	return res
+}
+
+func (s *Stage) IsReferencedByImage(imageName string) bool {
+	for _, imageMetadata := range s.ImageMetadata {
+		if imageMetadata.ImageName == imageName {
+			return true
+		}
+	}
+
+	return false
+}
+
+func newStage(desc *image.StageDescription) *Stage {
+	stg := &Stage{
+		StageID:           desc.StageID.String(),
+		StageName:         desc.Info.Labels[image.WerfStageNameLabel],
+		DockerImageName:   desc.Info.Name,
+		ImageID:           desc.Info.ID,
+		CreatedAt:         desc.Info.GetCreatedAt(),
+		Size:              desc.Info.Size,
+		WerfVersion:       desc.Info.Labels[image.WerfVersionLabel],
+		ProjectRepoCommit: desc.Info.Labels[image.WerfProjectRepoCommitLabel],
+		Labels:            desc.Info.Labels,
+	}
+
+	gitMappingByParamsHash := map[string]*GitMapping{}
+	for label, value := range desc.Info.Labels {
+		match := gitMappingLabelRegexp.FindStringSubmatch(label)
+		if match == nil {
+			continue
+		}
+
+		paramsHash := match[1]
+		gm, hasKey := gitMappingByParamsHash[paramsHash]
+		if !hasKey {
+			gm = &GitMapping{ParamsHash: paramsHash}
+			gitMappingByParamsHash[paramsHash] = gm
+			stg.GitMappings = append(stg.GitMappings, gm)
+		}
+
+		switch match[2] {
+		case "commit":
+			gm.Commit = value
+		case "virtual-merge":
+			gm.VirtualMerge = value == "true"
+		case "virtual-merge-from-commit":
+			gm.VirtualMergeFromCommit = value
+		case "virtual-merge-into-commit":
+			gm.VirtualMergeIntoCommit = value
+		}
+	}
+
+	sort.Slice(stg.GitMappings, func(i, j int) bool {
+		return stg.GitMappings[i].ParamsHash < stg.GitMappings[j].ParamsHash
+	})
+
+	return stg
+}
@@ -22,6 +22,7 @@ import (
 type ArchiveOptions struct {
 	Commit      string
 	PathMatcher path_matcher.PathMatcher
+	LFS         *LFSOptions
 }
 
 type ArchiveDescriptor struct {
@@ -156,24 +157,50 @@ func writeArchive(ctx context.Context, out io.Writer, gitDir, workTreeCacheDir s
 
 		switch gitFileMode {
 		case filemode.Regular, filemode.Executable, filemode.Deprecated:
+			size := info.Size()
+
+			var f io.ReadCloser
+			f, err = os.Open(absFilepath)
+			if err != nil {
+				return fmt.Errorf("unable to open file %s: %s", absFilepath, err)
+			}
+
+			if opts.LFS != nil && size <= lfsPointerMaxSize {
+				data, err := ioutil.ReadAll(f)
+				if err != nil {
+					return fmt.Errorf("unable to read file %s: %s", absFilepath, err)
+				}
+				f.Close()
+
+				if pointer := ParseLFSPointer(data); pointer != nil {
+					f, err = opts.LFS.OpenObject(ctx, pointer)
+					if err != nil {
+						return fmt.Errorf("unable to get Git LFS object for file %s: %s", lsTreeEntry.FullFilepath, err)
+					}
+					size = pointer.Size
+
+					if debugArchive() {
+						logboek.Context(ctx).Debug().LogF("Resolved Git LFS object %s for file '%s'\n", pointer.Oid, relToBasePathFilepath)
+					}
+				} else {
+					f = ioutil.NopCloser(bytes.NewReader(data))
+				}
+			}
+
 			err = tw.WriteHeader(&tar.Header{
 				Format:     tar.FormatGNU,
 				Name:       tarEntryName,
 				Mode:       int64(gitFileMode),
-				Size:       info.Size(),
+				Size:       size,
 				ModTime:    info.ModTime(),
 				AccessTime: info.ModTime(),
 				ChangeTime: info.ModTime(),
 			})
 			if err != nil {
+				f.Close()
 				return fmt.Errorf("unable to write tar header for file %s: %s", tarEntryName, err)
 			}
 
-			f, err := os.Open(absFilepath)
-			if err != nil {
-				return fmt.Errorf("unable to open file %s: %s", absFilepath, err)
-			}
-
 			_, err = io.Copy(tw, f)
 			if err != nil {
 				return fmt.Errorf("unable to write data to tar archive from file %s: %s", absFilepath, err)
@@ -0,0 +1,44 @@
+package true_git
+
+import (
+	"fmt"
+	"os/exec"
+	"strings"
+)
+
+// ResolveCommit returns the commit the revision (branch, tag, commit or an expression like HEAD~1) points to.
+func ResolveCommit(gitDir, rev string) (string, error) {
+	gitArgs := []string{"-C", gitDir, "rev-parse", "--verify", "--quiet", rev + "^{commit}"}
+	cmd := gitCommand(gitArgs...)
+
+	output, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("unable to resolve revision %q to commit: %s", rev, err)
+	}
+
+	return strings.TrimSpace(string(output)), nil
+}
+
+// ChangedPaths returns paths of files that differ between the commits.
+// Renamed files are reported with both old and new paths.
+func ChangedPaths(gitDir, fromCommit, toCommit string) ([]string, error) {
+	gitArgs := []string{"-C", gitDir, "diff", "--name-only", "--no-renames", "-z", fromCommit, toCommit}
+	cmd := gitCommand(gitArgs...)
+
+	output, err := cmd.Output()
+	if err != nil {
+		if exitError, ok := err.(*exec.ExitError); ok {
+			return nil, fmt.Errorf("%s failed: %s:\n%s", strings.Join(append([]string{"git"}, gitArgs...), " "), err, exitError.Stderr)
+		}
+		return nil, fmt.Errorf("%s failed: %s", strings.Join(append([]string{"git"}, gitArgs...), " "), err)
+	}
+
+	var res []string
+	for _, p := range strings.Split(string(output), "\x00") {
+		if p != "" {
+			res = append(res, p)
+		}
+	}
+
+	return res, nil
+}
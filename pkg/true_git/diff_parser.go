@@ -19,6 +19,7 @@ func makeDiffParser(out io.Writer, pathMatcher path_matcher.PathMatcher) *diffPa
 		OutLines:    0,
 		Paths:       make([]string, 0),
 		BinaryPaths: make([]string, 0),
+		FullPaths:   make(map[string]string),
 		state:       unrecognized,
 		lineBuf:     make([]byte, 0, 4096),
 	}
@@ -47,6 +48,9 @@ type diffParser struct {
 	BinaryPaths   []string
 	LastSeenPaths []string
 
+	// FullPaths maps trimmed Paths to the paths relative to the repository root
+	FullPaths map[string]string
+
 	state   parserState
 	lineBuf []byte
 }
@@ -265,6 +269,7 @@ func (p *diffParser) handleDiffBegin(line string) error {
 
 			newPath := p.trimFileBaseFilepath(path)
 			p.Paths = appendUnique(p.Paths, newPath)
+			p.FullPaths[newPath] = path
 			p.LastSeenPaths = appendUnique(p.LastSeenPaths, newPath)
 
 			newPathWithPrefix := data.Prefix + newPath
@@ -278,6 +283,7 @@ func (p *diffParser) handleDiffBegin(line string) error {
 
 			newPath := p.trimFileBaseFilepath(path)
 			p.Paths = appendUnique(p.Paths, newPath)
+			p.FullPaths[newPath] = path
 			p.LastSeenPaths = appendUnique(p.LastSeenPaths, newPath)
 
 			trimmedPaths[data.PathWithPrefix] = data.Prefix + newPath
@@ -12,8 +12,10 @@ import (
 )
 
 const (
-	MinGitVersionConstraintValue               = "1.9"
-	MinGitVersionWithSubmodulesConstraintValue = "2.14"
+	MinGitVersionConstraintValue                     = "1.9"
+	MinGitVersionWithSubmodulesConstraintValue       = "2.14"
+	MinGitVersionWithPartialCloneConstraintValue     = "2.22"
+	MinGitVersionWithHTTPSCredentialsConstraintValue = "2.31"
 )
 
 var (
@@ -26,6 +28,8 @@ var (
 	minGitVersionErrorMsg       = fmt.Sprintf("Git version >= %s required", MinGitVersionConstraintValue)
 	forbiddenGitVersionErrorMsg = fmt.Sprintf("Forbidden git versions: %s", strings.Join(ForbiddenGitVersionsConstraintValues, ", "))
 	submodulesVersionErrorMsg   = fmt.Sprintf("To use git submodules install git >= %s", MinGitVersionWithSubmodulesConstraintValue)
+	partialCloneVersionErrorMsg = fmt.Sprintf("To use partial clone install git >= %s", MinGitVersionWithPartialCloneConstraintValue)
+	httpsCredentialsErrorMsg    = fmt.Sprintf("To use git https credentials install git >= %s", MinGitVersionWithHTTPSCredentialsConstraintValue)
 
 	liveGitOutput bool
 )
@@ -150,3 +154,41 @@ func checkSubmoduleConstraint() error {
 
 	return nil
 }
+
+// CheckPartialCloneConstraint checks git version before enabling partial clone filters:
+// filters are available since 2.19 (tree:0 since 2.20), missing objects are fetched in batches by diff and checkout since 2.22.
+func CheckPartialCloneConstraint() error {
+	constraint, err := semver.NewConstraint(fmt.Sprintf(">= %s", MinGitVersionWithPartialCloneConstraintValue))
+	if err != nil {
+		panic(err)
+	}
+
+	if !constraint.Check(gitVersion) {
+		errMsg := strings.Join([]string{
+			strings.ToLower(partialCloneVersionErrorMsg),
+			fmt.Sprintf("Your git version is %s", gitVersion.String()),
+		}, ".\n")
+
+		return errors.New(errMsg)
+	}
+
+	return nil
+}
+
+func checkHTTPSCredentialsConstraint() error {
+	constraint, err := semver.NewConstraint(fmt.Sprintf(">= %s", MinGitVersionWithHTTPSCredentialsConstraintValue))
+	if err != nil {
+		panic(err)
+	}
+
+	if !constraint.Check(gitVersion) {
+		errMsg := strings.Join([]string{
+			strings.ToLower(httpsCredentialsErrorMsg),
+			fmt.Sprintf("Your git version is %s", gitVersion.String()),
+		}, ".\n")
+
+		return errors.New(errMsg)
+	}
+
+	return nil
+}
@@ -0,0 +1,402 @@
+package true_git
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/go-git/go-git/v5/plumbing/transport"
+
+	"github.com/werf/logboek"
+
+	"github.com/werf/werf/pkg/ssh_agent"
+)
+
+const (
+	lfsPointerVersionLine = "version https://git-lfs.github.com/spec/v1"
+	lfsPointerMaxSize     = 1024
+	lfsMediaType          = "application/vnd.git-lfs+json"
+)
+
+// LFSOptions enables resolving of Git LFS pointer files into the real objects contents.
+// Objects are taken from the StoreDir (usually GIT_DIR/lfs/objects) and downloaded from the ServerURL when not found.
+// The LFS server is authenticated with the userinfo of the ServerURL or the configured https credentials,
+// or over ssh with git-lfs-authenticate when the SSHRemoteURL is specified.
+type LFSOptions struct {
+	StoreDir     string
+	ServerURL    string
+	SSHRemoteURL string
+}
+
+type LFSPointer struct {
+	Oid  string
+	Size int64
+}
+
+// ParseLFSPointer returns nil if data is not a valid Git LFS pointer file.
+func ParseLFSPointer(data []byte) *LFSPointer {
+	if len(data) > lfsPointerMaxSize || !bytes.HasPrefix(data, []byte(lfsPointerVersionLine+"\n")) {
+		return nil
+	}
+
+	pointer := &LFSPointer{Size: -1}
+	for _, line := range strings.Split(string(data), "\n") {
+		parts := strings.SplitN(line, " ", 2)
+		if len(parts) != 2 {
+			continue
+		}
+
+		switch parts[0] {
+		case "oid":
+			pointer.Oid = strings.TrimPrefix(parts[1], "sha256:")
+		case "size":
+			size, err := strconv.ParseInt(parts[1], 10, 64)
+			if err != nil {
+				return nil
+			}
+			pointer.Size = size
+		}
+	}
+
+	if len(pointer.Oid) != 64 || pointer.Size < 0 {
+		return nil
+	}
+
+	return pointer
+}
+
+// LFSServerURLFromRemoteURL returns the default Git LFS server url for the git remote url the same way git-lfs does.
+func LFSServerURLFromRemoteURL(remoteURL string) (string, error) {
+	endpoint, err := transport.NewEndpoint(remoteURL)
+	if err != nil {
+		return "", fmt.Errorf("bad remote url %q: %s", remoteURL, err)
+	}
+
+	repoPath := strings.TrimSuffix(strings.TrimPrefix(endpoint.Path, "/"), "/")
+	if !strings.HasSuffix(repoPath, ".git") {
+		repoPath += ".git"
+	}
+
+	switch endpoint.Protocol {
+	case "http", "https":
+		host := endpoint.Host
+		if endpoint.Port != 0 {
+			host = fmt.Sprintf("%s:%d", host, endpoint.Port)
+		}
+		return fmt.Sprintf("%s://%s/%s/info/lfs", endpoint.Protocol, host, repoPath), nil
+	case "ssh", "git":
+		return fmt.Sprintf("https://%s/%s/info/lfs", endpoint.Host, repoPath), nil
+	default:
+		return "", fmt.Errorf("unable to get Git LFS server url for remote url %q with %s protocol", remoteURL, endpoint.Protocol)
+	}
+}
+
+// IsSSHRemoteURL returns true for the ssh and scp-like git remote urls.
+func IsSSHRemoteURL(remoteURL string) bool {
+	endpoint, err := transport.NewEndpoint(remoteURL)
+	return err == nil && endpoint.Protocol == "ssh"
+}
+
+func (opts *LFSOptions) objectPath(oid string) string {
+	return filepath.Join(opts.StoreDir, oid[0:2], oid[2:4], oid)
+}
+
+// OpenObject opens the object from the local store, downloading it from the LFS server if needed.
+func (opts *LFSOptions) OpenObject(ctx context.Context, pointer *LFSPointer) (io.ReadCloser, error) {
+	objectPath := opts.objectPath(pointer.Oid)
+
+	if _, err := os.Stat(objectPath); os.IsNotExist(err) {
+		if opts.ServerURL == "" {
+			return nil, fmt.Errorf("Git LFS object %s not found in %s and LFS server url is not specified", pointer.Oid, opts.StoreDir)
+		}
+
+		if err := logboek.Context(ctx).Info().LogProcess("Downloading Git LFS object %s (%d bytes)", pointer.Oid, pointer.Size).DoError(func() error {
+			return opts.downloadObject(ctx, pointer, objectPath)
+		}); err != nil {
+			return nil, fmt.Errorf("unable to download Git LFS object %s: %s", pointer.Oid, err)
+		}
+	} else if err != nil {
+		return nil, fmt.Errorf("error accessing %s: %s", objectPath, err)
+	}
+
+	return os.Open(objectPath)
+}
+
+type lfsBatchRequest struct {
+	Operation string             `json:"operation"`
+	Transfers []string           `json:"transfers"`
+	Objects   []lfsBatchObjectID `json:"objects"`
+}
+
+type lfsBatchObjectID struct {
+	Oid  string `json:"oid"`
+	Size int64  `json:"size"`
+}
+
+type lfsBatchResponse struct {
+	Objects []struct {
+		lfsBatchObjectID
+		Actions map[string]struct {
+			Href   string            `json:"href"`
+			Header map[string]string `json:"header"`
+		} `json:"actions"`
+		Error *struct {
+			Code    int    `json:"code"`
+			Message string `json:"message"`
+		} `json:"error"`
+	} `json:"objects"`
+}
+
+type lfsSSHAuthenticateResponse struct {
+	Href   string            `json:"href"`
+	Header map[string]string `json:"header"`
+}
+
+// lfsSSHAuthenticate requests the LFS server url and the authorization headers with git-lfs-authenticate over ssh the same way git-lfs does.
+func lfsSSHAuthenticate(ctx context.Context, remoteURL string) (*lfsSSHAuthenticateResponse, error) {
+	endpoint, err := transport.NewEndpoint(remoteURL)
+	if err != nil {
+		return nil, fmt.Errorf("bad remote url %q: %s", remoteURL, err)
+	}
+
+	args := []string{"-o", "BatchMode=yes"}
+	if endpoint.Port != 0 {
+		args = append(args, "-p", strconv.Itoa(endpoint.Port))
+	}
+
+	host := endpoint.Host
+	if endpoint.User != "" {
+		host = fmt.Sprintf("%s@%s", endpoint.User, host)
+	}
+	args = append(args, host, "git-lfs-authenticate", strings.TrimPrefix(endpoint.Path, "/"), "download")
+
+	cmd := exec.CommandContext(ctx, "ssh", args...)
+	if ssh_agent.SSHAuthSock != "" {
+		cmd.Env = append(os.Environ(), fmt.Sprintf("SSH_AUTH_SOCK=%s", ssh_agent.SSHAuthSock))
+	}
+
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+
+	output, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("ssh git-lfs-authenticate failed: %s\n%s", err, strings.TrimSpace(stderr.String()))
+	}
+
+	res := &lfsSSHAuthenticateResponse{}
+	if err := json.Unmarshal(output, res); err != nil {
+		return nil, fmt.Errorf("unable to decode git-lfs-authenticate response: %s", err)
+	}
+
+	return res, nil
+}
+
+// setLFSServerAuth sets the request url and the authorization of the LFS server batch request.
+func (opts *LFSOptions) setLFSServerAuth(ctx context.Context, req *http.Request) error {
+	serverURL, err := url.Parse(opts.ServerURL)
+	if err != nil {
+		return fmt.Errorf("bad Git LFS server url %q: %s", opts.ServerURL, err)
+	}
+	userinfo := serverURL.User
+	serverURL.User = nil
+
+	switch {
+	case userinfo != nil:
+		password, _ := userinfo.Password()
+		req.SetBasicAuth(userinfo.Username(), password)
+	case opts.SSHRemoteURL != "":
+		authResp, err := lfsSSHAuthenticate(ctx, opts.SSHRemoteURL)
+		if err != nil {
+			return err
+		}
+
+		if authResp.Href != "" {
+			if serverURL, err = url.Parse(authResp.Href); err != nil {
+				return fmt.Errorf("bad Git LFS server url in git-lfs-authenticate response: %s", err)
+			}
+		}
+
+		for k, v := range authResp.Header {
+			req.Header.Set(k, v)
+		}
+	default:
+		repoURL := strings.TrimSuffix(strings.TrimSuffix(serverURL.String(), "/"), "/info/lfs")
+		if credential := GetHTTPSCredential(strings.TrimSuffix(repoURL, ".git")); credential != nil {
+			req.SetBasicAuth(credential.Username, credential.Password)
+		} else if credential := GetHTTPSCredential(repoURL); credential != nil {
+			req.SetBasicAuth(credential.Username, credential.Password)
+		}
+	}
+
+	batchURL, err := url.Parse(strings.TrimSuffix(serverURL.String(), "/") + "/objects/batch")
+	if err != nil {
+		return err
+	}
+	req.URL = batchURL
+	req.Host = batchURL.Host
+
+	return nil
+}
+
+func (opts *LFSOptions) downloadObject(ctx context.Context, pointer *LFSPointer, objectPath string) error {
+	reqBody, err := json.Marshal(lfsBatchRequest{
+		Operation: "download",
+		Transfers: []string{"basic"},
+		Objects:   []lfsBatchObjectID{{Oid: pointer.Oid, Size: pointer.Size}},
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, opts.ServerURL, bytes.NewReader(reqBody))
+	if err != nil {
+		return err
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Accept", lfsMediaType)
+	req.Header.Set("Content-Type", lfsMediaType)
+	if err := opts.setLFSServerAuth(ctx, req); err != nil {
+		return fmt.Errorf("unable to authenticate to Git LFS server: %s", err)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("batch request failed: %s", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("batch request failed: %s", resp.Status)
+	}
+
+	var batchResp lfsBatchResponse
+	if err := json.NewDecoder(resp.Body).Decode(&batchResp); err != nil {
+		return fmt.Errorf("unable to decode batch response: %s", err)
+	}
+
+	if len(batchResp.Objects) != 1 {
+		return fmt.Errorf("unexpected batch response: got %d objects", len(batchResp.Objects))
+	}
+
+	obj := batchResp.Objects[0]
+	if obj.Error != nil {
+		return fmt.Errorf("%s (code %d)", obj.Error.Message, obj.Error.Code)
+	}
+
+	download, hasKey := obj.Actions["download"]
+	if !hasKey {
+		return fmt.Errorf("no download action in batch response")
+	}
+
+	downloadReq, err := http.NewRequest(http.MethodGet, download.Href, nil)
+	if err != nil {
+		return err
+	}
+	downloadReq = downloadReq.WithContext(ctx)
+	for k, v := range download.Header {
+		downloadReq.Header.Set(k, v)
+	}
+
+	downloadResp, err := http.DefaultClient.Do(downloadReq)
+	if err != nil {
+		return fmt.Errorf("download request failed: %s", err)
+	}
+	defer downloadResp.Body.Close()
+
+	if downloadResp.StatusCode != http.StatusOK {
+		return fmt.Errorf("download request failed: %s", downloadResp.Status)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(objectPath), os.ModePerm); err != nil {
+		return err
+	}
+
+	tmpFile, err := ioutil.TempFile(filepath.Dir(objectPath), "tmp-")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmpFile.Name())
+
+	h := sha256.New()
+	if _, err := io.Copy(io.MultiWriter(tmpFile, h), downloadResp.Body); err != nil {
+		tmpFile.Close()
+		return fmt.Errorf("unable to write object: %s", err)
+	}
+
+	if err := tmpFile.Close(); err != nil {
+		return err
+	}
+
+	if checksum := fmt.Sprintf("%x", h.Sum(nil)); checksum != pointer.Oid {
+		return fmt.Errorf("downloaded object checksum %s mismatch", checksum)
+	}
+
+	return os.Rename(tmpFile.Name(), objectPath)
+}
+
+// lfsPointerPaths returns paths which are Git LFS pointer files in any of the specified commits.
+func lfsPointerPaths(gitDir string, commits, paths []string) (map[string]bool, error) {
+	res := map[string]bool{}
+	if len(paths) == 0 {
+		return res, nil
+	}
+
+	var input bytes.Buffer
+	var objects []string
+	for _, commit := range commits {
+		for _, p := range paths {
+			objects = append(objects, p)
+			input.WriteString(fmt.Sprintf("%s:%s\n", commit, p))
+		}
+	}
+
+	cmd := gitCommand("-C", gitDir, "cat-file", "--batch")
+	cmd.Stdin = &input
+
+	output, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("git cat-file failed: %s", err)
+	}
+
+	reader := bufio.NewReader(bytes.NewReader(output))
+	for _, p := range objects {
+		header, err := reader.ReadString('\n')
+		if err != nil {
+			return nil, fmt.Errorf("unable to read git cat-file output: %s", err)
+		}
+
+		fields := strings.Fields(header)
+		if len(fields) != 3 {
+			// missing object
+			continue
+		}
+
+		size, err := strconv.ParseInt(fields[2], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("unexpected git cat-file output %q", header)
+		}
+
+		data := make([]byte, size+1)
+		if _, err := io.ReadFull(reader, data); err != nil {
+			return nil, fmt.Errorf("unable to read git cat-file output: %s", err)
+		}
+
+		if fields[1] == "blob" && ParseLFSPointer(data[:size]) != nil {
+			res[p] = true
+		}
+	}
+
+	return res, nil
+}
@@ -8,7 +8,7 @@ import (
 
 func IsAncestor(ancestorCommit, descendantCommit string, gitDir string) (bool, error) {
 	gitArgs := []string{"-C", gitDir, "merge-base", "--is-ancestor", ancestorCommit, descendantCommit}
-	cmd := exec.Command("git", gitArgs...)
+	cmd := gitCommand(gitArgs...)
 
 	output, err := cmd.CombinedOutput()
 	if err != nil {
@@ -0,0 +1,153 @@
+package true_git
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/werf/logboek"
+)
+
+const workTreeCacheSparsePathsFile = "sparse_paths"
+
+var sparseCheckoutPatternEscaper = strings.NewReplacer(`\`, `\\`, "*", `\*`, "?", `\?`, "[", `\[`, "!", `\!`, "#", `\#`)
+
+// PartialClone creates the bare repository with the origin remote configured as a promisor remote with the filter (blob:none, tree:0)
+// and fetches all branches and tags. Objects filtered out will be fetched by git on demand.
+func PartialClone(ctx context.Context, url, dir, filter string) error {
+	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+		return fmt.Errorf("unable to create dir %s: %s", dir, err)
+	}
+
+	for _, gitArgs := range [][]string{
+		{"init", "--bare"},
+		{"remote", "add", "origin", url},
+		{"config", "core.repositoryformatversion", "1"},
+		{"config", "extensions.partialClone", "origin"},
+		{"config", "remote.origin.promisor", "true"},
+		{"config", "remote.origin.partialCloneFilter", filter},
+	} {
+		if err := runPartialCloneGitCommand(ctx, dir, gitArgs...); err != nil {
+			return err
+		}
+	}
+
+	return PartialCloneFetch(ctx, dir)
+}
+
+// PartialCloneFetch fetches all branches and tags of the origin remote into the partial clone using the configured filter.
+func PartialCloneFetch(ctx context.Context, dir string) error {
+	return runPartialCloneGitCommand(ctx, dir, "fetch", "--force", "--tags", "--prune", "origin")
+}
+
+// FetchPartialCloneObjects fetches trees of the paths and .gitmodules file of the commit,
+// which are required to work with the commit of the partial clone without checkout.
+// Trees of the whole commit are fetched when no paths are specified.
+func FetchPartialCloneObjects(ctx context.Context, gitDir, commit string, paths []string) error {
+	gitArgs := append([]string{"ls-tree", "-r", "-t", "--full-tree", commit, "--"}, paths...)
+	if err := runPartialCloneGitCommand(ctx, gitDir, gitArgs...); err != nil {
+		return err
+	}
+
+	cmd := gitCommand("-C", gitDir, "ls-tree", commit, "--", ".gitmodules")
+	output, err := cmd.Output()
+	if err != nil {
+		return fmt.Errorf("git ls-tree failed: %s", err)
+	}
+
+	if fields := strings.Fields(string(output)); len(fields) >= 3 {
+		if err := runPartialCloneGitCommand(ctx, gitDir, "cat-file", "blob", fields[2]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func runPartialCloneGitCommand(ctx context.Context, gitDir string, gitArgs ...string) error {
+	cmd := gitCommand(append([]string{"-C", gitDir}, gitArgs...)...)
+	logboek.Context(ctx).Debug().LogF("Running %s\n", strings.Join(cmd.Args, " "))
+
+	var output bytes.Buffer
+	cmd.Stderr = &output
+
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("git %s failed: %s\n%s", strings.Join(gitArgs, " "), err, output.String())
+	}
+
+	return nil
+}
+
+// InitSparseWorkTreeCache makes work trees of the cache dir to check out only the specified paths.
+// The paths cannot be changed for the existing cache dir.
+func InitSparseWorkTreeCache(workTreeCacheDir string, paths []string) error {
+	sparsePathsFile := filepath.Join(workTreeCacheDir, workTreeCacheSparsePathsFile)
+	if _, err := os.Stat(sparsePathsFile); err == nil {
+		return nil
+	} else if !os.IsNotExist(err) {
+		return fmt.Errorf("unable to access %s: %s", sparsePathsFile, err)
+	}
+
+	if err := os.MkdirAll(workTreeCacheDir, os.ModePerm); err != nil {
+		return fmt.Errorf("unable to create dir %s: %s", workTreeCacheDir, err)
+	}
+
+	tmpFile := sparsePathsFile + ".tmp"
+	if err := ioutil.WriteFile(tmpFile, []byte(strings.Join(paths, "\n")+"\n"), 0644); err != nil {
+		return fmt.Errorf("error writing %s: %s", tmpFile, err)
+	}
+
+	return os.Rename(tmpFile, sparsePathsFile)
+}
+
+func getWorkTreeCacheSparsePaths(workTreeCacheDir string) ([]string, error) {
+	sparsePathsFile := filepath.Join(workTreeCacheDir, workTreeCacheSparsePathsFile)
+
+	data, err := ioutil.ReadFile(sparsePathsFile)
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("error reading %s: %s", sparsePathsFile, err)
+	}
+
+	res := []string{}
+	for _, line := range strings.Split(string(data), "\n") {
+		if line != "" {
+			res = append(res, line)
+		}
+	}
+
+	return res, nil
+}
+
+func writeWorkTreeSparseCheckoutFile(workTreeDir string, sparsePaths []string) error {
+	cmd := gitCommand("rev-parse", "--absolute-git-dir")
+	cmd.Dir = workTreeDir
+
+	output, err := cmd.Output()
+	if err != nil {
+		return fmt.Errorf("unable to get git dir of the work tree %s: %s", workTreeDir, err)
+	}
+
+	var patterns []string
+	for _, p := range sparsePaths {
+		patterns = append(patterns, "/"+sparseCheckoutPatternEscaper.Replace(filepath.ToSlash(p)))
+	}
+	// .gitmodules is required to work with submodules
+	patterns = append(patterns, "/.gitmodules")
+
+	sparseCheckoutFile := filepath.Join(strings.TrimSpace(string(output)), "info", "sparse-checkout")
+	if err := os.MkdirAll(filepath.Dir(sparseCheckoutFile), os.ModePerm); err != nil {
+		return err
+	}
+
+	if err := ioutil.WriteFile(sparseCheckoutFile, []byte(strings.Join(patterns, "\n")+"\n"), 0644); err != nil {
+		return fmt.Errorf("error writing %s: %s", sparseCheckoutFile, err)
+	}
+
+	return nil
+}
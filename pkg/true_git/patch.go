@@ -19,6 +19,13 @@ type PatchOptions struct {
 
 	WithEntireFileContext bool
 	WithBinary            bool
+
+	// LFS marks paths which are Git LFS pointer files as binary, so these paths will be taken from the archive
+	LFS *LFSOptions
+
+	// LimitByBasePath passes the base path of the PathMatcher to git diff as the pathspec to avoid fetching unrelated objects in the partial clone.
+	// The base path should not be inside the submodule: git diff --submodule=diff gives the empty diff for such pathspec.
+	LimitByBasePath bool
 }
 
 type PatchDescriptor struct {
@@ -87,6 +94,11 @@ func writePatch(ctx context.Context, out io.Writer, gitDir, workTreeCacheDir str
 		diffOpts = append(diffOpts, "--binary")
 	}
 
+	var pathspecArgs []string
+	if basePath := opts.PathMatcher.BaseFilepath(); opts.LimitByBasePath && basePath != "" {
+		pathspecArgs = []string{"--", ":(literal)" + filepath.ToSlash(basePath)}
+	}
+
 	var cmd *exec.Cmd
 
 	if withSubmodules {
@@ -99,12 +111,13 @@ func writePatch(ctx context.Context, out io.Writer, gitDir, workTreeCacheDir str
 		gitArgs = append(gitArgs, "diff")
 		gitArgs = append(gitArgs, diffOpts...)
 		gitArgs = append(gitArgs, opts.FromCommit, opts.ToCommit)
+		gitArgs = append(gitArgs, pathspecArgs...)
 
 		if debugPatch() {
 			fmt.Printf("# git %s\n", strings.Join(gitArgs, " "))
 		}
 
-		cmd = exec.Command("git", gitArgs...)
+		cmd = gitCommand(gitArgs...)
 
 		cmd.Dir = workTreeDir // required for `git diff` with submodules
 	} else {
@@ -112,12 +125,13 @@ func writePatch(ctx context.Context, out io.Writer, gitDir, workTreeCacheDir str
 		gitArgs = append(gitArgs, "diff")
 		gitArgs = append(gitArgs, diffOpts...)
 		gitArgs = append(gitArgs, opts.FromCommit, opts.ToCommit)
+		gitArgs = append(gitArgs, pathspecArgs...)
 
 		if debugPatch() {
 			fmt.Printf("# git %s\n", strings.Join(gitArgs, " "))
 		}
 
-		cmd = exec.Command("git", gitArgs...)
+		cmd = gitCommand(gitArgs...)
 	}
 
 	stdoutPipe, err := cmd.StdoutPipe()
@@ -213,6 +227,24 @@ WaitForData:
 		BinaryPaths: p.BinaryPaths,
 	}
 
+	if opts.LFS != nil {
+		var fullPaths []string
+		for _, path := range p.Paths {
+			fullPaths = append(fullPaths, p.FullPaths[path])
+		}
+
+		lfsPaths, err := lfsPointerPaths(gitDir, []string{opts.FromCommit, opts.ToCommit}, fullPaths)
+		if err != nil {
+			return nil, fmt.Errorf("unable to detect Git LFS pointer files: %s", err)
+		}
+
+		for _, path := range p.Paths {
+			if lfsPaths[p.FullPaths[path]] {
+				desc.BinaryPaths = appendUnique(desc.BinaryPaths, path)
+			}
+		}
+	}
+
 	if debugPatch() {
 		fmt.Printf("Patch paths count is %d, binary paths count is %d\n", len(desc.Paths), len(desc.BinaryPaths))
 		for _, path := range desc.Paths {
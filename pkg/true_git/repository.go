@@ -2,7 +2,6 @@ package true_git
 
 import (
 	"context"
-	"os/exec"
 	"path/filepath"
 	"strings"
 
@@ -28,7 +27,6 @@ type FetchOptions struct {
 }
 
 func Fetch(ctx context.Context, path string, options FetchOptions) error {
-	command := "git"
 	commandArgs := []string{"-C", path, "fetch"}
 
 	if options.Unshallow {
@@ -55,9 +53,9 @@ func Fetch(ctx context.Context, path string, options FetchOptions) error {
 		commandArgs = append(commandArgs, remote, refSpec)
 	}
 
-	logboek.Context(ctx).Debug().LogLnDetails(command, strings.Join(commandArgs, " "))
+	logboek.Context(ctx).Debug().LogLnDetails("git", strings.Join(commandArgs, " "))
 
-	cmd := exec.Command(command, commandArgs...)
+	cmd := gitCommand(commandArgs...)
 	cmd.Stdout = logboek.Context(ctx).ProxyOutStream()
 	cmd.Stderr = logboek.Context(ctx).ProxyErrStream()
 
@@ -74,7 +72,7 @@ func IsShallowClone(path string) (bool, error) {
 		return exist, nil
 	}
 
-	cmd := exec.Command("git", "-C", path, "rev-parse", "--is-shallow-repository")
+	cmd := gitCommand("-C", path, "rev-parse", "--is-shallow-repository")
 
 	res, err := cmd.Output()
 	if err != nil {
@@ -2,7 +2,6 @@ package true_git
 
 import (
 	"fmt"
-	"os/exec"
 	"strings"
 )
 
@@ -27,7 +26,7 @@ type ShowRefResult struct {
 func ShowRef(repoDir string) (*ShowRefResult, error) {
 	gitArgs := []string{"-C", repoDir, "show-ref", "--head"}
 
-	cmd := exec.Command("git", gitArgs...)
+	cmd := gitCommand(gitArgs...)
 
 	output, err := cmd.CombinedOutput()
 	if err != nil {
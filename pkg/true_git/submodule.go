@@ -3,7 +3,6 @@ package true_git
 import (
 	"context"
 	"fmt"
-	"os/exec"
 	"strings"
 
 	"github.com/werf/logboek"
@@ -12,8 +11,8 @@ import (
 func syncSubmodules(ctx context.Context, repoDir, workTreeDir string) error {
 	logProcessMsg := fmt.Sprintf("Sync submodules in work tree '%s'", workTreeDir)
 	return logboek.Context(ctx).Info().LogProcess(logProcessMsg).DoError(func() error {
-		cmd := exec.Command(
-			"git", "-c", "core.autocrlf=false",
+		cmd := gitCommand(
+			"-c", "core.autocrlf=false",
 			"submodule", "sync", "--recursive",
 		)
 
@@ -37,8 +36,8 @@ func syncSubmodules(ctx context.Context, repoDir, workTreeDir string) error {
 func updateSubmodules(ctx context.Context, repoDir, workTreeDir string) error {
 	logProcessMsg := fmt.Sprintf("Update submodules in work tree '%s'", workTreeDir)
 	return logboek.Context(ctx).Info().LogProcess(logProcessMsg).DoError(func() error {
-		cmd := exec.Command(
-			"git", "-c", "core.autocrlf=false",
+		cmd := gitCommand(
+			"-c", "core.autocrlf=false",
 			"submodule", "update", "--checkout", "--force", "--init", "--recursive",
 		)
 
@@ -71,6 +71,11 @@ func prepareWorkTree(ctx context.Context, repoDir, workTreeCacheDir string, comm
 		return "", fmt.Errorf("unable to access %s: %s", gitDirPath, err)
 	}
 
+	sparsePaths, err := getWorkTreeCacheSparsePaths(workTreeCacheDir)
+	if err != nil {
+		return "", err
+	}
+
 	workTreeDir := filepath.Join(workTreeCacheDir, "worktree")
 
 	isWorkTreeDirExist := false
@@ -137,7 +142,7 @@ func prepareWorkTree(ctx context.Context, repoDir, workTreeCacheDir string, comm
 		if currentCommit != "" {
 			logboek.Context(ctx).Info().LogFDetails("Current commit: %s\n", currentCommit)
 		}
-		return switchWorkTree(ctx, repoDir, workTreeDir, commit, withSubmodules)
+		return switchWorkTree(ctx, repoDir, workTreeDir, commit, withSubmodules, sparsePaths)
 	}); err != nil {
 		return "", fmt.Errorf("unable to switch work tree %s to commit %s: %s", workTreeDir, commit, err)
 	}
@@ -153,16 +158,26 @@ func debugWorktreeSwitch() bool {
 	return os.Getenv("WERF_TRUE_GIT_DEBUG_WORKTREE_SWITCH") == "1"
 }
 
-func switchWorkTree(ctx context.Context, repoDir, workTreeDir string, commit string, withSubmodules bool) error {
+func switchWorkTree(ctx context.Context, repoDir, workTreeDir string, commit string, withSubmodules bool, sparsePaths []string) error {
 	var err error
 	var cmd *exec.Cmd
 	var output *bytes.Buffer
 
+	// only paths from the sparse-checkout file of the work tree will be checked out (and fetched in the partial clone)
+	var sparseCheckoutOpts []string
+	if sparsePaths != nil {
+		sparseCheckoutOpts = []string{"-c", "core.sparseCheckout=true"}
+	}
+
+	isWorkTreeAdded := false
 	if _, err := os.Stat(workTreeDir); os.IsNotExist(err) {
-		cmd = exec.Command(
-			"git", "-C", repoDir,
-			"worktree", "add", "--force", "--detach", workTreeDir, commit,
-		)
+		gitArgs := []string{"-C", repoDir, "worktree", "add", "--force", "--detach"}
+		if sparsePaths != nil {
+			gitArgs = append(gitArgs, "--no-checkout")
+		}
+		gitArgs = append(gitArgs, workTreeDir, commit)
+
+		cmd = gitCommand(gitArgs...)
 		output = setCommandRecordingLiveOutput(ctx, cmd)
 		if debugWorktreeSwitch() {
 			fmt.Printf("[DEBUG WORKTREE SWITCH] %s\n", strings.Join(append([]string{cmd.Path}, cmd.Args[1:]...), " "))
@@ -171,10 +186,20 @@ func switchWorkTree(ctx context.Context, repoDir, workTreeDir string, commit str
 		if err != nil {
 			return fmt.Errorf("git worktree add failed: %s\n%s", err, output.String())
 		}
+
+		isWorkTreeAdded = true
 	} else if err != nil {
 		return fmt.Errorf("error accessing %s: %s", workTreeDir, err)
-	} else {
-		cmd = exec.Command("git", "checkout", "--force", "--detach", commit)
+	}
+
+	if sparsePaths != nil {
+		if err := writeWorkTreeSparseCheckoutFile(workTreeDir, sparsePaths); err != nil {
+			return err
+		}
+	}
+
+	if !isWorkTreeAdded || sparsePaths != nil {
+		cmd = gitCommand(append(sparseCheckoutOpts, "checkout", "--force", "--detach", commit)...)
 		cmd.Dir = workTreeDir
 		output = setCommandRecordingLiveOutput(ctx, cmd)
 		if debugWorktreeSwitch() {
@@ -186,9 +211,11 @@ func switchWorkTree(ctx context.Context, repoDir, workTreeDir string, commit str
 		}
 	}
 
-	cmd = exec.Command(
-		"git", "-c", "core.autocrlf=false",
-		"reset", "--hard", commit,
+	cmd = gitCommand(
+		append(sparseCheckoutOpts,
+			"-c", "core.autocrlf=false",
+			"reset", "--hard", commit,
+		)...,
 	)
 	cmd.Dir = workTreeDir
 	output = setCommandRecordingLiveOutput(ctx, cmd)
@@ -200,8 +227,8 @@ func switchWorkTree(ctx context.Context, repoDir, workTreeDir string, commit str
 		return fmt.Errorf("git reset failed: %s\n%s", err, output.String())
 	}
 
-	cmd = exec.Command(
-		"git", "--work-tree", workTreeDir,
+	cmd = gitCommand(
+		"--work-tree", workTreeDir,
 		"clean", "-d", "-f", "-f", "-x",
 	)
 	cmd.Dir = workTreeDir
@@ -227,8 +254,8 @@ func switchWorkTree(ctx context.Context, repoDir, workTreeDir string, commit str
 			return fmt.Errorf("cannot update submodules: %s", err)
 		}
 
-		cmd = exec.Command(
-			"git", "--work-tree", workTreeDir,
+		cmd = gitCommand(
+			"--work-tree", workTreeDir,
 			"submodule", "foreach", "--recursive",
 			"git", "-c", "core.autocrlf=false", "reset", "--hard",
 		)
@@ -242,8 +269,8 @@ func switchWorkTree(ctx context.Context, repoDir, workTreeDir string, commit str
 			return fmt.Errorf("git submodules reset failed: %s\n%s", err, output.String())
 		}
 
-		cmd = exec.Command(
-			"git", "--work-tree", workTreeDir,
+		cmd = gitCommand(
+			"--work-tree", workTreeDir,
 			"submodule", "foreach", "--recursive",
 			"git", "clean", "-d", "-f", "-f", "-x",
 		)
@@ -264,7 +291,7 @@ func switchWorkTree(ctx context.Context, repoDir, workTreeDir string, commit str
 func GetRealRepoDir(repoDir string) (string, error) {
 	gitArgs := []string{"--git-dir", repoDir, "rev-parse", "--git-dir"}
 
-	cmd := exec.Command("git", gitArgs...)
+	cmd := gitCommand(gitArgs...)
 
 	output, err := cmd.CombinedOutput()
 	if err != nil {
@@ -274,6 +301,20 @@ func GetRealRepoDir(repoDir string) (string, error) {
 	return strings.TrimSpace(string(output)), nil
 }
 
+// GetWorkTreePrefix returns the path relative to the work tree root, the empty string is returned for the work tree root.
+func GetWorkTreePrefix(path string) (string, error) {
+	gitArgs := []string{"-C", path, "rev-parse", "--show-prefix"}
+
+	cmd := gitCommand(gitArgs...)
+
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return "", fmt.Errorf("'%s' failed (%s): %s:\n%s", strings.Join(append([]string{cmd.Path}, cmd.Args[1:]...), " "), path, err, output)
+	}
+
+	return strings.TrimSuffix(strings.TrimSpace(string(output)), "/"), nil
+}
+
 type WorktreeDescriptor struct {
 	Path   string
 	Head   string
@@ -283,7 +324,7 @@ type WorktreeDescriptor struct {
 func GetWorkTreeList(repoDir string) ([]WorktreeDescriptor, error) {
 	gitArgs := []string{"-C", repoDir, "worktree", "list", "--porcelain"}
 
-	cmd := exec.Command("git", gitArgs...)
+	cmd := gitCommand(gitArgs...)
 
 	output, err := cmd.CombinedOutput()
 	if err != nil {
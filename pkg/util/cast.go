@@ -1,6 +1,9 @@
 package util
 
-import "fmt"
+import (
+	"fmt"
+	"sort"
+)
 
 func MapStringInterfaceToMapStringString(value map[string]interface{}) map[string]string {
 	result := map[string]string{}
@@ -57,3 +60,25 @@ func InterfaceToMapStringInterface(value interface{}) (map[string]interface{}, e
 		return nil, fmt.Errorf("value `%#v` can't be casted into map[string]interface{}", value)
 	}
 }
+
+// GetValuesKeys returns sorted dot-separated paths of leaf values, lists are considered as leaf values.
+func GetValuesKeys(values map[string]interface{}) []string {
+	keys := getValuesKeys(values, "")
+	sort.Strings(keys)
+
+	return keys
+}
+
+func getValuesKeys(values map[string]interface{}, prefix string) []string {
+	var keys []string
+	for key, value := range values {
+		path := prefix + key
+		if valueMap, ok := value.(map[string]interface{}); ok && len(valueMap) != 0 {
+			keys = append(keys, getValuesKeys(valueMap, path+".")...)
+		} else {
+			keys = append(keys, path)
+		}
+	}
+
+	return keys
+}
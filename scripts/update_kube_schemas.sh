@@ -0,0 +1,37 @@
+#!/bin/bash
+
+# Generates pkg/deploy/validation/kube_schemas/static.go with schemas of the specified Kubernetes versions.
+# Schemas are definitions of the Kubernetes OpenAPI spec without descriptions, they are embedded by esc
+# and are not stored in the repo as separate files.
+#
+# Usage: scripts/update_kube_schemas.sh 1.13 1.14 ...
+# The schema of each version is prepared by update_kube_schemas.jq.
+# Requires go, jq and esc (go get github.com/mjibson/esc).
+
+set -euo pipefail
+
+if [ $# -eq 0 ]; then
+    echo "Must specify versions!"
+    exit 1
+fi
+
+SCRIPT_DIR=$(cd "$(dirname "${BASH_SOURCE[0]}")" && pwd)
+DEST="$SCRIPT_DIR/../pkg/deploy/validation/kube_schemas/static.go"
+
+TMP_DIR=$(mktemp -d)
+trap 'rm -rf "$TMP_DIR"' EXIT
+mkdir "$TMP_DIR/kube_schemas"
+
+for VERSION in "$@"; do
+    VERSION=${VERSION#"v"}
+    DIR=$(
+        cd "$TMP_DIR" &&
+        go mod download -json "k8s.io/kubernetes@v${VERSION}.0" |
+        jq -r .Dir
+    )
+
+    jq -S --arg version "v${VERSION}.0" -f "$SCRIPT_DIR/update_kube_schemas.jq" "$DIR/api/openapi-spec/swagger.json" > "$TMP_DIR/kube_schemas/v${VERSION}.json"
+done
+
+(cd "$TMP_DIR" && esc -o static.go -pkg kube_schemas kube_schemas)
+gofmt "$TMP_DIR/static.go" > "$DEST"